@@ -0,0 +1,177 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl"
+	"github.com/hashicorp/hcl/hcl/ast"
+)
+
+// Key of the request meta which is mapped to the capabilities of the policy
+const permissionMetaKey = "permission"
+
+var (
+	// Capabilities of the policy if the permission is not mapped
+	defaultCapabilities = []string{"read"}
+	// Capabilities of the permissions of the built-in types which have a permission in the meta
+	builtinPermissionCapabilities = map[string][]string{
+		"readonly":  {"read"},
+		"readwrite": {"read", "update"},
+	}
+	// Capabilities supported by vault acl policies
+	policyCapabilities = []string{"create", "read", "update", "patch", "delete", "list", "sudo", "deny", "subscribe"}
+	// Keys can be set in a path of vault acl policies
+	policyPathKeys = []string{
+		"capabilities", "policy", "min_wrapping_ttl", "max_wrapping_ttl",
+		"allowed_parameters", "denied_parameters", "required_parameters", "mfa_methods", "control_group",
+	}
+)
+
+// ConfigureSecretTypePolicy changes the policy of a registered secret type.
+// The policy template is replaced if it is not empty,
+// and the permission in the request meta is mapped to the capabilities if permissionCapabilities is not empty.
+func ConfigureSecretTypePolicy(name, policyTemplate string, permissionCapabilities map[string][]string) error {
+	secretTypes.Lock()
+	defer secretTypes.Unlock()
+	old, ok := secretTypes.types[name]
+	if !ok {
+		return fmt.Errorf("secret type %s is not registered", name)
+	}
+
+	t := *old
+	if policyTemplate != "" {
+		policy, err := parseSecretTemplate(name+"-policy", policyTemplate)
+		if err != nil {
+			return err
+		}
+		t.PolicyTemplate = policyTemplate
+		t.policy = policy
+	}
+	if len(permissionCapabilities) != 0 {
+		t.PermissionCapabilities = permissionCapabilities
+	}
+	if err := t.checkPolicy(); err != nil {
+		return fmt.Errorf("policy of secret type %s is invalid: %w", name, err)
+	}
+	secretTypes.types[name] = &t
+	return nil
+}
+
+// checkPolicy checks the capabilities, and renders the policy by a sample meta to check it is a valid policy.
+func (t *secretType) checkPolicy() error {
+	for permission, capabilities := range t.PermissionCapabilities {
+		if len(capabilities) == 0 {
+			return fmt.Errorf("capabilities of permission %s are empty", permission)
+		}
+		for _, capability := range capabilities {
+			if !containsKey(policyCapabilities, capability) {
+				return fmt.Errorf("capability %s of permission %s is unknown", capability, permission)
+			}
+		}
+	}
+
+	meta := map[string]string{}
+	for _, field := range t.fields {
+		meta[field] = "sample"
+	}
+	secret, err := t.convertRequest(meta)
+	if err != nil {
+		return err
+	}
+	return ValidatePolicy(secret.PolicyData)
+}
+
+// capabilities returns the capabilities of the permission in the meta.
+func (t *secretType) capabilities(meta map[string]string) []string {
+	if capabilities, ok := t.PermissionCapabilities[meta[permissionMetaKey]]; ok {
+		return capabilities
+	}
+	return defaultCapabilities
+}
+
+// formatCapabilities formats the capabilities as a hcl list, such as ["read", "update"].
+func formatCapabilities(capabilities []string) string {
+	quoted := make([]string, 0, len(capabilities))
+	for _, capability := range capabilities {
+		quoted = append(quoted, fmt.Sprintf("%q", capability))
+	}
+	return fmt.Sprintf("[%s]", strings.Join(quoted, ", "))
+}
+
+// ValidatePolicy checks the policy is a vault acl policy which only has paths,
+// each path has known keys and its capabilities are supported by vault.
+func ValidatePolicy(policy string) error {
+	root, err := hcl.Parse(policy)
+	if err != nil {
+		return fmt.Errorf("parse policy failed: %w", err)
+	}
+	list, ok := root.Node.(*ast.ObjectList)
+	if !ok {
+		return fmt.Errorf("policy does not contain a root object")
+	}
+
+	paths := 0
+	for _, item := range list.Items {
+		key := item.Keys[0].Token.Value()
+		if key != "path" {
+			return fmt.Errorf("key %v is not allowed in policy", key)
+		}
+		if len(item.Keys) != 2 {
+			return fmt.Errorf("path of policy must have a name")
+		}
+		pathName := item.Keys[1].Token.Value()
+		if err := validatePolicyPath(item.Val); err != nil {
+			return fmt.Errorf("path %v is invalid: %w", pathName, err)
+		}
+		paths++
+	}
+	if paths == 0 {
+		return fmt.Errorf("policy does not have any path")
+	}
+	return nil
+}
+
+func validatePolicyPath(node ast.Node) error {
+	object, ok := node.(*ast.ObjectType)
+	if !ok {
+		return fmt.Errorf("path is not an object")
+	}
+	for _, item := range object.List.Items {
+		key, _ := item.Keys[0].Token.Value().(string)
+		if !containsKey(policyPathKeys, key) {
+			return fmt.Errorf("key %s is not allowed", key)
+		}
+	}
+
+	var path struct {
+		Capabilities []string `hcl:"capabilities"`
+		Policy       string   `hcl:"policy"`
+	}
+	if err := hcl.DecodeObject(&path, node); err != nil {
+		return err
+	}
+	if len(path.Capabilities) == 0 && path.Policy == "" {
+		return fmt.Errorf("capabilities are not set")
+	}
+	for _, capability := range path.Capabilities {
+		if !containsKey(policyCapabilities, capability) {
+			return fmt.Errorf("capability %s is unknown", capability)
+		}
+	}
+	return nil
+}
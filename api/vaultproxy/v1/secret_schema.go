@@ -0,0 +1,425 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Formats of the values in the secret data.
+const (
+	// One or more PEM blocks, such as certificates and private keys
+	FormatPEM = "pem"
+	// A kubeconfig file with at least one cluster and one user, the certs of the current context must not be expired
+	FormatKubeconfig = "kubeconfig"
+	// An absolute url with scheme and host
+	FormatURL = "url"
+)
+
+// SecretSchema declares the keys of the secret data of a type.
+type SecretSchema struct {
+	// Keys can be stored in the secret
+	Keys []SecretKeySchema
+	// Keys not declared are allowed if it is true
+	AdditionalKeys bool
+	// Max size of each value of the keys not declared in bytes, 0 means no limit
+	AdditionalMaxSize int
+	// Max size of the whole secret data in bytes, 0 means no limit
+	MaxSize int
+	// Keys which are required when another key has the value
+	Conditions []SecretSchemaCondition
+}
+
+// SecretKeySchema declares a key of the secret data.
+type SecretKeySchema struct {
+	Name     string
+	Required bool
+	// Format of the value, any string is accepted if it is empty
+	Format string
+	// Values can be used, any value is accepted if it is empty
+	Enum []string
+	// Max size of the value in bytes, 0 means no limit
+	MaxSize int
+}
+
+// SecretSchemaCondition makes the keys required when the value of Key is Value.
+type SecretSchemaCondition struct {
+	Key      string
+	Value    string
+	Required []string
+}
+
+// FieldViolation describes why a key of the secret data is invalid.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+func (v FieldViolation) String() string {
+	return fmt.Sprintf("%s: %s", v.Field, v.Description)
+}
+
+var formatValidators = map[string]func(value string) error{
+	FormatPEM:        validatePEM,
+	FormatKubeconfig: validateKubeconfig,
+	FormatURL:        validateURL,
+}
+
+const (
+	gitMaxSize     = 64 << 10
+	clusterMaxSize = 256 << 10
+)
+
+var (
+	gitSecretSchema = &SecretSchema{
+		Keys: []SecretKeySchema{
+			{Name: "deploykey", MaxSize: 16 << 10},
+			{Name: "accesstoken", MaxSize: 4 << 10},
+		},
+		AdditionalKeys:    true,
+		AdditionalMaxSize: 4 << 10,
+		MaxSize:           gitMaxSize,
+	}
+	repoSecretSchema = &SecretSchema{
+		Keys: []SecretKeySchema{
+			{Name: AuthTypeKey, Required: true, Enum: []string{AuthTypeToken, AuthTypePassword}},
+			{Name: "token", MaxSize: 4 << 10},
+			{Name: "username", MaxSize: 256},
+			{Name: "password", MaxSize: 4 << 10},
+		},
+		AdditionalKeys:    true,
+		AdditionalMaxSize: 4 << 10,
+		MaxSize:           gitMaxSize,
+		Conditions: []SecretSchemaCondition{
+			{Key: AuthTypeKey, Value: AuthTypeToken, Required: []string{"token"}},
+			{Key: AuthTypeKey, Value: AuthTypePassword, Required: []string{"username", "password"}},
+		},
+	}
+	clusterSecretSchema = &SecretSchema{
+		Keys: []SecretKeySchema{
+			{Name: "kubeconfig", Required: true, Format: FormatKubeconfig, MaxSize: clusterMaxSize},
+		},
+		MaxSize: clusterMaxSize,
+	}
+	pkiSecretSchema = &SecretSchema{
+		Keys: []SecretKeySchema{
+			{Name: "cacert", Required: true, Format: FormatPEM, MaxSize: 64 << 10},
+			{Name: "cert", Required: true, Format: FormatPEM, MaxSize: 64 << 10},
+			{Name: "key", Required: true, Format: FormatPEM, MaxSize: 16 << 10},
+		},
+	}
+)
+
+// check returns an error if the schema refers to unknown formats or keys.
+func (s *SecretSchema) check() error {
+	if s == nil {
+		return nil
+	}
+	for _, key := range s.Keys {
+		if key.Name == "" {
+			return fmt.Errorf("name of schema key is required")
+		}
+		if key.Format != "" && formatValidators[key.Format] == nil {
+			return fmt.Errorf("format %s of key %s is unknown", key.Format, key.Name)
+		}
+	}
+	for _, cond := range s.Conditions {
+		for _, name := range append([]string{cond.Key}, cond.Required...) {
+			if !s.AdditionalKeys && s.getKey(name) == nil {
+				return fmt.Errorf("key %s of condition is not declared", name)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *SecretSchema) getKey(name string) *SecretKeySchema {
+	for i := range s.Keys {
+		if s.Keys[i].Name == name {
+			return &s.Keys[i]
+		}
+	}
+	return nil
+}
+
+// validate checks the secret data against the schema, the violations are sorted by field.
+func (s *SecretSchema) validate(data map[string]interface{}) []FieldViolation {
+	if s == nil {
+		return nil
+	}
+	var violations []FieldViolation
+	addViolation := func(field, format string, args ...interface{}) {
+		violations = append(violations, FieldViolation{Field: field, Description: fmt.Sprintf(format, args...)})
+	}
+
+	totalSize := 0
+	for name, raw := range data {
+		value, ok := raw.(string)
+		if !ok {
+			addViolation(name, "value must be a string")
+			continue
+		}
+		totalSize += len(name) + len(value)
+
+		key := s.getKey(name)
+		if key == nil {
+			if !s.AdditionalKeys {
+				addViolation(name, "key is not allowed")
+			} else if s.AdditionalMaxSize != 0 && len(value) > s.AdditionalMaxSize {
+				addViolation(name, "value is larger than %d bytes", s.AdditionalMaxSize)
+			}
+			continue
+		}
+		if value == "" {
+			continue
+		}
+		if key.MaxSize != 0 && len(value) > key.MaxSize {
+			addViolation(name, "value is larger than %d bytes", key.MaxSize)
+			continue
+		}
+		if len(key.Enum) != 0 && !containsKey(key.Enum, value) {
+			addViolation(name, "value must in [ %s ]", strings.Join(key.Enum, ", "))
+			continue
+		}
+		if key.Format != "" {
+			if err := formatValidators[key.Format](value); err != nil {
+				addViolation(name, "value is not a valid %s: %s", key.Format, err)
+			}
+		}
+	}
+
+	required := map[string]bool{}
+	for _, key := range s.Keys {
+		if key.Required {
+			required[key.Name] = true
+		}
+	}
+	for _, cond := range s.Conditions {
+		if value, _ := data[cond.Key].(string); value == cond.Value {
+			for _, name := range cond.Required {
+				required[name] = true
+			}
+		}
+	}
+	for name := range required {
+		if value, _ := data[name].(string); value == "" {
+			addViolation(name, "key is required")
+		}
+	}
+
+	if s.MaxSize != 0 && totalSize > s.MaxSize {
+		addViolation("data", "secret data is larger than %d bytes", s.MaxSize)
+	}
+
+	sort.SliceStable(violations, func(i, j int) bool { return violations[i].Field < violations[j].Field })
+	return violations
+}
+
+// ValidateSecretData checks the secret data by the schema of the registered secret type.
+// Types without schema and types not registered accept any data.
+func ValidateSecretData(typeName string, data map[string]interface{}) []FieldViolation {
+	t, err := lookupSecretType(typeName)
+	if err != nil {
+		return nil
+	}
+	return t.Schema.validate(data)
+}
+
+func validatePEM(value string) error {
+	block, rest := pem.Decode([]byte(value))
+	if block == nil {
+		return fmt.Errorf("no pem block found")
+	}
+	for len(strings.TrimSpace(string(rest))) != 0 {
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return fmt.Errorf("unexpected data after pem blocks")
+		}
+	}
+	return nil
+}
+
+func validateURL(value string) error {
+	u, err := url.Parse(value)
+	if err != nil {
+		return err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("scheme and host are required")
+	}
+	return nil
+}
+
+type kubeconfig struct {
+	Kind           string `yaml:"kind"`
+	CurrentContext string `yaml:"current-context"`
+	Clusters       []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			ClientCertificateData string `yaml:"client-certificate-data"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+}
+
+// KubeconfigInfo is the info of the current context of a kubeconfig.
+type KubeconfigInfo struct {
+	// Empty if the current context is not set
+	Context string
+	Cluster string
+	User    string
+	Server  string
+	// Zero if no cert is embedded
+	Expiry time.Time
+}
+
+func validateKubeconfig(value string) error {
+	_, err := ValidateKubeconfig(value, time.Now())
+	return err
+}
+
+// ValidateKubeconfig has following checks, and returns the info of the current context
+//
+// 1. The kubeconfig has clusters with valid servers and users
+// 2. The current context refers to a cluster and a user in the kubeconfig if it is set
+// 3. The certs embedded in the cluster and the user of the current context can be parsed and are not expired at now
+func ValidateKubeconfig(value string, now time.Time) (*KubeconfigInfo, error) {
+	config := &kubeconfig{}
+	if err := yaml.Unmarshal([]byte(value), config); err != nil {
+		return nil, err
+	}
+	if config.Kind != "" && config.Kind != "Config" {
+		return nil, fmt.Errorf("kind must be Config")
+	}
+	if len(config.Clusters) == 0 {
+		return nil, fmt.Errorf("no cluster found")
+	}
+	for _, cluster := range config.Clusters {
+		if err := validateURL(cluster.Cluster.Server); err != nil {
+			return nil, fmt.Errorf("server of cluster %s is invalid: %w", cluster.Name, err)
+		}
+	}
+	if len(config.Users) == 0 {
+		return nil, fmt.Errorf("no user found")
+	}
+
+	info := &KubeconfigInfo{Context: config.CurrentContext}
+	if info.Context == "" {
+		return info, nil
+	}
+	for _, c := range config.Contexts {
+		if c.Name == info.Context {
+			info.Cluster = c.Context.Cluster
+			info.User = c.Context.User
+			break
+		}
+	}
+	if info.Cluster == "" || info.User == "" {
+		return nil, fmt.Errorf("context %s is not found or has no cluster and user", info.Context)
+	}
+
+	var certData []string
+	foundCluster := false
+	for _, c := range config.Clusters {
+		if c.Name == info.Cluster {
+			foundCluster = true
+			info.Server = c.Cluster.Server
+			certData = append(certData, c.Cluster.CertificateAuthorityData)
+			break
+		}
+	}
+	if !foundCluster {
+		return nil, fmt.Errorf("cluster %s of context %s is not found", info.Cluster, info.Context)
+	}
+
+	foundUser := false
+	for _, u := range config.Users {
+		if u.Name == info.User {
+			foundUser = true
+			certData = append(certData, u.User.ClientCertificateData)
+			break
+		}
+	}
+	if !foundUser {
+		return nil, fmt.Errorf("user %s of context %s is not found", info.User, info.Context)
+	}
+
+	for _, data := range certData {
+		if data == "" {
+			continue
+		}
+		expiry, err := validateEmbeddedCert(data, now)
+		if err != nil {
+			return nil, err
+		}
+		if info.Expiry.IsZero() || expiry.Before(info.Expiry) {
+			info.Expiry = expiry
+		}
+	}
+	return info, nil
+}
+
+// validateEmbeddedCert parses the base64 encoded pem certs, it returns the earliest expiry of them.
+func validateEmbeddedCert(data string, now time.Time) (time.Time, error) {
+	rest, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("decode embedded cert failed: %w", err)
+	}
+
+	var expiry time.Time
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("parse embedded cert failed: %w", err)
+		}
+		if now.After(cert.NotAfter) {
+			return time.Time{}, fmt.Errorf("cert %s is expired at %s", cert.Subject.CommonName, cert.NotAfter.Format(time.RFC3339))
+		}
+		if expiry.IsZero() || cert.NotAfter.Before(expiry) {
+			expiry = cert.NotAfter
+		}
+	}
+	if expiry.IsZero() {
+		return time.Time{}, fmt.Errorf("no cert found in embedded cert data")
+	}
+	return expiry, nil
+}
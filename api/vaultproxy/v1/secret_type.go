@@ -0,0 +1,482 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+	"text/template/parse"
+)
+
+// SecretTypeDefinition declares a kind of secret.
+// Secrets of all registered types can be managed by the generic secret apis.
+type SecretTypeDefinition struct {
+	// Name of the type, it is used in the url of the generic secret apis
+	Name string
+	// KV v2 secret engine the secrets are stored in
+	SecretName string
+	// Go template of the secret path, fields are the keys of the request meta, such as "{{.id}}/{{.permission}}".
+	// Fields outside of the if actions are required.
+	PathTemplate string
+	// Go template of the policy name, fields are the same as the path template.
+	// The hash of the full path is appended to the rendered name, so the names of different secrets never collide.
+	PolicyNameTemplate string
+	// Go template of the policy, fields are FullPath, SecretName, SecretPath, Meta and Capabilities.
+	// Capabilities is a hcl list such as ["read"]. The policy grants the capabilities on the secret if it is empty.
+	PolicyTemplate string
+	// Capabilities of each value of the permission in the request meta, the permission is mapped to read if it is not found
+	PermissionCapabilities map[string][]string
+	// Schema of the secret data, any data is accepted if it is nil
+	Schema *SecretSchema
+}
+
+const defaultPolicyTemplate = `
+path "{{.FullPath}}" {
+	capabilities = {{.Capabilities}}
+}`
+
+var builtinSecretTypes = map[SecretType]SecretTypeDefinition{
+	GIT: {
+		Name:               GIT.String(),
+		SecretName:         GitSecretName,
+		PathTemplate:       "{{.provider_type}}/{{.id}}/{{.username}}/{{.permission}}",
+		PolicyNameTemplate: "{{.provider_type}}-{{.id}}-{{.username}}-{{.permission}}",
+		PolicyTemplate: `
+path "git/data/{{.SecretPath}}" {
+    capabilities = {{.Capabilities}}
+}
+
+path "git/metadata/{{.SecretPath}}" {
+    capabilities = ["read"]
+}`,
+		Schema:                 gitSecretSchema,
+		PermissionCapabilities: builtinPermissionCapabilities,
+	},
+	REPO: {
+		Name:               REPO.String(),
+		SecretName:         RepoSecretName,
+		PathTemplate:       "{{.provider_id}}/{{.product}}{{ if .project }}/{{.project}}{{ end }}",
+		PolicyNameTemplate: "{{.provider_id}}-{{.product}}{{ if .project }}-{{.project}}{{ end }}",
+		Schema:             repoSecretSchema,
+	},
+	CLUSTER: {
+		Name:               CLUSTER.String(),
+		SecretName:         ClusterSecretName,
+		PathTemplate:       "{{.type}}/{{.id}}/{{.username}}/{{.permission}}",
+		PolicyNameTemplate: "{{.type}}-{{.id}}-{{.username}}-{{.permission}}",
+		PolicyTemplate: `
+path "cluster/data/{{.SecretPath}}" {
+    capabilities = {{.Capabilities}}
+}
+
+path "auth/{{.Meta.id}}/role/*" {
+    capabilities = ["read"]
+}`,
+		Schema:                 clusterSecretSchema,
+		PermissionCapabilities: builtinPermissionCapabilities,
+	},
+	TENANTGIT: {
+		Name:                   TENANTGIT.String(),
+		SecretName:             TenantSecretName,
+		PathTemplate:           "git/{{.id}}/{{.permission}}",
+		PolicyNameTemplate:     "tenant-git-{{.id}}-{{.permission}}",
+		Schema:                 gitSecretSchema,
+		PermissionCapabilities: builtinPermissionCapabilities,
+	},
+	TENANTREPO: {
+		Name:                   TENANTREPO.String(),
+		SecretName:             TenantSecretName,
+		PathTemplate:           "repo/{{.id}}/{{.permission}}",
+		PolicyNameTemplate:     "tenant-repo-{{.id}}-{{.permission}}",
+		Schema:                 repoSecretSchema,
+		PermissionCapabilities: builtinPermissionCapabilities,
+	},
+	PKI: {
+		Name:               PKI.String(),
+		SecretName:         PkiSecretName,
+		PathTemplate:       "{{.domain}}",
+		PolicyNameTemplate: "pki-{{.domain}}",
+		Schema:             pkiSecretSchema,
+	},
+}
+
+// Length of the hash suffix of the policy names
+const policyNameHashLen = 12
+
+var (
+	secretTypeNameRegex = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+	secretTypes = struct {
+		sync.RWMutex
+		types map[string]*secretType
+	}{types: map[string]*secretType{}}
+)
+
+func init() {
+	for _, def := range builtinSecretTypes {
+		if err := RegisterSecretType(def); err != nil {
+			panic(err)
+		}
+	}
+}
+
+type secretType struct {
+	SecretTypeDefinition
+	path       *template.Template
+	policyName *template.Template
+	policy     *template.Template
+	// All the fields used by the path template
+	fields []string
+	// Fields must not be empty in the meta
+	requiredFields []string
+	// Matches the secret path and captures the fields in order,
+	// it is nil if the path template has actions other than fields and if actions without else.
+	pathRegex *regexp.Regexp
+	// Fields captured by the path regex in order, a field may appear more than once
+	pathFields []string
+}
+
+// RegisterSecretType adds a secret type, the registered type can not be replaced.
+func RegisterSecretType(def SecretTypeDefinition) error {
+	if !secretTypeNameRegex.MatchString(def.Name) {
+		return fmt.Errorf("secret type name %q is invalid", def.Name)
+	}
+	if def.SecretName == "" || def.PathTemplate == "" || def.PolicyNameTemplate == "" {
+		return fmt.Errorf("secret name, path template and policy name template of secret type %s are required", def.Name)
+	}
+
+	if err := def.Schema.check(); err != nil {
+		return fmt.Errorf("schema of secret type %s is invalid: %w", def.Name, err)
+	}
+
+	t := &secretType{SecretTypeDefinition: def}
+	var err error
+	if t.path, err = parseSecretTemplate(def.Name+"-path", def.PathTemplate); err != nil {
+		return err
+	}
+	if t.policyName, err = parseSecretTemplate(def.Name+"-policy-name", def.PolicyNameTemplate); err != nil {
+		return err
+	}
+	policyTemplate := def.PolicyTemplate
+	if policyTemplate == "" {
+		policyTemplate = defaultPolicyTemplate
+	}
+	if t.policy, err = parseSecretTemplate(def.Name+"-policy", policyTemplate); err != nil {
+		return err
+	}
+	t.parsePathTemplate()
+	if err := t.checkPolicy(); err != nil {
+		return fmt.Errorf("policy of secret type %s is invalid: %w", def.Name, err)
+	}
+
+	secretTypes.Lock()
+	defer secretTypes.Unlock()
+	if _, ok := secretTypes.types[def.Name]; ok {
+		return fmt.Errorf("secret type %s is already registered", def.Name)
+	}
+	secretTypes.types[def.Name] = t
+	return nil
+}
+
+// GetSecretType returns the definition of the registered secret type.
+func GetSecretType(name string) (SecretTypeDefinition, bool) {
+	t, err := lookupSecretType(name)
+	if err != nil {
+		return SecretTypeDefinition{}, false
+	}
+	return t.SecretTypeDefinition, true
+}
+
+// SecretNames returns the sorted secret engines used by the registered secret types.
+func SecretNames() []string {
+	secretTypes.RLock()
+	defer secretTypes.RUnlock()
+
+	found := map[string]bool{}
+	var names []string
+	for _, t := range secretTypes.types {
+		if !found[t.SecretName] {
+			found[t.SecretName] = true
+			names = append(names, t.SecretName)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func lookupSecretType(name string) (*secretType, error) {
+	secretTypes.RLock()
+	defer secretTypes.RUnlock()
+	t, ok := secretTypes.types[name]
+	if !ok {
+		return nil, fmt.Errorf("secret type %s is not registered", name)
+	}
+	return t, nil
+}
+
+func isBuiltinSecretType(name string) bool {
+	for s := range builtinSecretTypes {
+		if s.String() == name {
+			return true
+		}
+	}
+	return false
+}
+
+func getBuiltinSecretType(s SecretType) *secretType {
+	t, err := lookupSecretType(s.String())
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func parseSecretTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parse template %s failed: %w", name, err)
+	}
+	return tmpl, nil
+}
+
+func executeSecretTemplate(tmpl *template.Template, vars interface{}) (string, error) {
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, vars); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+// parsePathTemplate finds the fields of the path template,
+// and builds the regex to match the path if the template only has texts, fields and if actions without else.
+func (t *secretType) parsePathTemplate() {
+	pattern := "^"
+	reversible := true
+	seen := map[string]bool{}
+	addField := func(field string, required bool) {
+		if !seen[field] {
+			seen[field] = true
+			t.fields = append(t.fields, field)
+		}
+		if required {
+			t.requiredFields = append(t.requiredFields, field)
+		}
+	}
+
+	var walk func(node parse.Node, required bool)
+	walk = func(node parse.Node, required bool) {
+		switch n := node.(type) {
+		case *parse.ListNode:
+			for _, child := range n.Nodes {
+				walk(child, required)
+			}
+		case *parse.TextNode:
+			pattern += regexp.QuoteMeta(string(n.Text))
+		case *parse.ActionNode:
+			fields := pipeFields(n.Pipe)
+			if len(n.Pipe.Cmds) == 1 && len(n.Pipe.Cmds[0].Args) == 1 && len(fields) == 1 {
+				pattern += "([^/]+)"
+				t.pathFields = append(t.pathFields, fields[0])
+			} else {
+				reversible = false
+			}
+			for _, field := range fields {
+				addField(field, required)
+			}
+		case *parse.IfNode:
+			for _, field := range pipeFields(n.Pipe) {
+				addField(field, false)
+			}
+			// The part in if is optional in the path, such as the project of repo.
+			// Whether it matches the condition is checked by rendering the path again.
+			if n.ElseList != nil {
+				reversible = false
+				walk(n.List, false)
+				walk(n.ElseList, false)
+				return
+			}
+			pattern += "(?:"
+			walk(n.List, false)
+			pattern += ")?"
+		default:
+			reversible = false
+		}
+	}
+	walk(t.path.Tree.Root, true)
+
+	if reversible {
+		t.pathRegex = regexp.MustCompile(pattern + "$")
+	}
+}
+
+func pipeFields(pipe *parse.PipeNode) []string {
+	var fields []string
+	for _, cmd := range pipe.Cmds {
+		for _, arg := range cmd.Args {
+			if field, ok := arg.(*parse.FieldNode); ok && len(field.Ident) == 1 {
+				fields = append(fields, field.Ident[0])
+			}
+		}
+	}
+	return fields
+}
+
+func (t *secretType) getNames(meta map[string]string) (*SecretMeta, error) {
+	for _, field := range t.requiredFields {
+		if meta[field] == "" {
+			return nil, fmt.Errorf("%s of %s secret is required", field, t.Name)
+		}
+	}
+
+	secretPath, err := executeSecretTemplate(t.path, meta)
+	if err != nil {
+		return nil, err
+	}
+	legacyPolicyName, err := executeSecretTemplate(t.policyName, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	fullPath := fmt.Sprintf("%s/data/%s", t.SecretName, secretPath)
+	return &SecretMeta{
+		SecretName:       t.SecretName,
+		SecretPath:       secretPath,
+		SecretType:       t.Name,
+		FullPath:         fullPath,
+		PolicyName:       policyName(legacyPolicyName, fullPath),
+		LegacyPolicyName: legacyPolicyName,
+	}, nil
+}
+
+// policyName appends the hash of the full path to the rendered policy name.
+// The fields of the rendered name are joined by "-" which may also be in the fields,
+// such as provider "a-b" with id "c" and provider "a" with id "b-c",
+// but the full paths of different secrets are always different.
+func policyName(name, fullPath string) string {
+	sum := sha256.Sum256([]byte(fullPath))
+	return fmt.Sprintf("%s-%s", name, hex.EncodeToString(sum[:])[:policyNameHashLen])
+}
+
+// convertRequest converts the meta to the request with policy, the secret data is empty.
+func (t *secretType) convertRequest(meta map[string]string) (*SecretRequest, error) {
+	secretMeta, err := t.getNames(meta)
+	if err != nil {
+		return nil, err
+	}
+	policyData, err := executeSecretTemplate(t.policy, struct {
+		FullPath     string
+		SecretName   string
+		SecretPath   string
+		Meta         map[string]string
+		Capabilities string
+	}{
+		FullPath:     secretMeta.FullPath,
+		SecretName:   secretMeta.SecretName,
+		SecretPath:   secretMeta.SecretPath,
+		Meta:         meta,
+		Capabilities: formatCapabilities(t.capabilities(meta)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SecretRequest{
+		SecretMeta: *secretMeta,
+		PolicyData: policyData,
+	}, nil
+}
+
+// checkMeta checks the meta of the generic secret apis only has the fields of the path template,
+// and the values are single path segments.
+func (t *secretType) checkMeta(meta map[string]string) error {
+	for key, value := range meta {
+		if !containsKey(t.fields, key) {
+			return fmt.Errorf("%s is not a field of %s secret", key, t.Name)
+		}
+		if strings.Contains(value, "/") {
+			return fmt.Errorf("%s of %s secret must not contain \"/\"", key, t.Name)
+		}
+	}
+	return nil
+}
+
+// fromPath rebuilds the request from the secret path, it returns false if the path is not a secret of the type.
+func (t *secretType) fromPath(secretPath string) (*SecretRequest, bool) {
+	if t.pathRegex == nil {
+		return nil, false
+	}
+	match := t.pathRegex.FindStringSubmatch(secretPath)
+	if match == nil {
+		return nil, false
+	}
+
+	// An optional part which is not in the path captures an empty field
+	meta := map[string]string{}
+	for i, field := range t.pathFields {
+		if old, ok := meta[field]; ok && old != match[i+1] {
+			return nil, false
+		}
+		meta[field] = match[i+1]
+	}
+
+	secret, err := t.convertRequest(meta)
+	if err != nil || secret.SecretPath != secretPath {
+		return nil, false
+	}
+	return secret, true
+}
+
+// newSecretRequestFromRegistry rebuilds the request of a secret stored in the secret engine by the registered types
+// whose path can be matched. The built-in types are tried before the others if they share the secret engine.
+func newSecretRequestFromRegistry(secretName, secretPath string) (*SecretRequest, bool) {
+	secretTypes.RLock()
+	var candidates []*secretType
+	for _, t := range secretTypes.types {
+		if t.SecretName == secretName {
+			candidates = append(candidates, t)
+		}
+	}
+	secretTypes.RUnlock()
+	sort.Slice(candidates, func(i, j int) bool {
+		iBuiltin, jBuiltin := isBuiltinSecretType(candidates[i].Name), isBuiltinSecretType(candidates[j].Name)
+		if iBuiltin != jBuiltin {
+			return iBuiltin
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+
+	for _, t := range candidates {
+		if secret, ok := t.fromPath(secretPath); ok {
+			return secret, true
+		}
+	}
+	return nil, false
+}
+
+func containsKey(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
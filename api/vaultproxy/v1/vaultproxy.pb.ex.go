@@ -17,7 +17,10 @@ package v1
 import (
 	"bytes"
 	fmt "fmt"
+	"net/http"
+	"strings"
 	"text/template"
+	"time"
 )
 
 const (
@@ -25,6 +28,7 @@ const (
 	RepoSecretName    = "repo"
 	ClusterSecretName = "cluster"
 	TenantSecretName  = "tenant"
+	PkiSecretName     = "pki"
 )
 
 type SecretType int
@@ -35,6 +39,7 @@ const (
 	CLUSTER
 	TENANTGIT
 	TENANTREPO
+	PKI
 )
 
 func (s SecretType) String() string {
@@ -49,81 +54,18 @@ func (s SecretType) String() string {
 		return "tenant-git"
 	case TENANTREPO:
 		return "tenant-repo"
+	case PKI:
+		return "pki"
 	}
 	return ""
 }
 
-var (
-	SecretPolicy = `
-path "%s" {
-	capabilities = ["read"]
-}`
-
-	GitPolicy = `
-path "git/data/%[1]s" {
-    capabilities = ["read"]
-}
-
-path "git/metadata/%[1]s" {
-    capabilities = ["read"]
-}`
-
-	ClusterPolicy = `
-path "cluster/data/%s" {
-    capabilities = ["read"]
-}
-
-path "auth/%s/role/*" {
-    capabilities = ["read"]
-}`
-)
-
 type VaultTemplate struct {
 	name     string
 	template string
 }
 
 var (
-	GitPathTemplate = VaultTemplate{
-		name:     "gitPath",
-		template: "{{.ProviderType}}/{{.Id}}/{{.Username}}/{{.Permission}}",
-	}
-	GitPolicyPathTemplate = VaultTemplate{
-		name:     "gitPolicyPath",
-		template: "{{.ProviderType}}-{{.Id}}-{{.Username}}-{{.Permission}}",
-	}
-	RepoPathTemplate = VaultTemplate{
-		name:     "repoPath",
-		template: "{{.ProviderId}}/{{.Product}}{{ if ne .Project \"\" }}/{{.Project}}{{ end }}",
-	}
-	RepoPolicyPathTemplate = VaultTemplate{
-		name:     "repoPolicy",
-		template: "{{.ProviderId}}-{{.Product}}{{ if ne .Project \"\" }}-{{.Project}}{{ end }}",
-	}
-	ClusterPathTemplate = VaultTemplate{
-		name:     "clusterPath",
-		template: "{{.Type}}/{{.Id}}/{{.Username}}/{{.Permission}}",
-	}
-	ClusterPolicyPathTemplate = VaultTemplate{
-		name:     "clusterPolicyPath",
-		template: "{{.Type}}-{{.Id}}-{{.Username}}-{{.Permission}}",
-	}
-	TenantGitPathTemplate = VaultTemplate{
-		name:     "tenantGitPath",
-		template: "git/{{.Id}}/{{.Permission}}",
-	}
-	TenantGitPolicyPathTemplate = VaultTemplate{
-		name:     "tenantGitPolicyPath",
-		template: "tenant-git-{{.Id}}-{{.Permission}}",
-	}
-	TenantRepoPathTemplate = VaultTemplate{
-		name:     "tenantRepoPath",
-		template: "repo/{{.Id}}/{{.Permission}}",
-	}
-	TenantRepoPolicyPathTemplate = VaultTemplate{
-		name:     "tenantRepoPolicyPath",
-		template: "tenant-repo-{{.Id}}-{{.Permission}}",
-	}
 	RolePathTemplate = VaultTemplate{
 		name:     "rolePath",
 		template: "auth/{{.ClusterName}}/role/{{.Projectid}}",
@@ -200,11 +142,69 @@ func (x *ClusterAccount) getData() map[string]interface{} {
 	return data
 }
 
+func (x *PkiRequest) getData() map[string]interface{} {
+	data := make(map[string]interface{})
+	if x.GetCacert() != "" {
+		data["cacert"] = x.GetCacert()
+	}
+	if x.GetCert() != "" {
+		data["cert"] = x.GetCert()
+	}
+	if x.GetKey() != "" {
+		data["key"] = x.GetKey()
+	}
+	return data
+}
+
 // Store the key info for authorize and vault request
 type SecretRequest struct {
 	SecretMeta
 	SecretData map[string]interface{}
 	PolicyData string
+	// CheckAndSet is the expected current version of the secret, nil means writing without check
+	CheckAndSet *int
+	// ExpireTime is the time the secret is purged, nil means the expire time of the secret is not changed
+	ExpireTime *time.Time
+}
+
+func getCheckAndSet(version *int32) *int {
+	if version == nil {
+		return nil
+	}
+	cas := int(*version)
+	return &cas
+}
+
+// getExpireTime converts the ttl or the expire time in RFC3339 format to the time the secret is purged.
+// The expire time must be in the future, it is nil if neither is set.
+func getExpireTime(ttl, expireTime string) (*time.Time, error) {
+	var t time.Time
+	switch {
+	case ttl != "" && expireTime != "":
+		return nil, fmt.Errorf("ttl and expire time can not be set together")
+	case ttl != "":
+		d, err := time.ParseDuration(ttl)
+		if err != nil {
+			return nil, fmt.Errorf("ttl %s is invalid: %w", ttl, err)
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("ttl %s must be positive", ttl)
+		}
+		t = time.Now().Add(d)
+	case expireTime != "":
+		var err error
+		t, err = time.Parse(time.RFC3339, expireTime)
+		if err != nil {
+			return nil, fmt.Errorf("expire time %s is invalid: %w", expireTime, err)
+		}
+		if !t.After(time.Now()) {
+			return nil, fmt.Errorf("expire time %s is not in the future", expireTime)
+		}
+	default:
+		return nil, nil
+	}
+	t = t.UTC().Truncate(time.Second)
+	return &t, nil
 }
 
 type SecRequest interface {
@@ -212,209 +212,596 @@ type SecRequest interface {
 }
 
 type SecretMeta struct {
-	SecretName string // secret name , use for vault api
-	SecretPath string // secret path , use for vault api
-	SecretType string // secret data type
-	FullPath   string // full path of secret use for create policy and authorize
-	PolicyName string // vault policy name, use for authorize and policy create
+	SecretName       string // secret name , use for vault api
+	SecretPath       string // secret path , use for vault api
+	SecretType       string // secret data type
+	FullPath         string // full path of secret use for create policy and authorize
+	PolicyName       string // vault policy name, use for authorize and policy create
+	LegacyPolicyName string // vault policy name without the hash suffix, use for migrating the policies created before
 }
 
-func (x *GitMeta) GetNames() (*SecretMeta, error) {
-	secretName := GitSecretName
-	secretPath, err := GetPath(x, GitPathTemplate)
-	if err != nil {
-		return nil, err
-	}
-	fullPath := fmt.Sprintf("%s/data/%s", secretName, secretPath)
-	policyName, err := GetPath(x, GitPolicyPathTemplate)
-	if err != nil {
-		return nil, err
+// secretTypeMeta is the meta of a secret in a built-in type.
+type secretTypeMeta interface {
+	secretType() SecretType
+	getMeta() map[string]string
+}
+
+func getSecretNames(meta secretTypeMeta) (*SecretMeta, error) {
+	return getBuiltinSecretType(meta.secretType()).getNames(meta.getMeta())
+}
+
+// convertMetaRequest converts the meta of a built-in type to the request with policy,
+// the secret data is always empty. It is used by requests which only need the names of the secret.
+func convertMetaRequest(meta secretTypeMeta) (*SecretRequest, error) {
+	return getBuiltinSecretType(meta.secretType()).convertRequest(meta.getMeta())
+}
+
+func (x *GitMeta) secretType() SecretType {
+	return GIT
+}
+
+func (x *GitMeta) getMeta() map[string]string {
+	return map[string]string{
+		"provider_type": x.GetProviderType(),
+		"id":            x.GetId(),
+		"username":      x.GetUsername(),
+		"permission":    x.GetPermission(),
 	}
+}
 
-	return &SecretMeta{
-		SecretName: secretName,
-		SecretPath: secretPath,
-		SecretType: GIT.String(),
-		FullPath:   fullPath,
-		PolicyName: policyName,
-	}, nil
+func (x *GitMeta) GetNames() (*SecretMeta, error) {
+	return getSecretNames(x)
 }
 
 func (x *GitRequest) ConvertRequest() (*SecretRequest, error) {
-	secretMeta, err := x.Meta.GetNames()
+	secret, err := convertMetaRequest(x.GetMeta())
 	if err != nil {
 		return nil, err
 	}
 
-	secretData := make(map[string]interface{}, 0)
+	secret.SecretData = make(map[string]interface{}, 0)
 	if x.Kvs != nil {
-		secretData = x.Kvs.getData()
+		secret.SecretData = x.Kvs.getData()
 	}
-	policyData := fmt.Sprintf(GitPolicy, secretMeta.SecretPath)
+	secret.CheckAndSet = getCheckAndSet(x.ExpectedVersion)
+	if secret.ExpireTime, err = getExpireTime(x.GetTtl(), x.GetExpireTime()); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
 
-	return &SecretRequest{
-		SecretMeta: *secretMeta,
-		SecretData: secretData,
-		PolicyData: policyData,
-	}, nil
+func (x *RepoMeta) secretType() SecretType {
+	return REPO
 }
 
-func (x *RepoMeta) GetNames() (*SecretMeta, error) {
-	secretName := RepoSecretName
-	secretPath, err := GetPath(x, RepoPathTemplate)
-	if err != nil {
-		return nil, err
-	}
-	fullPath := fmt.Sprintf("%s/data/%s", secretName, secretPath)
-	policyName, err := GetPath(x, RepoPolicyPathTemplate)
-	if err != nil {
-		return nil, err
+func (x *RepoMeta) getMeta() map[string]string {
+	return map[string]string{
+		"provider_id": x.GetProviderId(),
+		"product":     x.GetProduct(),
+		"project":     x.GetProject(),
 	}
+}
 
-	return &SecretMeta{
-		SecretName: secretName,
-		SecretPath: secretPath,
-		SecretType: REPO.String(),
-		FullPath:   fullPath,
-		PolicyName: policyName,
-	}, nil
+func (x *RepoMeta) GetNames() (*SecretMeta, error) {
+	return getSecretNames(x)
 }
 
 func (x *RepoRequest) ConvertRequest() (*SecretRequest, error) {
-	secretMeta, err := x.Meta.GetNames()
+	secret, err := convertMetaRequest(x.GetMeta())
 	if err != nil {
 		return nil, err
 	}
 
-	secretData := make(map[string]interface{}, 0)
+	secret.SecretData = make(map[string]interface{}, 0)
 	account := x.GetAccount()
 	if account != nil {
-		secretData = x.GetAccount().getData()
+		secret.SecretData = account.getData()
 	}
+	secret.CheckAndSet = getCheckAndSet(x.ExpectedVersion)
+	if secret.ExpireTime, err = getExpireTime(x.GetTtl(), x.GetExpireTime()); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
 
-	policyData := fmt.Sprintf(SecretPolicy, secretMeta.FullPath)
+func (x *ClusterMeta) secretType() SecretType {
+	return CLUSTER
+}
 
-	return &SecretRequest{
-		SecretMeta: *secretMeta,
-		SecretData: secretData,
-		PolicyData: policyData,
-	}, nil
+func (x *ClusterMeta) getMeta() map[string]string {
+	return map[string]string{
+		"type":       x.GetType(),
+		"id":         x.GetId(),
+		"username":   x.GetUsername(),
+		"permission": x.GetPermission(),
+	}
 }
 
 func (x *ClusterMeta) GetNames() (*SecretMeta, error) {
-	secretName := ClusterSecretName
-	secretPath, err := GetPath(x, ClusterPathTemplate)
+	return getSecretNames(x)
+}
+
+func (x *ClusterRequest) ConvertRequest() (*SecretRequest, error) {
+	secret, err := convertMetaRequest(x.GetMeta())
 	if err != nil {
 		return nil, err
 	}
-	fullPath := fmt.Sprintf("%s/data/%s", secretName, secretPath)
-	policyName, err := GetPath(x, ClusterPolicyPathTemplate)
-	if err != nil {
+
+	secret.SecretData = x.GetAccount().getData()
+	secret.CheckAndSet = getCheckAndSet(x.ExpectedVersion)
+	if secret.ExpireTime, err = getExpireTime(x.GetTtl(), x.GetExpireTime()); err != nil {
 		return nil, err
 	}
+	return secret, nil
+}
 
-	return &SecretMeta{
-		SecretName: secretName,
-		SecretPath: secretPath,
-		SecretType: CLUSTER.String(),
-		FullPath:   fullPath,
-		PolicyName: policyName,
-	}, nil
+func (x *TenantGitMeta) secretType() SecretType {
+	return TENANTGIT
 }
 
-func (x *ClusterRequest) ConvertRequest() (*SecretRequest, error) {
-	secretMeta, err := x.Meta.GetNames()
+func (x *TenantGitMeta) getMeta() map[string]string {
+	return map[string]string{
+		"id":         x.GetId(),
+		"permission": x.GetPermission(),
+	}
+}
+
+func (x *TenantGitMeta) GetNames() (*SecretMeta, error) {
+	return getSecretNames(x)
+}
+
+func (x *TenantGitRequest) ConvertRequest() (*SecretRequest, error) {
+	secret, err := convertMetaRequest(x.GetMeta())
 	if err != nil {
 		return nil, err
 	}
 
-	secretData := x.GetAccount().getData()
-	policyData := fmt.Sprintf(ClusterPolicy, secretMeta.SecretPath, x.Meta.GetId())
+	secret.SecretData = make(map[string]interface{}, 0)
+	if x.Kvs != nil {
+		secret.SecretData = x.Kvs.getData()
+	}
+	secret.CheckAndSet = getCheckAndSet(x.ExpectedVersion)
+	if secret.ExpireTime, err = getExpireTime(x.GetTtl(), x.GetExpireTime()); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
 
-	return &SecretRequest{
-		SecretMeta: *secretMeta,
-		SecretData: secretData,
-		PolicyData: policyData,
-	}, nil
+func (x *TenantRepoMeta) secretType() SecretType {
+	return TENANTREPO
 }
 
-func (x *TenantGitMeta) GetNames() (*SecretMeta, error) {
-	secretName := TenantSecretName
-	secretPath, err := GetPath(x, TenantGitPathTemplate)
+func (x *TenantRepoMeta) getMeta() map[string]string {
+	return map[string]string{
+		"id":         x.GetId(),
+		"permission": x.GetPermission(),
+	}
+}
+
+func (x *TenantRepoMeta) GetNames() (*SecretMeta, error) {
+	return getSecretNames(x)
+}
+
+func (x *TenantRepoRequest) ConvertRequest() (*SecretRequest, error) {
+	secret, err := convertMetaRequest(x.GetMeta())
 	if err != nil {
 		return nil, err
 	}
-	fullPath := fmt.Sprintf("%s/data/%s", secretName, secretPath)
-	policyName, err := GetPath(x, TenantGitPolicyPathTemplate)
-	if err != nil {
+
+	secret.SecretData = x.GetAccount().getData()
+	secret.CheckAndSet = getCheckAndSet(x.ExpectedVersion)
+	if secret.ExpireTime, err = getExpireTime(x.GetTtl(), x.GetExpireTime()); err != nil {
 		return nil, err
 	}
+	return secret, nil
+}
 
-	return &SecretMeta{
-		SecretName: secretName,
-		SecretPath: secretPath,
-		SecretType: TENANTGIT.String(),
-		FullPath:   fullPath,
-		PolicyName: policyName,
-	}, nil
+func (x *PkiRequest) secretType() SecretType {
+	return PKI
 }
 
-func (x *TenantGitRequest) ConvertRequest() (*SecretRequest, error) {
-	secretMeta, err := x.Meta.GetNames()
+func (x *PkiRequest) getMeta() map[string]string {
+	return map[string]string{
+		"domain": x.GetDomain(),
+	}
+}
+
+func (x *PkiRequest) GetNames() (*SecretMeta, error) {
+	return getSecretNames(x)
+}
+
+func (x *PkiRequest) ConvertRequest() (*SecretRequest, error) {
+	secret, err := convertMetaRequest(x)
 	if err != nil {
 		return nil, err
 	}
 
-	secretData := make(map[string]interface{}, 0)
-	if x.Kvs != nil {
-		secretData = x.Kvs.getData()
+	secret.SecretData = x.getData()
+	secret.CheckAndSet = getCheckAndSet(x.ExpectedVersion)
+	if secret.ExpireTime, err = getExpireTime(x.GetTtl(), x.GetExpireTime()); err != nil {
+		return nil, err
 	}
-	policyData := fmt.Sprintf(SecretPolicy, secretMeta.FullPath)
+	return secret, nil
+}
 
-	return &SecretRequest{
-		SecretMeta: *secretMeta,
-		SecretData: secretData,
-		PolicyData: policyData,
-	}, nil
+func (x *GetGitRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(x.GetMeta())
 }
 
-func (x *TenantRepoMeta) GetNames() (*SecretMeta, error) {
-	secretName := TenantSecretName
-	secretPath, err := GetPath(x, TenantRepoPathTemplate)
+func (x *GetRepoRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(x.GetMeta())
+}
+
+func (x *GetClusterRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(x.GetMeta())
+}
+
+func (x *GetTenantGitRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(x.GetMeta())
+}
+
+func (x *GetTenantRepoRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(x.GetMeta())
+}
+
+// NewSecretRequestFromPath rebuilds the request of a secret stored in vault,
+// it is used to find the policy of an existing secret. The secret data is always empty.
+func NewSecretRequestFromPath(secretName, secretPath string) (*SecretRequest, error) {
+	secret, ok := newSecretRequestFromRegistry(secretName, secretPath)
+	if !ok {
+		return nil, fmt.Errorf("%s in %s is not a managed secret", secretPath, secretName)
+	}
+	return secret, nil
+}
+
+// ActionRollback is the action of rollback requests in resource acl.
+const ActionRollback = "ROLLBACK"
+
+// SecActionRequest is a request whose action in resource acl is not the http method.
+type SecActionRequest interface {
+	SecRequest
+	Action() string
+}
+
+// SecRollbackRequest is a request to roll back a secret to the version.
+type SecRollbackRequest interface {
+	SecActionRequest
+	GetVersion() int32
+}
+
+func (x *RollbackGitRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(x.GetMeta())
+}
+
+func (x *RollbackGitRequest) Action() string {
+	return ActionRollback
+}
+
+func (x *RollbackRepoRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(x.GetMeta())
+}
+
+func (x *RollbackRepoRequest) Action() string {
+	return ActionRollback
+}
+
+func (x *RollbackClusterRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(x.GetMeta())
+}
+
+func (x *RollbackClusterRequest) Action() string {
+	return ActionRollback
+}
+
+func (x *RollbackTenantGitRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(x.GetMeta())
+}
+
+func (x *RollbackTenantGitRequest) Action() string {
+	return ActionRollback
+}
+
+func (x *RollbackTenantRepoRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(x.GetMeta())
+}
+
+func (x *RollbackTenantRepoRequest) Action() string {
+	return ActionRollback
+}
+
+// Actions of undelete and purge requests in resource acl.
+// A delete request only deletes the latest version, purge destroys all the versions and the metadata.
+const (
+	ActionUndelete = "UNDELETE"
+	ActionPurge    = "PURGE"
+)
+
+func (x *UndeleteGitRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(x.GetMeta())
+}
+
+func (x *UndeleteGitRequest) Action() string {
+	return ActionUndelete
+}
+
+func (x *PurgeGitRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(x.GetMeta())
+}
+
+func (x *PurgeGitRequest) Action() string {
+	return ActionPurge
+}
+
+func (x *UndeleteRepoRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(x.GetMeta())
+}
+
+func (x *UndeleteRepoRequest) Action() string {
+	return ActionUndelete
+}
+
+func (x *PurgeRepoRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(x.GetMeta())
+}
+
+func (x *PurgeRepoRequest) Action() string {
+	return ActionPurge
+}
+
+func (x *UndeleteClusterRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(x.GetMeta())
+}
+
+func (x *UndeleteClusterRequest) Action() string {
+	return ActionUndelete
+}
+
+func (x *PurgeClusterRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(x.GetMeta())
+}
+
+func (x *PurgeClusterRequest) Action() string {
+	return ActionPurge
+}
+
+func (x *UndeleteTenantGitRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(x.GetMeta())
+}
+
+func (x *UndeleteTenantGitRequest) Action() string {
+	return ActionUndelete
+}
+
+func (x *PurgeTenantGitRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(x.GetMeta())
+}
+
+func (x *PurgeTenantGitRequest) Action() string {
+	return ActionPurge
+}
+
+func (x *UndeleteTenantRepoRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(x.GetMeta())
+}
+
+func (x *UndeleteTenantRepoRequest) Action() string {
+	return ActionUndelete
+}
+
+func (x *PurgeTenantRepoRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(x.GetMeta())
+}
+
+func (x *PurgeTenantRepoRequest) Action() string {
+	return ActionPurge
+}
+
+func (x *UndeletePkiRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(&PkiRequest{Domain: x.GetDomain()})
+}
+
+func (x *UndeletePkiRequest) Action() string {
+	return ActionUndelete
+}
+
+func (x *PurgePkiRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(&PkiRequest{Domain: x.GetDomain()})
+}
+
+func (x *PurgePkiRequest) Action() string {
+	return ActionPurge
+}
+
+// ActionRead is the action of the requests which read the secret values in resource acl.
+const ActionRead = "READ"
+
+// DockerConfigRequest is a request to render the docker config of a repo account.
+type DockerConfigRequest interface {
+	SecActionRequest
+	// GetProvider returns the provider id which the registry host is configured by
+	GetProvider() string
+	GetName() string
+	GetNamespace() string
+}
+
+func (x *RenderRepoDockerConfigRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(x.GetMeta())
+}
+
+func (x *RenderRepoDockerConfigRequest) Action() string {
+	return ActionRead
+}
+
+func (x *RenderRepoDockerConfigRequest) GetProvider() string {
+	return x.GetMeta().GetProviderId()
+}
+
+func (x *RenderTenantRepoDockerConfigRequest) ConvertRequest() (*SecretRequest, error) {
+	return convertMetaRequest(x.GetMeta())
+}
+
+func (x *RenderTenantRepoDockerConfigRequest) Action() string {
+	return ActionRead
+}
+
+func (x *RenderTenantRepoDockerConfigRequest) GetProvider() string {
+	return x.GetMeta().GetId()
+}
+
+// convertGenericRequest converts the request of the generic secret apis by the registered secret type.
+func convertGenericRequest(typeName string, meta map[string]string) (*SecretRequest, *secretType, error) {
+	t, err := lookupSecretType(typeName)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	fullPath := fmt.Sprintf("%s/data/%s", secretName, secretPath)
-	policyName, err := GetPath(x, TenantRepoPolicyPathTemplate)
+	if err := t.checkMeta(meta); err != nil {
+		return nil, nil, err
+	}
+	secret, err := t.convertRequest(meta)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-
-	return &SecretMeta{
-		SecretName: secretName,
-		SecretPath: secretPath,
-		SecretType: TENANTREPO.String(),
-		FullPath:   fullPath,
-		PolicyName: policyName,
-	}, nil
+	return secret, t, nil
 }
 
-func (x *TenantRepoRequest) ConvertRequest() (*SecretRequest, error) {
-	secretMeta, err := x.Meta.GetNames()
+func (x *CreateSecretRequest) ConvertRequest() (*SecretRequest, error) {
+	secret, _, err := convertGenericRequest(x.GetType(), x.GetMeta())
 	if err != nil {
 		return nil, err
 	}
 
-	secretData := x.GetAccount().getData()
-	policyData := fmt.Sprintf(SecretPolicy, secretMeta.FullPath)
+	secret.SecretData = make(map[string]interface{}, len(x.GetData()))
+	for k, v := range x.GetData() {
+		secret.SecretData[k] = v
+	}
+	secret.CheckAndSet = getCheckAndSet(x.ExpectedVersion)
+	if secret.ExpireTime, err = getExpireTime(x.GetTtl(), x.GetExpireTime()); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+func (x *GetSecretRequest) ConvertRequest() (*SecretRequest, error) {
+	secret, _, err := convertGenericRequest(x.GetType(), x.GetMeta())
+	return secret, err
+}
+
+func (x *DeleteSecretRequest) ConvertRequest() (*SecretRequest, error) {
+	secret, _, err := convertGenericRequest(x.GetType(), x.GetMeta())
+	return secret, err
+}
+
+func (x *UndeleteSecretRequest) ConvertRequest() (*SecretRequest, error) {
+	secret, _, err := convertGenericRequest(x.GetType(), x.GetMeta())
+	return secret, err
+}
+
+func (x *UndeleteSecretRequest) Action() string {
+	return ActionUndelete
+}
+
+func (x *PurgeSecretRequest) ConvertRequest() (*SecretRequest, error) {
+	secret, _, err := convertGenericRequest(x.GetType(), x.GetMeta())
+	return secret, err
+}
+
+func (x *PurgeSecretRequest) Action() string {
+	return ActionPurge
+}
+
+func (x *UpdateSecretOwnersRequest) ConvertRequest() (*SecretRequest, error) {
+	secret, _, err := convertGenericRequest(x.GetType(), x.GetMeta())
+	return secret, err
+}
+
+// SecBatchRequest is a request to run the action on several secrets.
+// Each secret is authorized by the action in resource acl before any of them is run.
+type SecBatchRequest interface {
+	SecRequests() []SecRequest
+	Action() string
+	GetAtomic() bool
+}
+
+func (x *BatchCreateSecretsRequest) SecRequests() []SecRequest {
+	reqs := make([]SecRequest, 0, len(x.GetItems()))
+	for _, item := range x.GetItems() {
+		reqs = append(reqs, item)
+	}
+	return reqs
+}
+
+func (x *BatchCreateSecretsRequest) Action() string {
+	return http.MethodPost
+}
+
+func (x *BatchDeleteSecretsRequest) SecRequests() []SecRequest {
+	reqs := make([]SecRequest, 0, len(x.GetItems()))
+	for _, item := range x.GetItems() {
+		reqs = append(reqs, item)
+	}
+	return reqs
+}
+
+func (x *BatchDeleteSecretsRequest) Action() string {
+	return http.MethodDelete
+}
 
+// SecListRequest is a request to list the secrets under a path prefix.
+// The SecretPath of the converted request is the prefix.
+type SecListRequest interface {
+	SecRequest
+	GetPageSize() int32
+	GetPageToken() string
+}
+
+// getPrefix joins the leading non-empty parts of a path,
+// parts after the first empty one are ignored.
+func getPrefix(parts ...string) string {
+	var prefix []string
+	for _, part := range parts {
+		if part == "" {
+			break
+		}
+		prefix = append(prefix, part)
+	}
+	return strings.Join(prefix, "/")
+}
+
+func convertListRequest(secretName, prefix string) (*SecretRequest, error) {
 	return &SecretRequest{
-		SecretMeta: *secretMeta,
-		SecretData: secretData,
-		PolicyData: policyData,
+		SecretMeta: SecretMeta{
+			SecretName: secretName,
+			SecretPath: prefix,
+			FullPath:   fmt.Sprintf("%s/data/%s", secretName, prefix),
+		},
 	}, nil
 }
 
+func (x *ListGitRequest) ConvertRequest() (*SecretRequest, error) {
+	prefix := getPrefix(x.ProviderType, x.Id, x.Username, x.Permission)
+	return convertListRequest(GitSecretName, prefix)
+}
+
+func (x *ListRepoRequest) ConvertRequest() (*SecretRequest, error) {
+	prefix := getPrefix(x.ProviderId, x.Product, x.Project)
+	return convertListRequest(RepoSecretName, prefix)
+}
+
+func (x *ListClusterRequest) ConvertRequest() (*SecretRequest, error) {
+	prefix := getPrefix(x.Type, x.Id, x.Username, x.Permission)
+	return convertListRequest(ClusterSecretName, prefix)
+}
+
+func (x *ListTenantGitRequest) ConvertRequest() (*SecretRequest, error) {
+	prefix := getPrefix("git", x.Id, x.Permission)
+	return convertListRequest(TenantSecretName, prefix)
+}
+
+func (x *ListTenantRepoRequest) ConvertRequest() (*SecretRequest, error) {
+	prefix := getPrefix("repo", x.Id, x.Permission)
+	return convertListRequest(TenantSecretName, prefix)
+}
+
 func (x *AuthRequest) ConvertRequest() (*SecretRequest, error) {
 	fullPath := fmt.Sprintf("auth/%s", x.ClusterName)
 
@@ -497,3 +884,123 @@ func (req *AuthroleTenantRepoPolicyRequest) ConvertToAuthPolicyReqeuest() (*Gran
 	}
 	return ConvertAuthGrantRequest(req.ClusterName, req.DestUser, secretMeta)
 }
+
+// DriftPath is the resource of the drift requests in resource acl.
+const DriftPath = "sys/drift"
+
+func (x *GetDriftRequest) ConvertRequest() (*SecretRequest, error) {
+	return &SecretRequest{SecretMeta: SecretMeta{FullPath: DriftPath}}, nil
+}
+
+func (x *ReconcileRequest) ConvertRequest() (*SecretRequest, error) {
+	return &SecretRequest{SecretMeta: SecretMeta{FullPath: DriftPath}}, nil
+}
+
+// ExpirationsPath is the resource of the expiration requests in resource acl.
+const ExpirationsPath = "sys/expirations"
+
+func (x *ListExpirationsRequest) ConvertRequest() (*SecretRequest, error) {
+	return &SecretRequest{SecretMeta: SecretMeta{FullPath: ExpirationsPath}}, nil
+}
+
+// RotationsPath is the resource of the rotation report requests in resource acl.
+const RotationsPath = "sys/rotations"
+
+func (x *ListRotationsRequest) ConvertRequest() (*SecretRequest, error) {
+	return &SecretRequest{SecretMeta: SecretMeta{FullPath: RotationsPath}}, nil
+}
+
+func (x *ExportRotationsRequest) ConvertRequest() (*SecretRequest, error) {
+	return &SecretRequest{SecretMeta: SecretMeta{FullPath: RotationsPath}}, nil
+}
+
+// DryRunReply is a reply of the request which supports dry run, the plan is set when the request is a dry run.
+type DryRunReply interface {
+	SetDryRun(plan *DryRunPlan)
+}
+
+func (x *CreateGitReply) SetDryRun(plan *DryRunPlan) {
+	x.DryRun = plan
+}
+
+func (x *DeleteGitReply) SetDryRun(plan *DryRunPlan) {
+	x.DryRun = plan
+}
+
+func (x *CreatePkiReply) SetDryRun(plan *DryRunPlan) {
+	x.DryRun = plan
+}
+
+func (x *DeletePkiReply) SetDryRun(plan *DryRunPlan) {
+	x.DryRun = plan
+}
+
+func (x *CreateRepoReply) SetDryRun(plan *DryRunPlan) {
+	x.DryRun = plan
+}
+
+func (x *DeleteRepoReply) SetDryRun(plan *DryRunPlan) {
+	x.DryRun = plan
+}
+
+func (x *CreateClusterReply) SetDryRun(plan *DryRunPlan) {
+	x.DryRun = plan
+}
+
+func (x *DeleteClusterReply) SetDryRun(plan *DryRunPlan) {
+	x.DryRun = plan
+}
+
+func (x *CreateTenantGitReply) SetDryRun(plan *DryRunPlan) {
+	x.DryRun = plan
+}
+
+func (x *DeleteTenantGitReply) SetDryRun(plan *DryRunPlan) {
+	x.DryRun = plan
+}
+
+func (x *CreateTenantRepoReply) SetDryRun(plan *DryRunPlan) {
+	x.DryRun = plan
+}
+
+func (x *DeleteTenantRepoReply) SetDryRun(plan *DryRunPlan) {
+	x.DryRun = plan
+}
+
+func (x *CreateSecretReply) SetDryRun(plan *DryRunPlan) {
+	x.DryRun = plan
+}
+
+func (x *DeleteSecretReply) SetDryRun(plan *DryRunPlan) {
+	x.DryRun = plan
+}
+
+func (x *CreateAuthReply) SetDryRun(plan *DryRunPlan) {
+	x.DryRun = plan
+}
+
+func (x *CreateAuthroleReply) SetDryRun(plan *DryRunPlan) {
+	x.DryRun = plan
+}
+
+func (x *GrantAuthrolePolicyReply) SetDryRun(plan *DryRunPlan) {
+	x.DryRun = plan
+}
+
+func (x *RevokeAuthrolePolicyReply) SetDryRun(plan *DryRunPlan) {
+	x.DryRun = plan
+}
+
+// GeneratedReply is a reply which may return the secret material generated by the request, such as a generated password.
+// The material is only returned by the reply of the request, it is never stored or replayed.
+type GeneratedReply interface {
+	HasGenerated() bool
+}
+
+func (x *CreateRepoReply) HasGenerated() bool {
+	return x.GetPassword() != ""
+}
+
+func (x *CreateTenantRepoReply) HasGenerated() bool {
+	return x.GetPassword() != ""
+}
@@ -45,6 +45,8 @@ const (
 	ErrorReason_RESOURCE_NOT_FOUND     ErrorReason = 2
 	ErrorReason_INPUT_ARG_ERROR        ErrorReason = 3
 	ErrorReason_INTERNAL_SERVICE_ERROR ErrorReason = 4
+	ErrorReason_VERSION_CONFLICT       ErrorReason = 5
+	ErrorReason_IDEMPOTENCY_CONFLICT   ErrorReason = 6
 )
 
 // Enum value maps for ErrorReason.
@@ -55,6 +57,8 @@ var (
 		2: "RESOURCE_NOT_FOUND",
 		3: "INPUT_ARG_ERROR",
 		4: "INTERNAL_SERVICE_ERROR",
+		5: "VERSION_CONFLICT",
+		6: "IDEMPOTENCY_CONFLICT",
 	}
 	ErrorReason_value = map[string]int32{
 		"AUTH_FAILED":            0,
@@ -62,6 +66,8 @@ var (
 		"RESOURCE_NOT_FOUND":     2,
 		"INPUT_ARG_ERROR":        3,
 		"INTERNAL_SERVICE_ERROR": 4,
+		"VERSION_CONFLICT":       5,
+		"IDEMPOTENCY_CONFLICT":   6,
 	}
 )
 
@@ -92,18 +98,25 @@ func (ErrorReason) EnumDescriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{0}
 }
 
-type SecretInfo struct {
+// Changes a dry run request would make in vault, nothing is written
+type DryRunPlan struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Path    string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Version int32  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	SecretName string `protobuf:"bytes,1,opt,name=secret_name,proto3" json:"secret_name,omitempty"`
+	SecretPath string `protobuf:"bytes,2,opt,name=secret_path,proto3" json:"secret_path,omitempty"`
+	PolicyName string `protobuf:"bytes,3,opt,name=policy_name,proto3" json:"policy_name,omitempty"`
+	// Policy in HCL which would be written
+	PolicyData string `protobuf:"bytes,4,opt,name=policy_data,proto3" json:"policy_data,omitempty"`
+	AuthPath   string `protobuf:"bytes,5,opt,name=auth_path,proto3" json:"auth_path,omitempty"`
+	// The auth would be enabled, because it does not exist
+	EnableAuth bool        `protobuf:"varint,6,opt,name=enable_auth,proto3" json:"enable_auth,omitempty"`
+	Roles      []*RoleDiff `protobuf:"bytes,7,rep,name=roles,proto3" json:"roles,omitempty"`
 }
 
-func (x *SecretInfo) Reset() {
-	*x = SecretInfo{}
+func (x *DryRunPlan) Reset() {
+	*x = DryRunPlan{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -111,13 +124,13 @@ func (x *SecretInfo) Reset() {
 	}
 }
 
-func (x *SecretInfo) String() string {
+func (x *DryRunPlan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretInfo) ProtoMessage() {}
+func (*DryRunPlan) ProtoMessage() {}
 
-func (x *SecretInfo) ProtoReflect() protoreflect.Message {
+func (x *DryRunPlan) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -129,48 +142,77 @@ func (x *SecretInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretInfo.ProtoReflect.Descriptor instead.
-func (*SecretInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use DryRunPlan.ProtoReflect.Descriptor instead.
+func (*DryRunPlan) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *SecretInfo) GetName() string {
+func (x *DryRunPlan) GetSecretName() string {
 	if x != nil {
-		return x.Name
+		return x.SecretName
 	}
 	return ""
 }
 
-func (x *SecretInfo) GetPath() string {
+func (x *DryRunPlan) GetSecretPath() string {
 	if x != nil {
-		return x.Path
+		return x.SecretPath
 	}
 	return ""
 }
 
-func (x *SecretInfo) GetVersion() int32 {
+func (x *DryRunPlan) GetPolicyName() string {
 	if x != nil {
-		return x.Version
+		return x.PolicyName
 	}
-	return 0
+	return ""
 }
 
-// GIT DEFINE
-type GitKVs struct {
+func (x *DryRunPlan) GetPolicyData() string {
+	if x != nil {
+		return x.PolicyData
+	}
+	return ""
+}
+
+func (x *DryRunPlan) GetAuthPath() string {
+	if x != nil {
+		return x.AuthPath
+	}
+	return ""
+}
+
+func (x *DryRunPlan) GetEnableAuth() bool {
+	if x != nil {
+		return x.EnableAuth
+	}
+	return false
+}
+
+func (x *DryRunPlan) GetRoles() []*RoleDiff {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// Changes of a role in the dry run plan
+type RoleDiff struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Deploykey of the unit
-	DeployKey string `protobuf:"bytes,1,opt,name=deploy_key,proto3" json:"deploy_key,omitempty"`
-	// AccessToken of this unit
-	AccessToken string `protobuf:"bytes,2,opt,name=access_token,proto3" json:"access_token,omitempty"`
-	// External kvs store in vault, deploy key and access token wiil over write it if key name is same
-	Additionals map[string]string `protobuf:"bytes,3,rep,name=additionals,proto3" json:"additionals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RolePath string `protobuf:"bytes,1,opt,name=role_path,proto3" json:"role_path,omitempty"`
+	// The role would be created, because it does not exist
+	Create bool `protobuf:"varint,2,opt,name=create,proto3" json:"create,omitempty"`
+	// Config of the role which would be written, except the token policies
+	Config          map[string]string `protobuf:"bytes,3,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	AddedPolicies   []string          `protobuf:"bytes,4,rep,name=added_policies,proto3" json:"added_policies,omitempty"`
+	RemovedPolicies []string          `protobuf:"bytes,5,rep,name=removed_policies,proto3" json:"removed_policies,omitempty"`
 }
 
-func (x *GitKVs) Reset() {
-	*x = GitKVs{}
+func (x *RoleDiff) Reset() {
+	*x = RoleDiff{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -178,13 +220,13 @@ func (x *GitKVs) Reset() {
 	}
 }
 
-func (x *GitKVs) String() string {
+func (x *RoleDiff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitKVs) ProtoMessage() {}
+func (*RoleDiff) ProtoMessage() {}
 
-func (x *GitKVs) ProtoReflect() protoreflect.Message {
+func (x *RoleDiff) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -196,47 +238,58 @@ func (x *GitKVs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitKVs.ProtoReflect.Descriptor instead.
-func (*GitKVs) Descriptor() ([]byte, []int) {
+// Deprecated: Use RoleDiff.ProtoReflect.Descriptor instead.
+func (*RoleDiff) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *GitKVs) GetDeployKey() string {
+func (x *RoleDiff) GetRolePath() string {
 	if x != nil {
-		return x.DeployKey
+		return x.RolePath
 	}
 	return ""
 }
 
-func (x *GitKVs) GetAccessToken() string {
+func (x *RoleDiff) GetCreate() bool {
 	if x != nil {
-		return x.AccessToken
+		return x.Create
 	}
-	return ""
+	return false
 }
 
-func (x *GitKVs) GetAdditionals() map[string]string {
+func (x *RoleDiff) GetConfig() map[string]string {
 	if x != nil {
-		return x.Additionals
+		return x.Config
 	}
 	return nil
 }
 
-type GitMeta struct {
+func (x *RoleDiff) GetAddedPolicies() []string {
+	if x != nil {
+		return x.AddedPolicies
+	}
+	return nil
+}
+
+func (x *RoleDiff) GetRemovedPolicies() []string {
+	if x != nil {
+		return x.RemovedPolicies
+	}
+	return nil
+}
+
+type SecretInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Git Provider Type, gitlab or github
-	ProviderType string `protobuf:"bytes,1,opt,name=provider_type,proto3" json:"provider_type,omitempty"`
-	// Git repo ID or product ID in nautes
-	Id         string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Username   string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Permission string `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Path    string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Version int32  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *GitMeta) Reset() {
-	*x = GitMeta{}
+func (x *SecretInfo) Reset() {
+	*x = SecretInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -244,13 +297,13 @@ func (x *GitMeta) Reset() {
 	}
 }
 
-func (x *GitMeta) String() string {
+func (x *SecretInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitMeta) ProtoMessage() {}
+func (*SecretInfo) ProtoMessage() {}
 
-func (x *GitMeta) ProtoReflect() protoreflect.Message {
+func (x *SecretInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -262,51 +315,61 @@ func (x *GitMeta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitMeta.ProtoReflect.Descriptor instead.
-func (*GitMeta) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretInfo.ProtoReflect.Descriptor instead.
+func (*SecretInfo) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GitMeta) GetProviderType() string {
-	if x != nil {
-		return x.ProviderType
-	}
-	return ""
-}
-
-func (x *GitMeta) GetId() string {
+func (x *SecretInfo) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GitMeta) GetUsername() string {
+func (x *SecretInfo) GetPath() string {
 	if x != nil {
-		return x.Username
+		return x.Path
 	}
 	return ""
 }
 
-func (x *GitMeta) GetPermission() string {
+func (x *SecretInfo) GetVersion() int32 {
 	if x != nil {
-		return x.Permission
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-type GitRequest struct {
+// Metadata of a kv v2 secret, it never contains the secret values.
+// The current version is returned in SecretInfo.
+type SecretMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Meta *GitMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	// The key vaules will store in vault
-	Kvs *GitKVs `protobuf:"bytes,2,opt,name=kvs,proto3" json:"kvs,omitempty"`
-}
-
-func (x *GitRequest) Reset() {
-	*x = GitRequest{}
+	// Name of the read only policy of the secret
+	PolicyName string `protobuf:"bytes,1,opt,name=policy_name,proto3" json:"policy_name,omitempty"`
+	// Time the secret is created, in RFC3339 format
+	CreatedTime string `protobuf:"bytes,2,opt,name=created_time,proto3" json:"created_time,omitempty"`
+	// Time the secret is last updated, in RFC3339 format
+	UpdatedTime string `protobuf:"bytes,3,opt,name=updated_time,proto3" json:"updated_time,omitempty"`
+	// Common name of the client cert which creates the secret
+	CreatedBy string `protobuf:"bytes,4,opt,name=created_by,proto3" json:"created_by,omitempty"`
+	// Common name of the client cert which writes the last version
+	UpdatedBy string `protobuf:"bytes,5,opt,name=updated_by,proto3" json:"updated_by,omitempty"`
+	// Id of the request which writes the last version, it is the X-Request-Id header of the request
+	RequestId string `protobuf:"bytes,6,opt,name=request_id,proto3" json:"request_id,omitempty"`
+	// Nautes tenant which vault proxy serves
+	Tenant string `protobuf:"bytes,7,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// Secret type of the request which writes the last version
+	SourceType string `protobuf:"bytes,8,opt,name=source_type,proto3" json:"source_type,omitempty"`
+	// Common names which can also update and delete the secret when owner only is enabled
+	CoOwners []string `protobuf:"bytes,9,rep,name=co_owners,proto3" json:"co_owners,omitempty"`
+}
+
+func (x *SecretMetadata) Reset() {
+	*x = SecretMetadata{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -314,13 +377,13 @@ func (x *GitRequest) Reset() {
 	}
 }
 
-func (x *GitRequest) String() string {
+func (x *SecretMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitRequest) ProtoMessage() {}
+func (*SecretMetadata) ProtoMessage() {}
 
-func (x *GitRequest) ProtoReflect() protoreflect.Message {
+func (x *SecretMetadata) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -332,97 +395,106 @@ func (x *GitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitRequest.ProtoReflect.Descriptor instead.
-func (*GitRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretMetadata.ProtoReflect.Descriptor instead.
+func (*SecretMetadata) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GitRequest) GetMeta() *GitMeta {
+func (x *SecretMetadata) GetPolicyName() string {
 	if x != nil {
-		return x.Meta
+		return x.PolicyName
 	}
-	return nil
+	return ""
 }
 
-func (x *GitRequest) GetKvs() *GitKVs {
+func (x *SecretMetadata) GetCreatedTime() string {
 	if x != nil {
-		return x.Kvs
+		return x.CreatedTime
 	}
-	return nil
+	return ""
 }
 
-type CreateGitReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+func (x *SecretMetadata) GetUpdatedTime() string {
+	if x != nil {
+		return x.UpdatedTime
+	}
+	return ""
 }
 
-func (x *CreateGitReply) Reset() {
-	*x = CreateGitReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SecretMetadata) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
 	}
+	return ""
 }
 
-func (x *CreateGitReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SecretMetadata) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
 }
 
-func (*CreateGitReply) ProtoMessage() {}
+func (x *SecretMetadata) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
 
-func (x *CreateGitReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SecretMetadata) GetTenant() string {
+	if x != nil {
+		return x.Tenant
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CreateGitReply.ProtoReflect.Descriptor instead.
-func (*CreateGitReply) Descriptor() ([]byte, []int) {
-	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{4}
+func (x *SecretMetadata) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
 }
 
-func (x *CreateGitReply) GetSecret() *SecretInfo {
+func (x *SecretMetadata) GetCoOwners() []string {
 	if x != nil {
-		return x.Secret
+		return x.CoOwners
 	}
 	return nil
 }
 
-type DeleteGitReply struct {
+// A version of a kv v2 secret, the secret values are not returned.
+type SecretVersion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	Version int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Time the version is created, in RFC3339 format
+	CreatedTime string `protobuf:"bytes,2,opt,name=created_time,proto3" json:"created_time,omitempty"`
+	// Time the version is deleted, empty if it is not deleted
+	DeletionTime string `protobuf:"bytes,3,opt,name=deletion_time,proto3" json:"deletion_time,omitempty"`
+	// A destroyed version can not be rolled back to
+	Destroyed bool `protobuf:"varint,4,opt,name=destroyed,proto3" json:"destroyed,omitempty"`
 }
 
-func (x *DeleteGitReply) Reset() {
-	*x = DeleteGitReply{}
+func (x *SecretVersion) Reset() {
+	*x = SecretVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[5]
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteGitReply) String() string {
+func (x *SecretVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGitReply) ProtoMessage() {}
+func (*SecretVersion) ProtoMessage() {}
 
-func (x *DeleteGitReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[5]
+func (x *SecretVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -433,47 +505,70 @@ func (x *DeleteGitReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGitReply.ProtoReflect.Descriptor instead.
-func (*DeleteGitReply) Descriptor() ([]byte, []int) {
-	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use SecretVersion.ProtoReflect.Descriptor instead.
+func (*SecretVersion) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DeleteGitReply) GetMsg() string {
+func (x *SecretVersion) GetVersion() int32 {
 	if x != nil {
-		return x.Msg
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SecretVersion) GetCreatedTime() string {
+	if x != nil {
+		return x.CreatedTime
 	}
 	return ""
 }
 
-// PKI DEFINE
-type PkiRequest struct {
+func (x *SecretVersion) GetDeletionTime() string {
+	if x != nil {
+		return x.DeletionTime
+	}
+	return ""
+}
+
+func (x *SecretVersion) GetDestroyed() bool {
+	if x != nil {
+		return x.Destroyed
+	}
+	return false
+}
+
+// GIT DEFINE
+type GitKVs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	Cacert string `protobuf:"bytes,2,opt,name=cacert,proto3" json:"cacert,omitempty"`
-	Cert   string `protobuf:"bytes,3,opt,name=cert,proto3" json:"cert,omitempty"`
-	Key    string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	// Deploykey of the unit
+	DeployKey string `protobuf:"bytes,1,opt,name=deploy_key,proto3" json:"deploy_key,omitempty"`
+	// AccessToken of this unit
+	AccessToken string `protobuf:"bytes,2,opt,name=access_token,proto3" json:"access_token,omitempty"`
+	// External kvs store in vault, deploy key and access token wiil over write it if key name is same
+	Additionals map[string]string `protobuf:"bytes,3,rep,name=additionals,proto3" json:"additionals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *PkiRequest) Reset() {
-	*x = PkiRequest{}
+func (x *GitKVs) Reset() {
+	*x = GitKVs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[6]
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PkiRequest) String() string {
+func (x *GitKVs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PkiRequest) ProtoMessage() {}
+func (*GitKVs) ProtoMessage() {}
 
-func (x *PkiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[6]
+func (x *GitKVs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -484,49 +579,129 @@ func (x *PkiRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PkiRequest.ProtoReflect.Descriptor instead.
-func (*PkiRequest) Descriptor() ([]byte, []int) {
-	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GitKVs.ProtoReflect.Descriptor instead.
+func (*GitKVs) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *PkiRequest) GetDomain() string {
+func (x *GitKVs) GetDeployKey() string {
 	if x != nil {
-		return x.Domain
+		return x.DeployKey
 	}
 	return ""
 }
 
-func (x *PkiRequest) GetCacert() string {
+func (x *GitKVs) GetAccessToken() string {
 	if x != nil {
-		return x.Cacert
+		return x.AccessToken
 	}
 	return ""
 }
 
-func (x *PkiRequest) GetCert() string {
+func (x *GitKVs) GetAdditionals() map[string]string {
 	if x != nil {
-		return x.Cert
+		return x.Additionals
+	}
+	return nil
+}
+
+type GitMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Git Provider Type, gitlab or github
+	ProviderType string `protobuf:"bytes,1,opt,name=provider_type,proto3" json:"provider_type,omitempty"`
+	// Git repo ID or product ID in nautes
+	Id         string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Username   string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Permission string `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
+}
+
+func (x *GitMeta) Reset() {
+	*x = GitMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GitMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitMeta) ProtoMessage() {}
+
+func (x *GitMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitMeta.ProtoReflect.Descriptor instead.
+func (*GitMeta) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GitMeta) GetProviderType() string {
+	if x != nil {
+		return x.ProviderType
 	}
 	return ""
 }
 
-func (x *PkiRequest) GetKey() string {
+func (x *GitMeta) GetId() string {
 	if x != nil {
-		return x.Key
+		return x.Id
 	}
 	return ""
 }
 
-type CreatePkiReply struct {
+func (x *GitMeta) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GitMeta) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+type GitRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Meta *GitMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// The key vaules will store in vault
+	Kvs *GitKVs `protobuf:"bytes,2,opt,name=kvs,proto3" json:"kvs,omitempty"`
+	// Expected current version of the secret, it is only used by create.
+	// 0 means the secret must not exist, the secret is written without check if it is not set.
+	ExpectedVersion *int32 `protobuf:"varint,3,opt,name=expected_version,proto3,oneof" json:"expected_version,omitempty"`
+	// Generate a ssh key pair by vault proxy, the private key is stored as the deploy key,
+	// and the public key is returned. The deploy key in kvs must be empty if it is true. It is only used by create.
+	GenerateDeployKey bool `protobuf:"varint,4,opt,name=generate_deploy_key,proto3" json:"generate_deploy_key,omitempty"`
+	// Type of the generated key, it must in [ ed25519, rsa ], default is ed25519
+	DeployKeyType string `protobuf:"bytes,5,opt,name=deploy_key_type,proto3" json:"deploy_key_type,omitempty"`
+	// Time to live of the secret, such as "24h", the secret is purged by the reaper after it. It is only used by create.
+	Ttl string `protobuf:"bytes,6,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// Time the secret is purged by the reaper in RFC3339 format, it can not be set together with ttl. It is only used by create.
+	ExpireTime string `protobuf:"bytes,7,opt,name=expire_time,proto3" json:"expire_time,omitempty"`
 }
 
-func (x *CreatePkiReply) Reset() {
-	*x = CreatePkiReply{}
+func (x *GitRequest) Reset() {
+	*x = GitRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -534,13 +709,13 @@ func (x *CreatePkiReply) Reset() {
 	}
 }
 
-func (x *CreatePkiReply) String() string {
+func (x *GitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePkiReply) ProtoMessage() {}
+func (*GitRequest) ProtoMessage() {}
 
-func (x *CreatePkiReply) ProtoReflect() protoreflect.Message {
+func (x *GitRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -552,28 +727,74 @@ func (x *CreatePkiReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePkiReply.ProtoReflect.Descriptor instead.
-func (*CreatePkiReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use GitRequest.ProtoReflect.Descriptor instead.
+func (*GitRequest) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreatePkiReply) GetSecret() *SecretInfo {
+func (x *GitRequest) GetMeta() *GitMeta {
 	if x != nil {
-		return x.Secret
+		return x.Meta
 	}
 	return nil
 }
 
-type DeletePkiReply struct {
+func (x *GitRequest) GetKvs() *GitKVs {
+	if x != nil {
+		return x.Kvs
+	}
+	return nil
+}
+
+func (x *GitRequest) GetExpectedVersion() int32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *GitRequest) GetGenerateDeployKey() bool {
+	if x != nil {
+		return x.GenerateDeployKey
+	}
+	return false
+}
+
+func (x *GitRequest) GetDeployKeyType() string {
+	if x != nil {
+		return x.DeployKeyType
+	}
+	return ""
+}
+
+func (x *GitRequest) GetTtl() string {
+	if x != nil {
+		return x.Ttl
+	}
+	return ""
+}
+
+func (x *GitRequest) GetExpireTime() string {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return ""
+}
+
+type CreateGitReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Public key of the generated deploy key in authorized keys format
+	PublicKey string `protobuf:"bytes,2,opt,name=public_key,proto3" json:"public_key,omitempty"`
+	// What the request would change in vault, it is only set when the request is a dry run
+	DryRun *DryRunPlan `protobuf:"bytes,15,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *DeletePkiReply) Reset() {
-	*x = DeletePkiReply{}
+func (x *CreateGitReply) Reset() {
+	*x = CreateGitReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -581,13 +802,13 @@ func (x *DeletePkiReply) Reset() {
 	}
 }
 
-func (x *DeletePkiReply) String() string {
+func (x *CreateGitReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePkiReply) ProtoMessage() {}
+func (*CreateGitReply) ProtoMessage() {}
 
-func (x *DeletePkiReply) ProtoReflect() protoreflect.Message {
+func (x *CreateGitReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -599,32 +820,46 @@ func (x *DeletePkiReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePkiReply.ProtoReflect.Descriptor instead.
-func (*DeletePkiReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateGitReply.ProtoReflect.Descriptor instead.
+func (*CreateGitReply) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DeletePkiReply) GetMsg() string {
+func (x *CreateGitReply) GetSecret() *SecretInfo {
 	if x != nil {
-		return x.Msg
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *CreateGitReply) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
 	}
 	return ""
 }
 
-// REPO DEFINE
-type RepoAccount struct {
+func (x *CreateGitReply) GetDryRun() *DryRunPlan {
+	if x != nil {
+		return x.DryRun
+	}
+	return nil
+}
+
+type DeleteGitReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AuthType    string            `protobuf:"bytes,1,opt,name=auth_type,proto3" json:"auth_type,omitempty"`
-	Token       *Token            `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	Account     *Account          `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
-	Additionals map[string]string `protobuf:"bytes,4,rep,name=additionals,proto3" json:"additionals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// Paths of the roles which the policy of the secret is revoked from
+	RevokedRoles []string `protobuf:"bytes,2,rep,name=revoked_roles,proto3" json:"revoked_roles,omitempty"`
+	// What the request would change in vault, it is only set when the request is a dry run
+	DryRun *DryRunPlan `protobuf:"bytes,15,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *RepoAccount) Reset() {
-	*x = RepoAccount{}
+func (x *DeleteGitReply) Reset() {
+	*x = DeleteGitReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -632,13 +867,13 @@ func (x *RepoAccount) Reset() {
 	}
 }
 
-func (x *RepoAccount) String() string {
+func (x *DeleteGitReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepoAccount) ProtoMessage() {}
+func (*DeleteGitReply) ProtoMessage() {}
 
-func (x *RepoAccount) ProtoReflect() protoreflect.Message {
+func (x *DeleteGitReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -650,49 +885,42 @@ func (x *RepoAccount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepoAccount.ProtoReflect.Descriptor instead.
-func (*RepoAccount) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteGitReply.ProtoReflect.Descriptor instead.
+func (*DeleteGitReply) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *RepoAccount) GetAuthType() string {
+func (x *DeleteGitReply) GetMsg() string {
 	if x != nil {
-		return x.AuthType
+		return x.Msg
 	}
 	return ""
 }
 
-func (x *RepoAccount) GetToken() *Token {
-	if x != nil {
-		return x.Token
-	}
-	return nil
-}
-
-func (x *RepoAccount) GetAccount() *Account {
+func (x *DeleteGitReply) GetRevokedRoles() []string {
 	if x != nil {
-		return x.Account
+		return x.RevokedRoles
 	}
 	return nil
 }
 
-func (x *RepoAccount) GetAdditionals() map[string]string {
+func (x *DeleteGitReply) GetDryRun() *DryRunPlan {
 	if x != nil {
-		return x.Additionals
+		return x.DryRun
 	}
 	return nil
 }
 
-type Token struct {
+type GetGitRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Meta *GitMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 }
 
-func (x *Token) Reset() {
-	*x = Token{}
+func (x *GetGitRequest) Reset() {
+	*x = GetGitRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -700,13 +928,13 @@ func (x *Token) Reset() {
 	}
 }
 
-func (x *Token) String() string {
+func (x *GetGitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Token) ProtoMessage() {}
+func (*GetGitRequest) ProtoMessage() {}
 
-func (x *Token) ProtoReflect() protoreflect.Message {
+func (x *GetGitRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -718,29 +946,29 @@ func (x *Token) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Token.ProtoReflect.Descriptor instead.
-func (*Token) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetGitRequest.ProtoReflect.Descriptor instead.
+func (*GetGitRequest) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Token) GetToken() string {
+func (x *GetGitRequest) GetMeta() *GitMeta {
 	if x != nil {
-		return x.Token
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-type Account struct {
+type GetGitReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Secret   *SecretInfo     `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Metadata *SecretMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *Account) Reset() {
-	*x = Account{}
+func (x *GetGitReply) Reset() {
+	*x = GetGitReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -748,13 +976,13 @@ func (x *Account) Reset() {
 	}
 }
 
-func (x *Account) String() string {
+func (x *GetGitReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Account) ProtoMessage() {}
+func (*GetGitReply) ProtoMessage() {}
 
-func (x *Account) ProtoReflect() protoreflect.Message {
+func (x *GetGitReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -766,38 +994,43 @@ func (x *Account) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Account.ProtoReflect.Descriptor instead.
-func (*Account) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetGitReply.ProtoReflect.Descriptor instead.
+func (*GetGitReply) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Account) GetUsername() string {
+func (x *GetGitReply) GetSecret() *SecretInfo {
 	if x != nil {
-		return x.Username
+		return x.Secret
 	}
-	return ""
+	return nil
 }
 
-func (x *Account) GetPassword() string {
+func (x *GetGitReply) GetMetadata() *SecretMetadata {
 	if x != nil {
-		return x.Password
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-type RepoMeta struct {
+type ListGitRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProviderId string `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
-	// Witch kind of repo it is, such as pip, docker images.
-	Product string `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
-	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	// Fields are joined as the path prefix to list, fields after the first empty one are ignored
+	ProviderType string `protobuf:"bytes,1,opt,name=provider_type,proto3" json:"provider_type,omitempty"`
+	Id           string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Username     string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Permission   string `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
+	// Max number of secrets in one page, default is 100
+	PageSize int32 `protobuf:"varint,5,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	// The next_page_token of the previous reply, empty for the first page
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,proto3" json:"page_token,omitempty"`
 }
 
-func (x *RepoMeta) Reset() {
-	*x = RepoMeta{}
+func (x *ListGitRequest) Reset() {
+	*x = ListGitRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -805,13 +1038,13 @@ func (x *RepoMeta) Reset() {
 	}
 }
 
-func (x *RepoMeta) String() string {
+func (x *ListGitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepoMeta) ProtoMessage() {}
+func (*ListGitRequest) ProtoMessage() {}
 
-func (x *RepoMeta) ProtoReflect() protoreflect.Message {
+func (x *ListGitRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -823,43 +1056,64 @@ func (x *RepoMeta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepoMeta.ProtoReflect.Descriptor instead.
-func (*RepoMeta) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListGitRequest.ProtoReflect.Descriptor instead.
+func (*ListGitRequest) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *RepoMeta) GetProviderId() string {
+func (x *ListGitRequest) GetProviderType() string {
 	if x != nil {
-		return x.ProviderId
+		return x.ProviderType
 	}
 	return ""
 }
 
-func (x *RepoMeta) GetProduct() string {
+func (x *ListGitRequest) GetId() string {
 	if x != nil {
-		return x.Product
+		return x.Id
 	}
 	return ""
 }
 
-func (x *RepoMeta) GetProject() string {
+func (x *ListGitRequest) GetUsername() string {
 	if x != nil {
-		return x.Project
+		return x.Username
 	}
 	return ""
 }
 
-type RepoRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ListGitRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
 
-	Meta    *RepoMeta    `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Account *RepoAccount `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+func (x *ListGitRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *RepoRequest) Reset() {
-	*x = RepoRequest{}
+func (x *ListGitRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListGitReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secrets       []*SecretInfo `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListGitReply) Reset() {
+	*x = ListGitReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -867,13 +1121,13 @@ func (x *RepoRequest) Reset() {
 	}
 }
 
-func (x *RepoRequest) String() string {
+func (x *ListGitReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepoRequest) ProtoMessage() {}
+func (*ListGitReply) ProtoMessage() {}
 
-func (x *RepoRequest) ProtoReflect() protoreflect.Message {
+func (x *ListGitReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -885,35 +1139,35 @@ func (x *RepoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepoRequest.ProtoReflect.Descriptor instead.
-func (*RepoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListGitReply.ProtoReflect.Descriptor instead.
+func (*ListGitReply) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *RepoRequest) GetMeta() *RepoMeta {
+func (x *ListGitReply) GetSecrets() []*SecretInfo {
 	if x != nil {
-		return x.Meta
+		return x.Secrets
 	}
 	return nil
 }
 
-func (x *RepoRequest) GetAccount() *RepoAccount {
+func (x *ListGitReply) GetNextPageToken() string {
 	if x != nil {
-		return x.Account
+		return x.NextPageToken
 	}
-	return nil
+	return ""
 }
 
-type CreateRepoReply struct {
+type ListGitVersionsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Versions []*SecretVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
 }
 
-func (x *CreateRepoReply) Reset() {
-	*x = CreateRepoReply{}
+func (x *ListGitVersionsReply) Reset() {
+	*x = ListGitVersionsReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -921,13 +1175,13 @@ func (x *CreateRepoReply) Reset() {
 	}
 }
 
-func (x *CreateRepoReply) String() string {
+func (x *ListGitVersionsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRepoReply) ProtoMessage() {}
+func (*ListGitVersionsReply) ProtoMessage() {}
 
-func (x *CreateRepoReply) ProtoReflect() protoreflect.Message {
+func (x *ListGitVersionsReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -939,28 +1193,30 @@ func (x *CreateRepoReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRepoReply.ProtoReflect.Descriptor instead.
-func (*CreateRepoReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListGitVersionsReply.ProtoReflect.Descriptor instead.
+func (*ListGitVersionsReply) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CreateRepoReply) GetSecret() *SecretInfo {
+func (x *ListGitVersionsReply) GetVersions() []*SecretVersion {
 	if x != nil {
-		return x.Secret
+		return x.Versions
 	}
 	return nil
 }
 
-type DeleteRepoReply struct {
+type RollbackGitRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	Meta *GitMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// The version to roll back to, it must not be deleted or destroyed
+	Version int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *DeleteRepoReply) Reset() {
-	*x = DeleteRepoReply{}
+func (x *RollbackGitRequest) Reset() {
+	*x = RollbackGitRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -968,13 +1224,13 @@ func (x *DeleteRepoReply) Reset() {
 	}
 }
 
-func (x *DeleteRepoReply) String() string {
+func (x *RollbackGitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRepoReply) ProtoMessage() {}
+func (*RollbackGitRequest) ProtoMessage() {}
 
-func (x *DeleteRepoReply) ProtoReflect() protoreflect.Message {
+func (x *RollbackGitRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -986,30 +1242,35 @@ func (x *DeleteRepoReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRepoReply.ProtoReflect.Descriptor instead.
-func (*DeleteRepoReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use RollbackGitRequest.ProtoReflect.Descriptor instead.
+func (*RollbackGitRequest) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *DeleteRepoReply) GetMsg() string {
+func (x *RollbackGitRequest) GetMeta() *GitMeta {
 	if x != nil {
-		return x.Msg
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-// TENANT DEFINE
-type TenantGitMeta struct {
+func (x *RollbackGitRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type RollbackGitReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Permission string `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
 }
 
-func (x *TenantGitMeta) Reset() {
-	*x = TenantGitMeta{}
+func (x *RollbackGitReply) Reset() {
+	*x = RollbackGitReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1017,13 +1278,13 @@ func (x *TenantGitMeta) Reset() {
 	}
 }
 
-func (x *TenantGitMeta) String() string {
+func (x *RollbackGitReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TenantGitMeta) ProtoMessage() {}
+func (*RollbackGitReply) ProtoMessage() {}
 
-func (x *TenantGitMeta) ProtoReflect() protoreflect.Message {
+func (x *RollbackGitReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1035,37 +1296,28 @@ func (x *TenantGitMeta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TenantGitMeta.ProtoReflect.Descriptor instead.
-func (*TenantGitMeta) Descriptor() ([]byte, []int) {
+// Deprecated: Use RollbackGitReply.ProtoReflect.Descriptor instead.
+func (*RollbackGitReply) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *TenantGitMeta) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *TenantGitMeta) GetPermission() string {
+func (x *RollbackGitReply) GetSecret() *SecretInfo {
 	if x != nil {
-		return x.Permission
+		return x.Secret
 	}
-	return ""
+	return nil
 }
 
-type TenantGitRequest struct {
+type UndeleteGitRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Meta *TenantGitMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	// The access info of root user in gitlab or github
-	Kvs *GitKVs `protobuf:"bytes,2,opt,name=kvs,proto3" json:"kvs,omitempty"`
+	Meta *GitMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 }
 
-func (x *TenantGitRequest) Reset() {
-	*x = TenantGitRequest{}
+func (x *UndeleteGitRequest) Reset() {
+	*x = UndeleteGitRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1073,13 +1325,13 @@ func (x *TenantGitRequest) Reset() {
 	}
 }
 
-func (x *TenantGitRequest) String() string {
+func (x *UndeleteGitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TenantGitRequest) ProtoMessage() {}
+func (*UndeleteGitRequest) ProtoMessage() {}
 
-func (x *TenantGitRequest) ProtoReflect() protoreflect.Message {
+func (x *UndeleteGitRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1091,35 +1343,30 @@ func (x *TenantGitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TenantGitRequest.ProtoReflect.Descriptor instead.
-func (*TenantGitRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UndeleteGitRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteGitRequest) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *TenantGitRequest) GetMeta() *TenantGitMeta {
+func (x *UndeleteGitRequest) GetMeta() *GitMeta {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *TenantGitRequest) GetKvs() *GitKVs {
-	if x != nil {
-		return x.Kvs
-	}
-	return nil
-}
-
-type CreateTenantGitReply struct {
+type UndeleteGitReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Paths of the roles which the policy of the secret is granted to again
+	GrantedRoles []string `protobuf:"bytes,2,rep,name=granted_roles,proto3" json:"granted_roles,omitempty"`
 }
 
-func (x *CreateTenantGitReply) Reset() {
-	*x = CreateTenantGitReply{}
+func (x *UndeleteGitReply) Reset() {
+	*x = UndeleteGitReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1127,13 +1374,13 @@ func (x *CreateTenantGitReply) Reset() {
 	}
 }
 
-func (x *CreateTenantGitReply) String() string {
+func (x *UndeleteGitReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTenantGitReply) ProtoMessage() {}
+func (*UndeleteGitReply) ProtoMessage() {}
 
-func (x *CreateTenantGitReply) ProtoReflect() protoreflect.Message {
+func (x *UndeleteGitReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1145,28 +1392,35 @@ func (x *CreateTenantGitReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTenantGitReply.ProtoReflect.Descriptor instead.
-func (*CreateTenantGitReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use UndeleteGitReply.ProtoReflect.Descriptor instead.
+func (*UndeleteGitReply) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CreateTenantGitReply) GetSecret() *SecretInfo {
+func (x *UndeleteGitReply) GetSecret() *SecretInfo {
 	if x != nil {
 		return x.Secret
 	}
 	return nil
 }
 
-type DeleteTenantGitReply struct {
+func (x *UndeleteGitReply) GetGrantedRoles() []string {
+	if x != nil {
+		return x.GrantedRoles
+	}
+	return nil
+}
+
+type PurgeGitRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	Meta *GitMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 }
 
-func (x *DeleteTenantGitReply) Reset() {
-	*x = DeleteTenantGitReply{}
+func (x *PurgeGitRequest) Reset() {
+	*x = PurgeGitRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1174,13 +1428,13 @@ func (x *DeleteTenantGitReply) Reset() {
 	}
 }
 
-func (x *DeleteTenantGitReply) String() string {
+func (x *PurgeGitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTenantGitReply) ProtoMessage() {}
+func (*PurgeGitRequest) ProtoMessage() {}
 
-func (x *DeleteTenantGitReply) ProtoReflect() protoreflect.Message {
+func (x *PurgeGitRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1192,29 +1446,43 @@ func (x *DeleteTenantGitReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTenantGitReply.ProtoReflect.Descriptor instead.
-func (*DeleteTenantGitReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use PurgeGitRequest.ProtoReflect.Descriptor instead.
+func (*PurgeGitRequest) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *DeleteTenantGitReply) GetMsg() string {
+func (x *PurgeGitRequest) GetMeta() *GitMeta {
 	if x != nil {
-		return x.Msg
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-type TenantRepoMeta struct {
+// PKI DEFINE
+type PkiRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Permission string `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+	// The domain name the certificate is issued for, it is also used as the secret path
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	// PEM encoded CA certificate, the cert chain must be verified by it
+	Cacert string `protobuf:"bytes,2,opt,name=cacert,proto3" json:"cacert,omitempty"`
+	// PEM encoded certificate, intermediate certificates can be appended after the leaf
+	Cert string `protobuf:"bytes,3,opt,name=cert,proto3" json:"cert,omitempty"`
+	// PEM encoded private key of the cert
+	Key string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	// Expected current version of the secret, it is only used by create.
+	// 0 means the secret must not exist, the secret is written without check if it is not set.
+	ExpectedVersion *int32 `protobuf:"varint,5,opt,name=expected_version,proto3,oneof" json:"expected_version,omitempty"`
+	// Time to live of the secret, such as "24h", the secret is purged by the reaper after it. It is only used by create.
+	Ttl string `protobuf:"bytes,6,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// Time the secret is purged by the reaper in RFC3339 format, it can not be set together with ttl. It is only used by create.
+	ExpireTime string `protobuf:"bytes,7,opt,name=expire_time,proto3" json:"expire_time,omitempty"`
 }
 
-func (x *TenantRepoMeta) Reset() {
-	*x = TenantRepoMeta{}
+func (x *PkiRequest) Reset() {
+	*x = PkiRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1222,13 +1490,13 @@ func (x *TenantRepoMeta) Reset() {
 	}
 }
 
-func (x *TenantRepoMeta) String() string {
+func (x *PkiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TenantRepoMeta) ProtoMessage() {}
+func (*PkiRequest) ProtoMessage() {}
 
-func (x *TenantRepoMeta) ProtoReflect() protoreflect.Message {
+func (x *PkiRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1240,36 +1508,72 @@ func (x *TenantRepoMeta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TenantRepoMeta.ProtoReflect.Descriptor instead.
-func (*TenantRepoMeta) Descriptor() ([]byte, []int) {
+// Deprecated: Use PkiRequest.ProtoReflect.Descriptor instead.
+func (*PkiRequest) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *TenantRepoMeta) GetId() string {
+func (x *PkiRequest) GetDomain() string {
 	if x != nil {
-		return x.Id
+		return x.Domain
 	}
 	return ""
 }
 
-func (x *TenantRepoMeta) GetPermission() string {
+func (x *PkiRequest) GetCacert() string {
 	if x != nil {
-		return x.Permission
+		return x.Cacert
 	}
 	return ""
 }
 
-type TenantRepoRequest struct {
+func (x *PkiRequest) GetCert() string {
+	if x != nil {
+		return x.Cert
+	}
+	return ""
+}
+
+func (x *PkiRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PkiRequest) GetExpectedVersion() int32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *PkiRequest) GetTtl() string {
+	if x != nil {
+		return x.Ttl
+	}
+	return ""
+}
+
+func (x *PkiRequest) GetExpireTime() string {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return ""
+}
+
+type CreatePkiReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Meta    *TenantRepoMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Account *RepoAccount    `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// What the request would change in vault, it is only set when the request is a dry run
+	DryRun *DryRunPlan `protobuf:"bytes,15,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *TenantRepoRequest) Reset() {
-	*x = TenantRepoRequest{}
+func (x *CreatePkiReply) Reset() {
+	*x = CreatePkiReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1277,13 +1581,13 @@ func (x *TenantRepoRequest) Reset() {
 	}
 }
 
-func (x *TenantRepoRequest) String() string {
+func (x *CreatePkiReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TenantRepoRequest) ProtoMessage() {}
+func (*CreatePkiReply) ProtoMessage() {}
 
-func (x *TenantRepoRequest) ProtoReflect() protoreflect.Message {
+func (x *CreatePkiReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1295,35 +1599,39 @@ func (x *TenantRepoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TenantRepoRequest.ProtoReflect.Descriptor instead.
-func (*TenantRepoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreatePkiReply.ProtoReflect.Descriptor instead.
+func (*CreatePkiReply) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *TenantRepoRequest) GetMeta() *TenantRepoMeta {
+func (x *CreatePkiReply) GetSecret() *SecretInfo {
 	if x != nil {
-		return x.Meta
+		return x.Secret
 	}
 	return nil
 }
 
-func (x *TenantRepoRequest) GetAccount() *RepoAccount {
+func (x *CreatePkiReply) GetDryRun() *DryRunPlan {
 	if x != nil {
-		return x.Account
+		return x.DryRun
 	}
 	return nil
 }
 
-type CreateTenantRepoReply struct {
+type DeletePkiReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// Paths of the roles which the policy of the secret is revoked from
+	RevokedRoles []string `protobuf:"bytes,2,rep,name=revoked_roles,proto3" json:"revoked_roles,omitempty"`
+	// What the request would change in vault, it is only set when the request is a dry run
+	DryRun *DryRunPlan `protobuf:"bytes,15,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *CreateTenantRepoReply) Reset() {
-	*x = CreateTenantRepoReply{}
+func (x *DeletePkiReply) Reset() {
+	*x = DeletePkiReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1331,13 +1639,13 @@ func (x *CreateTenantRepoReply) Reset() {
 	}
 }
 
-func (x *CreateTenantRepoReply) String() string {
+func (x *DeletePkiReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTenantRepoReply) ProtoMessage() {}
+func (*DeletePkiReply) ProtoMessage() {}
 
-func (x *CreateTenantRepoReply) ProtoReflect() protoreflect.Message {
+func (x *DeletePkiReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1349,28 +1657,43 @@ func (x *CreateTenantRepoReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTenantRepoReply.ProtoReflect.Descriptor instead.
-func (*CreateTenantRepoReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeletePkiReply.ProtoReflect.Descriptor instead.
+func (*DeletePkiReply) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *CreateTenantRepoReply) GetSecret() *SecretInfo {
+func (x *DeletePkiReply) GetMsg() string {
 	if x != nil {
-		return x.Secret
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *DeletePkiReply) GetRevokedRoles() []string {
+	if x != nil {
+		return x.RevokedRoles
 	}
 	return nil
 }
 
-type DeleteTenantRepoReply struct {
+func (x *DeletePkiReply) GetDryRun() *DryRunPlan {
+	if x != nil {
+		return x.DryRun
+	}
+	return nil
+}
+
+type GetPkiReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	Secret   *SecretInfo     `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Metadata *SecretMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *DeleteTenantRepoReply) Reset() {
-	*x = DeleteTenantRepoReply{}
+func (x *GetPkiReply) Reset() {
+	*x = GetPkiReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1378,13 +1701,13 @@ func (x *DeleteTenantRepoReply) Reset() {
 	}
 }
 
-func (x *DeleteTenantRepoReply) String() string {
+func (x *GetPkiReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTenantRepoReply) ProtoMessage() {}
+func (*GetPkiReply) ProtoMessage() {}
 
-func (x *DeleteTenantRepoReply) ProtoReflect() protoreflect.Message {
+func (x *GetPkiReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1396,30 +1719,35 @@ func (x *DeleteTenantRepoReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTenantRepoReply.ProtoReflect.Descriptor instead.
-func (*DeleteTenantRepoReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPkiReply.ProtoReflect.Descriptor instead.
+func (*GetPkiReply) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *DeleteTenantRepoReply) GetMsg() string {
+func (x *GetPkiReply) GetSecret() *SecretInfo {
 	if x != nil {
-		return x.Msg
+		return x.Secret
 	}
-	return ""
+	return nil
 }
 
-// CLUSTER DEFINE
-type ClusterAccount struct {
+func (x *GetPkiReply) GetMetadata() *SecretMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type UndeletePkiRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Kubeconfig file to access cluster
-	Kubeconfig string `protobuf:"bytes,1,opt,name=kubeconfig,proto3" json:"kubeconfig,omitempty"`
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 }
 
-func (x *ClusterAccount) Reset() {
-	*x = ClusterAccount{}
+func (x *UndeletePkiRequest) Reset() {
+	*x = UndeletePkiRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1427,13 +1755,13 @@ func (x *ClusterAccount) Reset() {
 	}
 }
 
-func (x *ClusterAccount) String() string {
+func (x *UndeletePkiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClusterAccount) ProtoMessage() {}
+func (*UndeletePkiRequest) ProtoMessage() {}
 
-func (x *ClusterAccount) ProtoReflect() protoreflect.Message {
+func (x *UndeletePkiRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1445,32 +1773,30 @@ func (x *ClusterAccount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClusterAccount.ProtoReflect.Descriptor instead.
-func (*ClusterAccount) Descriptor() ([]byte, []int) {
+// Deprecated: Use UndeletePkiRequest.ProtoReflect.Descriptor instead.
+func (*UndeletePkiRequest) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ClusterAccount) GetKubeconfig() string {
+func (x *UndeletePkiRequest) GetDomain() string {
 	if x != nil {
-		return x.Kubeconfig
+		return x.Domain
 	}
 	return ""
 }
 
-type ClusterMeta struct {
+type UndeletePkiReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Type of cluster, such as k8s, aws, virtual machine, only support "kubernetes"
-	Type       string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Id         string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Username   string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Permission string `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
+	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Paths of the roles which the policy of the secret is granted to again
+	GrantedRoles []string `protobuf:"bytes,2,rep,name=granted_roles,proto3" json:"granted_roles,omitempty"`
 }
 
-func (x *ClusterMeta) Reset() {
-	*x = ClusterMeta{}
+func (x *UndeletePkiReply) Reset() {
+	*x = UndeletePkiReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1478,13 +1804,13 @@ func (x *ClusterMeta) Reset() {
 	}
 }
 
-func (x *ClusterMeta) String() string {
+func (x *UndeletePkiReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClusterMeta) ProtoMessage() {}
+func (*UndeletePkiReply) ProtoMessage() {}
 
-func (x *ClusterMeta) ProtoReflect() protoreflect.Message {
+func (x *UndeletePkiReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1496,51 +1822,35 @@ func (x *ClusterMeta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClusterMeta.ProtoReflect.Descriptor instead.
-func (*ClusterMeta) Descriptor() ([]byte, []int) {
+// Deprecated: Use UndeletePkiReply.ProtoReflect.Descriptor instead.
+func (*UndeletePkiReply) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ClusterMeta) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *ClusterMeta) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *ClusterMeta) GetUsername() string {
+func (x *UndeletePkiReply) GetSecret() *SecretInfo {
 	if x != nil {
-		return x.Username
+		return x.Secret
 	}
-	return ""
+	return nil
 }
 
-func (x *ClusterMeta) GetPermission() string {
+func (x *UndeletePkiReply) GetGrantedRoles() []string {
 	if x != nil {
-		return x.Permission
+		return x.GrantedRoles
 	}
-	return ""
+	return nil
 }
 
-type ClusterRequest struct {
+type PurgePkiRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Meta *ClusterMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	// Cluster access info, such as kubeconfig when clsuter type is kubernetes
-	Account *ClusterAccount `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 }
 
-func (x *ClusterRequest) Reset() {
-	*x = ClusterRequest{}
+func (x *PurgePkiRequest) Reset() {
+	*x = PurgePkiRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1548,13 +1858,13 @@ func (x *ClusterRequest) Reset() {
 	}
 }
 
-func (x *ClusterRequest) String() string {
+func (x *PurgePkiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClusterRequest) ProtoMessage() {}
+func (*PurgePkiRequest) ProtoMessage() {}
 
-func (x *ClusterRequest) ProtoReflect() protoreflect.Message {
+func (x *PurgePkiRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1566,35 +1876,38 @@ func (x *ClusterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClusterRequest.ProtoReflect.Descriptor instead.
-func (*ClusterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PurgePkiRequest.ProtoReflect.Descriptor instead.
+func (*PurgePkiRequest) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ClusterRequest) GetMeta() *ClusterMeta {
-	if x != nil {
-		return x.Meta
-	}
-	return nil
-}
-
-func (x *ClusterRequest) GetAccount() *ClusterAccount {
+func (x *PurgePkiRequest) GetDomain() string {
 	if x != nil {
-		return x.Account
+		return x.Domain
 	}
-	return nil
+	return ""
 }
 
-type CreateClusterReply struct {
+// REPO DEFINE
+type RepoAccount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// auth method of this repo account, it must in [ token, password ].
+	// if token, it will load data will from token.
+	// if password, it will load data from account.
+	AuthType    string            `protobuf:"bytes,1,opt,name=auth_type,proto3" json:"auth_type,omitempty"`
+	Token       *Token            `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Account     *Account          `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Additionals map[string]string `protobuf:"bytes,4,rep,name=additionals,proto3" json:"additionals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Generate the password of the account by the password policy of vault proxy, it is only used by create.
+	// The auth type must be password, and the password of the account must be empty if it is true.
+	GeneratePassword bool `protobuf:"varint,5,opt,name=generate_password,proto3" json:"generate_password,omitempty"`
 }
 
-func (x *CreateClusterReply) Reset() {
-	*x = CreateClusterReply{}
+func (x *RepoAccount) Reset() {
+	*x = RepoAccount{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1602,13 +1915,13 @@ func (x *CreateClusterReply) Reset() {
 	}
 }
 
-func (x *CreateClusterReply) String() string {
+func (x *RepoAccount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateClusterReply) ProtoMessage() {}
+func (*RepoAccount) ProtoMessage() {}
 
-func (x *CreateClusterReply) ProtoReflect() protoreflect.Message {
+func (x *RepoAccount) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1620,28 +1933,56 @@ func (x *CreateClusterReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateClusterReply.ProtoReflect.Descriptor instead.
-func (*CreateClusterReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use RepoAccount.ProtoReflect.Descriptor instead.
+func (*RepoAccount) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *CreateClusterReply) GetSecret() *SecretInfo {
+func (x *RepoAccount) GetAuthType() string {
 	if x != nil {
-		return x.Secret
+		return x.AuthType
+	}
+	return ""
+}
+
+func (x *RepoAccount) GetToken() *Token {
+	if x != nil {
+		return x.Token
 	}
 	return nil
 }
 
-type DeleteClusterReply struct {
+func (x *RepoAccount) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *RepoAccount) GetAdditionals() map[string]string {
+	if x != nil {
+		return x.Additionals
+	}
+	return nil
+}
+
+func (x *RepoAccount) GetGeneratePassword() bool {
+	if x != nil {
+		return x.GeneratePassword
+	}
+	return false
+}
+
+type Token struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *DeleteClusterReply) Reset() {
-	*x = DeleteClusterReply{}
+func (x *Token) Reset() {
+	*x = Token{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1649,13 +1990,13 @@ func (x *DeleteClusterReply) Reset() {
 	}
 }
 
-func (x *DeleteClusterReply) String() string {
+func (x *Token) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteClusterReply) ProtoMessage() {}
+func (*Token) ProtoMessage() {}
 
-func (x *DeleteClusterReply) ProtoReflect() protoreflect.Message {
+func (x *Token) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1667,33 +2008,30 @@ func (x *DeleteClusterReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteClusterReply.ProtoReflect.Descriptor instead.
-func (*DeleteClusterReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use Token.ProtoReflect.Descriptor instead.
+func (*Token) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *DeleteClusterReply) GetMsg() string {
+func (x *Token) GetToken() string {
 	if x != nil {
-		return x.Msg
+		return x.Token
 	}
 	return ""
 }
 
-type Kubernetes struct {
+type Account struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Kubernetes URL
-	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	// Kubernetes cabundle when url is https
-	Cabundle string `protobuf:"bytes,2,opt,name=cabundle,proto3" json:"cabundle,omitempty"`
-	// The k8s service account token witch has "system:auth-delegator" role
-	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// It can be empty if the password is generated
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *Kubernetes) Reset() {
-	*x = Kubernetes{}
+func (x *Account) Reset() {
+	*x = Account{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1701,13 +2039,13 @@ func (x *Kubernetes) Reset() {
 	}
 }
 
-func (x *Kubernetes) String() string {
+func (x *Account) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Kubernetes) ProtoMessage() {}
+func (*Account) ProtoMessage() {}
 
-func (x *Kubernetes) ProtoReflect() protoreflect.Message {
+func (x *Account) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1719,47 +2057,38 @@ func (x *Kubernetes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Kubernetes.ProtoReflect.Descriptor instead.
-func (*Kubernetes) Descriptor() ([]byte, []int) {
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *Kubernetes) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
-}
-
-func (x *Kubernetes) GetCabundle() string {
+func (x *Account) GetUsername() string {
 	if x != nil {
-		return x.Cabundle
+		return x.Username
 	}
 	return ""
 }
 
-func (x *Kubernetes) GetToken() string {
+func (x *Account) GetPassword() string {
 	if x != nil {
-		return x.Token
+		return x.Password
 	}
 	return ""
 }
 
-type AuthRequest struct {
+type RepoMeta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Vault auth path
-	ClusterName string `protobuf:"bytes,1,opt,name=cluster_name,proto3" json:"cluster_name,omitempty"`
-	// Vault auth type
-	AuthType string `protobuf:"bytes,2,opt,name=auth_type,proto3" json:"auth_type,omitempty"`
-	// Vault auth setting when type is k8s
-	Kubernetes *Kubernetes `protobuf:"bytes,3,opt,name=kubernetes,proto3" json:"kubernetes,omitempty"`
+	ProviderId string `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	// Witch kind of repo it is, such as pip, docker images.
+	Product string `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
 }
 
-func (x *AuthRequest) Reset() {
-	*x = AuthRequest{}
+func (x *RepoMeta) Reset() {
+	*x = RepoMeta{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1767,13 +2096,13 @@ func (x *AuthRequest) Reset() {
 	}
 }
 
-func (x *AuthRequest) String() string {
+func (x *RepoMeta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthRequest) ProtoMessage() {}
+func (*RepoMeta) ProtoMessage() {}
 
-func (x *AuthRequest) ProtoReflect() protoreflect.Message {
+func (x *RepoMeta) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1785,42 +2114,52 @@ func (x *AuthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthRequest.ProtoReflect.Descriptor instead.
-func (*AuthRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RepoMeta.ProtoReflect.Descriptor instead.
+func (*RepoMeta) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *AuthRequest) GetClusterName() string {
+func (x *RepoMeta) GetProviderId() string {
 	if x != nil {
-		return x.ClusterName
+		return x.ProviderId
 	}
 	return ""
 }
 
-func (x *AuthRequest) GetAuthType() string {
+func (x *RepoMeta) GetProduct() string {
 	if x != nil {
-		return x.AuthType
+		return x.Product
 	}
 	return ""
 }
 
-func (x *AuthRequest) GetKubernetes() *Kubernetes {
+func (x *RepoMeta) GetProject() string {
 	if x != nil {
-		return x.Kubernetes
+		return x.Project
 	}
-	return nil
+	return ""
 }
 
-type CreateAuthReply struct {
+type RepoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	Meta    *RepoMeta    `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Account *RepoAccount `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// Expected current version of the secret, it is only used by create.
+	// 0 means the secret must not exist, the secret is written without check if it is not set.
+	ExpectedVersion *int32 `protobuf:"varint,3,opt,name=expected_version,proto3,oneof" json:"expected_version,omitempty"`
+	// Delete the product together with all the projects under it, it is only used by delete product.
+	Recursive bool `protobuf:"varint,4,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	// Time to live of the secret, such as "24h", the secret is purged by the reaper after it. It is only used by create.
+	Ttl string `protobuf:"bytes,5,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// Time the secret is purged by the reaper in RFC3339 format, it can not be set together with ttl. It is only used by create.
+	ExpireTime string `protobuf:"bytes,6,opt,name=expire_time,proto3" json:"expire_time,omitempty"`
 }
 
-func (x *CreateAuthReply) Reset() {
-	*x = CreateAuthReply{}
+func (x *RepoRequest) Reset() {
+	*x = RepoRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1828,13 +2167,13 @@ func (x *CreateAuthReply) Reset() {
 	}
 }
 
-func (x *CreateAuthReply) String() string {
+func (x *RepoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAuthReply) ProtoMessage() {}
+func (*RepoRequest) ProtoMessage() {}
 
-func (x *CreateAuthReply) ProtoReflect() protoreflect.Message {
+func (x *RepoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1846,28 +2185,67 @@ func (x *CreateAuthReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAuthReply.ProtoReflect.Descriptor instead.
-func (*CreateAuthReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use RepoRequest.ProtoReflect.Descriptor instead.
+func (*RepoRequest) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *CreateAuthReply) GetMsg() string {
+func (x *RepoRequest) GetMeta() *RepoMeta {
 	if x != nil {
-		return x.Msg
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RepoRequest) GetAccount() *RepoAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *RepoRequest) GetExpectedVersion() int32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *RepoRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+func (x *RepoRequest) GetTtl() string {
+	if x != nil {
+		return x.Ttl
 	}
 	return ""
 }
 
-type DeleteAuthReply struct {
+func (x *RepoRequest) GetExpireTime() string {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return ""
+}
+
+type CreateRepoReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// The generated password, it is only returned by this reply
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// What the request would change in vault, it is only set when the request is a dry run
+	DryRun *DryRunPlan `protobuf:"bytes,15,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *DeleteAuthReply) Reset() {
-	*x = DeleteAuthReply{}
+func (x *CreateRepoReply) Reset() {
+	*x = CreateRepoReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1875,13 +2253,13 @@ func (x *DeleteAuthReply) Reset() {
 	}
 }
 
-func (x *DeleteAuthReply) String() string {
+func (x *CreateRepoReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAuthReply) ProtoMessage() {}
+func (*CreateRepoReply) ProtoMessage() {}
 
-func (x *DeleteAuthReply) ProtoReflect() protoreflect.Message {
+func (x *CreateRepoReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1893,29 +2271,48 @@ func (x *DeleteAuthReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAuthReply.ProtoReflect.Descriptor instead.
-func (*DeleteAuthReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateRepoReply.ProtoReflect.Descriptor instead.
+func (*CreateRepoReply) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *DeleteAuthReply) GetMsg() string {
+func (x *CreateRepoReply) GetSecret() *SecretInfo {
 	if x != nil {
-		return x.Msg
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *CreateRepoReply) GetPassword() string {
+	if x != nil {
+		return x.Password
 	}
 	return ""
 }
 
-type KubernetesAuthRoleMeta struct {
+func (x *CreateRepoReply) GetDryRun() *DryRunPlan {
+	if x != nil {
+		return x.DryRun
+	}
+	return nil
+}
+
+type DeleteRepoReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Namespaces      []string `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
-	ServiceAccounts []string `protobuf:"bytes,2,rep,name=service_accounts,proto3" json:"service_accounts,omitempty"`
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// Paths of the roles which the policy of the secret is revoked from
+	RevokedRoles []string `protobuf:"bytes,2,rep,name=revoked_roles,proto3" json:"revoked_roles,omitempty"`
+	// Paths of the deleted secrets
+	DeletedPaths []string `protobuf:"bytes,3,rep,name=deleted_paths,proto3" json:"deleted_paths,omitempty"`
+	// What the request would change in vault, it is only set when the request is a dry run
+	DryRun *DryRunPlan `protobuf:"bytes,15,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *KubernetesAuthRoleMeta) Reset() {
-	*x = KubernetesAuthRoleMeta{}
+func (x *DeleteRepoReply) Reset() {
+	*x = DeleteRepoReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1923,13 +2320,13 @@ func (x *KubernetesAuthRoleMeta) Reset() {
 	}
 }
 
-func (x *KubernetesAuthRoleMeta) String() string {
+func (x *DeleteRepoReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KubernetesAuthRoleMeta) ProtoMessage() {}
+func (*DeleteRepoReply) ProtoMessage() {}
 
-func (x *KubernetesAuthRoleMeta) ProtoReflect() protoreflect.Message {
+func (x *DeleteRepoReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1941,42 +2338,49 @@ func (x *KubernetesAuthRoleMeta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KubernetesAuthRoleMeta.ProtoReflect.Descriptor instead.
-func (*KubernetesAuthRoleMeta) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteRepoReply.ProtoReflect.Descriptor instead.
+func (*DeleteRepoReply) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *KubernetesAuthRoleMeta) GetNamespaces() []string {
+func (x *DeleteRepoReply) GetMsg() string {
 	if x != nil {
-		return x.Namespaces
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *DeleteRepoReply) GetRevokedRoles() []string {
+	if x != nil {
+		return x.RevokedRoles
 	}
 	return nil
 }
 
-func (x *KubernetesAuthRoleMeta) GetServiceAccounts() []string {
+func (x *DeleteRepoReply) GetDeletedPaths() []string {
 	if x != nil {
-		return x.ServiceAccounts
+		return x.DeletedPaths
 	}
 	return nil
 }
 
-type AuthroleRequest struct {
+func (x *DeleteRepoReply) GetDryRun() *DryRunPlan {
+	if x != nil {
+		return x.DryRun
+	}
+	return nil
+}
+
+type GetRepoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Vault path of kubernetes auth
-	ClusterName string `protobuf:"bytes,1,opt,name=cluster_name,proto3" json:"cluster_name,omitempty"`
-	// Vault role name in kubernetes auth
-	DestUser string `protobuf:"bytes,2,opt,name=dest_user,proto3" json:"dest_user,omitempty"`
-	// Types that are assignable to Role:
-	//
-	//	*AuthroleRequest_Kubernetes
-	Role isAuthroleRequest_Role `protobuf_oneof:"role"`
+	Meta *RepoMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 }
 
-func (x *AuthroleRequest) Reset() {
-	*x = AuthroleRequest{}
+func (x *GetRepoRequest) Reset() {
+	*x = GetRepoRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1984,13 +2388,13 @@ func (x *AuthroleRequest) Reset() {
 	}
 }
 
-func (x *AuthroleRequest) String() string {
+func (x *GetRepoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthroleRequest) ProtoMessage() {}
+func (*GetRepoRequest) ProtoMessage() {}
 
-func (x *AuthroleRequest) ProtoReflect() protoreflect.Message {
+func (x *GetRepoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2002,59 +2406,29 @@ func (x *AuthroleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthroleRequest.ProtoReflect.Descriptor instead.
-func (*AuthroleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRepoRequest.ProtoReflect.Descriptor instead.
+func (*GetRepoRequest) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *AuthroleRequest) GetClusterName() string {
-	if x != nil {
-		return x.ClusterName
-	}
-	return ""
-}
-
-func (x *AuthroleRequest) GetDestUser() string {
+func (x *GetRepoRequest) GetMeta() *RepoMeta {
 	if x != nil {
-		return x.DestUser
-	}
-	return ""
-}
-
-func (m *AuthroleRequest) GetRole() isAuthroleRequest_Role {
-	if m != nil {
-		return m.Role
-	}
-	return nil
-}
-
-func (x *AuthroleRequest) GetKubernetes() *KubernetesAuthRoleMeta {
-	if x, ok := x.GetRole().(*AuthroleRequest_Kubernetes); ok {
-		return x.Kubernetes
+		return x.Meta
 	}
 	return nil
 }
 
-type isAuthroleRequest_Role interface {
-	isAuthroleRequest_Role()
-}
-
-type AuthroleRequest_Kubernetes struct {
-	Kubernetes *KubernetesAuthRoleMeta `protobuf:"bytes,3,opt,name=kubernetes,proto3,oneof"`
-}
-
-func (*AuthroleRequest_Kubernetes) isAuthroleRequest_Role() {}
-
-type CreateAuthroleReply struct {
+type GetRepoReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	Secret   *SecretInfo     `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Metadata *SecretMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *CreateAuthroleReply) Reset() {
-	*x = CreateAuthroleReply{}
+func (x *GetRepoReply) Reset() {
+	*x = GetRepoReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2062,13 +2436,13 @@ func (x *CreateAuthroleReply) Reset() {
 	}
 }
 
-func (x *CreateAuthroleReply) String() string {
+func (x *GetRepoReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAuthroleReply) ProtoMessage() {}
+func (*GetRepoReply) ProtoMessage() {}
 
-func (x *CreateAuthroleReply) ProtoReflect() protoreflect.Message {
+func (x *GetRepoReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2080,28 +2454,42 @@ func (x *CreateAuthroleReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAuthroleReply.ProtoReflect.Descriptor instead.
-func (*CreateAuthroleReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRepoReply.ProtoReflect.Descriptor instead.
+func (*GetRepoReply) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *CreateAuthroleReply) GetMsg() string {
+func (x *GetRepoReply) GetSecret() *SecretInfo {
 	if x != nil {
-		return x.Msg
+		return x.Secret
 	}
-	return ""
+	return nil
 }
 
-type DeleteAuthroleReply struct {
+func (x *GetRepoReply) GetMetadata() *SecretMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ListRepoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// Fields are joined as the path prefix to list, fields after the first empty one are ignored
+	ProviderId string `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	Product    string `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	Project    string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	// Max number of secrets in one page, default is 100
+	PageSize int32 `protobuf:"varint,4,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	// The next_page_token of the previous reply, empty for the first page
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,proto3" json:"page_token,omitempty"`
 }
 
-func (x *DeleteAuthroleReply) Reset() {
-	*x = DeleteAuthroleReply{}
+func (x *ListRepoRequest) Reset() {
+	*x = ListRepoRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2109,13 +2497,13 @@ func (x *DeleteAuthroleReply) Reset() {
 	}
 }
 
-func (x *DeleteAuthroleReply) String() string {
+func (x *ListRepoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAuthroleReply) ProtoMessage() {}
+func (*ListRepoRequest) ProtoMessage() {}
 
-func (x *DeleteAuthroleReply) ProtoReflect() protoreflect.Message {
+func (x *ListRepoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2127,30 +2515,57 @@ func (x *DeleteAuthroleReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAuthroleReply.ProtoReflect.Descriptor instead.
-func (*DeleteAuthroleReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRepoRequest.ProtoReflect.Descriptor instead.
+func (*ListRepoRequest) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *DeleteAuthroleReply) GetMsg() string {
+func (x *ListRepoRequest) GetProviderId() string {
 	if x != nil {
-		return x.Msg
+		return x.ProviderId
 	}
 	return ""
 }
 
-type AuthroleGitPolicyRequest struct {
+func (x *ListRepoRequest) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *ListRepoRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ListRepoRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRepoRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListRepoReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClusterName string   `protobuf:"bytes,1,opt,name=cluster_name,proto3" json:"cluster_name,omitempty"`
-	DestUser    string   `protobuf:"bytes,2,opt,name=dest_user,proto3" json:"dest_user,omitempty"`
-	Secret      *GitMeta `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	Secrets       []*SecretInfo `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *AuthroleGitPolicyRequest) Reset() {
-	*x = AuthroleGitPolicyRequest{}
+func (x *ListRepoReply) Reset() {
+	*x = ListRepoReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2158,13 +2573,13 @@ func (x *AuthroleGitPolicyRequest) Reset() {
 	}
 }
 
-func (x *AuthroleGitPolicyRequest) String() string {
+func (x *ListRepoReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthroleGitPolicyRequest) ProtoMessage() {}
+func (*ListRepoReply) ProtoMessage() {}
 
-func (x *AuthroleGitPolicyRequest) ProtoReflect() protoreflect.Message {
+func (x *ListRepoReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2176,44 +2591,35 @@ func (x *AuthroleGitPolicyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthroleGitPolicyRequest.ProtoReflect.Descriptor instead.
-func (*AuthroleGitPolicyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRepoReply.ProtoReflect.Descriptor instead.
+func (*ListRepoReply) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *AuthroleGitPolicyRequest) GetClusterName() string {
+func (x *ListRepoReply) GetSecrets() []*SecretInfo {
 	if x != nil {
-		return x.ClusterName
+		return x.Secrets
 	}
-	return ""
+	return nil
 }
 
-func (x *AuthroleGitPolicyRequest) GetDestUser() string {
+func (x *ListRepoReply) GetNextPageToken() string {
 	if x != nil {
-		return x.DestUser
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *AuthroleGitPolicyRequest) GetSecret() *GitMeta {
-	if x != nil {
-		return x.Secret
-	}
-	return nil
-}
-
-type AuthroleRepoPolicyRequest struct {
+type ListRepoVersionsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClusterName string    `protobuf:"bytes,1,opt,name=cluster_name,proto3" json:"cluster_name,omitempty"`
-	DestUser    string    `protobuf:"bytes,2,opt,name=dest_user,proto3" json:"dest_user,omitempty"`
-	Secret      *RepoMeta `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	Versions []*SecretVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
 }
 
-func (x *AuthroleRepoPolicyRequest) Reset() {
-	*x = AuthroleRepoPolicyRequest{}
+func (x *ListRepoVersionsReply) Reset() {
+	*x = ListRepoVersionsReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2221,13 +2627,13 @@ func (x *AuthroleRepoPolicyRequest) Reset() {
 	}
 }
 
-func (x *AuthroleRepoPolicyRequest) String() string {
+func (x *ListRepoVersionsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthroleRepoPolicyRequest) ProtoMessage() {}
+func (*ListRepoVersionsReply) ProtoMessage() {}
 
-func (x *AuthroleRepoPolicyRequest) ProtoReflect() protoreflect.Message {
+func (x *ListRepoVersionsReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2239,44 +2645,30 @@ func (x *AuthroleRepoPolicyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthroleRepoPolicyRequest.ProtoReflect.Descriptor instead.
-func (*AuthroleRepoPolicyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRepoVersionsReply.ProtoReflect.Descriptor instead.
+func (*ListRepoVersionsReply) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *AuthroleRepoPolicyRequest) GetClusterName() string {
-	if x != nil {
-		return x.ClusterName
-	}
-	return ""
-}
-
-func (x *AuthroleRepoPolicyRequest) GetDestUser() string {
+func (x *ListRepoVersionsReply) GetVersions() []*SecretVersion {
 	if x != nil {
-		return x.DestUser
+		return x.Versions
 	}
-	return ""
+	return nil
 }
 
-func (x *AuthroleRepoPolicyRequest) GetSecret() *RepoMeta {
-	if x != nil {
-		return x.Secret
-	}
-	return nil
-}
-
-type AuthroleClusterPolicyRequest struct {
+type RollbackRepoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClusterName string       `protobuf:"bytes,1,opt,name=cluster_name,proto3" json:"cluster_name,omitempty"`
-	DestUser    string       `protobuf:"bytes,2,opt,name=dest_user,proto3" json:"dest_user,omitempty"`
-	Secret      *ClusterMeta `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	Meta *RepoMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// The version to roll back to, it must not be deleted or destroyed
+	Version int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *AuthroleClusterPolicyRequest) Reset() {
-	*x = AuthroleClusterPolicyRequest{}
+func (x *RollbackRepoRequest) Reset() {
+	*x = RollbackRepoRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2284,13 +2676,13 @@ func (x *AuthroleClusterPolicyRequest) Reset() {
 	}
 }
 
-func (x *AuthroleClusterPolicyRequest) String() string {
+func (x *RollbackRepoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthroleClusterPolicyRequest) ProtoMessage() {}
+func (*RollbackRepoRequest) ProtoMessage() {}
 
-func (x *AuthroleClusterPolicyRequest) ProtoReflect() protoreflect.Message {
+func (x *RollbackRepoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2302,59 +2694,97 @@ func (x *AuthroleClusterPolicyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthroleClusterPolicyRequest.ProtoReflect.Descriptor instead.
-func (*AuthroleClusterPolicyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RollbackRepoRequest.ProtoReflect.Descriptor instead.
+func (*RollbackRepoRequest) Descriptor() ([]byte, []int) {
 	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *AuthroleClusterPolicyRequest) GetClusterName() string {
+func (x *RollbackRepoRequest) GetMeta() *RepoMeta {
 	if x != nil {
-		return x.ClusterName
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-func (x *AuthroleClusterPolicyRequest) GetDestUser() string {
+func (x *RollbackRepoRequest) GetVersion() int32 {
 	if x != nil {
-		return x.DestUser
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-func (x *AuthroleClusterPolicyRequest) GetSecret() *ClusterMeta {
+type RollbackRepoReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *RollbackRepoReply) Reset() {
+	*x = RollbackRepoReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackRepoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackRepoReply) ProtoMessage() {}
+
+func (x *RollbackRepoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackRepoReply.ProtoReflect.Descriptor instead.
+func (*RollbackRepoReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RollbackRepoReply) GetSecret() *SecretInfo {
 	if x != nil {
 		return x.Secret
 	}
 	return nil
 }
 
-type AuthroleTenantGitPolicyRequest struct {
+type UndeleteRepoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClusterName string         `protobuf:"bytes,1,opt,name=cluster_name,proto3" json:"cluster_name,omitempty"`
-	DestUser    string         `protobuf:"bytes,2,opt,name=dest_user,proto3" json:"dest_user,omitempty"`
-	Secret      *TenantGitMeta `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	Meta *RepoMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 }
 
-func (x *AuthroleTenantGitPolicyRequest) Reset() {
-	*x = AuthroleTenantGitPolicyRequest{}
+func (x *UndeleteRepoRequest) Reset() {
+	*x = UndeleteRepoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[40]
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuthroleTenantGitPolicyRequest) String() string {
+func (x *UndeleteRepoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthroleTenantGitPolicyRequest) ProtoMessage() {}
+func (*UndeleteRepoRequest) ProtoMessage() {}
 
-func (x *AuthroleTenantGitPolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[40]
+func (x *UndeleteRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2365,59 +2795,103 @@ func (x *AuthroleTenantGitPolicyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthroleTenantGitPolicyRequest.ProtoReflect.Descriptor instead.
-func (*AuthroleTenantGitPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use UndeleteRepoRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteRepoRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *AuthroleTenantGitPolicyRequest) GetClusterName() string {
+func (x *UndeleteRepoRequest) GetMeta() *RepoMeta {
 	if x != nil {
-		return x.ClusterName
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-func (x *AuthroleTenantGitPolicyRequest) GetDestUser() string {
-	if x != nil {
-		return x.DestUser
+type UndeleteRepoReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Paths of the roles which the policy of the secret is granted to again
+	GrantedRoles []string `protobuf:"bytes,2,rep,name=granted_roles,proto3" json:"granted_roles,omitempty"`
+}
+
+func (x *UndeleteRepoReply) Reset() {
+	*x = UndeleteRepoReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AuthroleTenantGitPolicyRequest) GetSecret() *TenantGitMeta {
+func (x *UndeleteRepoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteRepoReply) ProtoMessage() {}
+
+func (x *UndeleteRepoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteRepoReply.ProtoReflect.Descriptor instead.
+func (*UndeleteRepoReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UndeleteRepoReply) GetSecret() *SecretInfo {
 	if x != nil {
 		return x.Secret
 	}
 	return nil
 }
 
-type AuthroleTenantRepoPolicyRequest struct {
+func (x *UndeleteRepoReply) GetGrantedRoles() []string {
+	if x != nil {
+		return x.GrantedRoles
+	}
+	return nil
+}
+
+type RenderRepoDockerConfigRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClusterName string          `protobuf:"bytes,1,opt,name=cluster_name,proto3" json:"cluster_name,omitempty"`
-	DestUser    string          `protobuf:"bytes,2,opt,name=dest_user,proto3" json:"dest_user,omitempty"`
-	Secret      *TenantRepoMeta `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	Meta *RepoMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Name of the kubernetes secret, default is the path of the repo secret with "/" replaced by "-"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Namespace of the kubernetes secret, it is not set in the manifest if it is empty
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
-func (x *AuthroleTenantRepoPolicyRequest) Reset() {
-	*x = AuthroleTenantRepoPolicyRequest{}
+func (x *RenderRepoDockerConfigRequest) Reset() {
+	*x = RenderRepoDockerConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[41]
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuthroleTenantRepoPolicyRequest) String() string {
+func (x *RenderRepoDockerConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthroleTenantRepoPolicyRequest) ProtoMessage() {}
+func (*RenderRepoDockerConfigRequest) ProtoMessage() {}
 
-func (x *AuthroleTenantRepoPolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[41]
+func (x *RenderRepoDockerConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2428,57 +2902,106 @@ func (x *AuthroleTenantRepoPolicyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthroleTenantRepoPolicyRequest.ProtoReflect.Descriptor instead.
-func (*AuthroleTenantRepoPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use RenderRepoDockerConfigRequest.ProtoReflect.Descriptor instead.
+func (*RenderRepoDockerConfigRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *AuthroleTenantRepoPolicyRequest) GetClusterName() string {
+func (x *RenderRepoDockerConfigRequest) GetMeta() *RepoMeta {
 	if x != nil {
-		return x.ClusterName
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RenderRepoDockerConfigRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *AuthroleTenantRepoPolicyRequest) GetDestUser() string {
+func (x *RenderRepoDockerConfigRequest) GetNamespace() string {
 	if x != nil {
-		return x.DestUser
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *AuthroleTenantRepoPolicyRequest) GetSecret() *TenantRepoMeta {
+type PurgeRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meta *RepoMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (x *PurgeRepoRequest) Reset() {
+	*x = PurgeRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeRepoRequest) ProtoMessage() {}
+
+func (x *PurgeRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeRepoRequest.ProtoReflect.Descriptor instead.
+func (*PurgeRepoRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PurgeRepoRequest) GetMeta() *RepoMeta {
 	if x != nil {
-		return x.Secret
+		return x.Meta
 	}
 	return nil
 }
 
-type GrantAuthrolePolicyReply struct {
+// TENANT DEFINE
+type TenantGitMeta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Permission string `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
 }
 
-func (x *GrantAuthrolePolicyReply) Reset() {
-	*x = GrantAuthrolePolicyReply{}
+func (x *TenantGitMeta) Reset() {
+	*x = TenantGitMeta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[42]
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GrantAuthrolePolicyReply) String() string {
+func (x *TenantGitMeta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GrantAuthrolePolicyReply) ProtoMessage() {}
+func (*TenantGitMeta) ProtoMessage() {}
 
-func (x *GrantAuthrolePolicyReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[42]
+func (x *TenantGitMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2489,43 +3012,59 @@ func (x *GrantAuthrolePolicyReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GrantAuthrolePolicyReply.ProtoReflect.Descriptor instead.
-func (*GrantAuthrolePolicyReply) Descriptor() ([]byte, []int) {
-	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use TenantGitMeta.ProtoReflect.Descriptor instead.
+func (*TenantGitMeta) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GrantAuthrolePolicyReply) GetMsg() string {
+func (x *TenantGitMeta) GetId() string {
 	if x != nil {
-		return x.Msg
+		return x.Id
 	}
 	return ""
 }
 
-type RevokeAuthrolePolicyReply struct {
+func (x *TenantGitMeta) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+type TenantGitRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	Meta *TenantGitMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// The access info of root user in gitlab or github
+	Kvs *GitKVs `protobuf:"bytes,2,opt,name=kvs,proto3" json:"kvs,omitempty"`
+	// Expected current version of the secret, it is only used by create.
+	// 0 means the secret must not exist, the secret is written without check if it is not set.
+	ExpectedVersion *int32 `protobuf:"varint,3,opt,name=expected_version,proto3,oneof" json:"expected_version,omitempty"`
+	// Time to live of the secret, such as "24h", the secret is purged by the reaper after it. It is only used by create.
+	Ttl string `protobuf:"bytes,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// Time the secret is purged by the reaper in RFC3339 format, it can not be set together with ttl. It is only used by create.
+	ExpireTime string `protobuf:"bytes,5,opt,name=expire_time,proto3" json:"expire_time,omitempty"`
 }
 
-func (x *RevokeAuthrolePolicyReply) Reset() {
-	*x = RevokeAuthrolePolicyReply{}
+func (x *TenantGitRequest) Reset() {
+	*x = TenantGitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[43]
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RevokeAuthrolePolicyReply) String() string {
+func (x *TenantGitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokeAuthrolePolicyReply) ProtoMessage() {}
+func (*TenantGitRequest) ProtoMessage() {}
 
-func (x *RevokeAuthrolePolicyReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[43]
+func (x *TenantGitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2536,755 +3075,8220 @@ func (x *RevokeAuthrolePolicyReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokeAuthrolePolicyReply.ProtoReflect.Descriptor instead.
-func (*RevokeAuthrolePolicyReply) Descriptor() ([]byte, []int) {
-	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use TenantGitRequest.ProtoReflect.Descriptor instead.
+func (*TenantGitRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *RevokeAuthrolePolicyReply) GetMsg() string {
+func (x *TenantGitRequest) GetMeta() *TenantGitMeta {
 	if x != nil {
-		return x.Msg
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-var File_api_vaultproxy_v1_vaultproxy_proto protoreflect.FileDescriptor
+func (x *TenantGitRequest) GetKvs() *GitKVs {
+	if x != nil {
+		return x.Kvs
+	}
+	return nil
+}
 
-var file_api_vaultproxy_v1_vaultproxy_proto_rawDesc = []byte{
-	0x0a, 0x22, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
-	0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70,
-	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x4e, 0x0a, 0x0a, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x22, 0xda, 0x01, 0x0a, 0x06, 0x47, 0x69, 0x74, 0x4b, 0x56, 0x73, 0x12, 0x1e,
-	0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x12, 0x22,
-	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x4c, 0x0a, 0x0b, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
-	0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x4b,
-	0x56, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x73,
-	0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x9f, 0x01, 0x0a, 0x07, 0x47, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x2d, 0x0a, 0x0d,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0d, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0a, 0x70, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x22, 0x7d, 0x0a, 0x0a, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x38, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a,
-	0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x03, 0x6b, 0x76,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
-	0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x4b,
-	0x56, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x03, 0x6b, 0x76,
-	0x73, 0x22, 0x47, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x69, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70,
-	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x22, 0x0a, 0x0e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x62,
-	0x0a, 0x0a, 0x50, 0x6b, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f,
-	0x6d, 0x61, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x63, 0x65, 0x72, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x63, 0x65, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x63, 0x65, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x65, 0x72, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x22, 0x47, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6b, 0x69, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74,
-	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x22, 0x0a, 0x0e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6b, 0x69, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22,
-	0xa4, 0x02, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x34, 0x0a,
-	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
-	0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x51, 0x0a, 0x0b, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61,
-	0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70,
-	0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x64, 0x64, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x26, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x1d, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
-	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x53,
-	0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23,
-	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x22, 0x72, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x65, 0x74, 0x61, 0x12,
-	0x29, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x07, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c,
-	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x65,
-	0x74, 0x61, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x65,
-	0x74, 0x61, 0x12, 0x38, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70,
-	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x48, 0x0a, 0x0f,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06,
-	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x23, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x51, 0x0a, 0x0d, 0x54,
-	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x17, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
-	0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
-	0x10, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x89,
-	0x01, 0x0a, 0x10, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
-	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x4d,
-	0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d,
-	0x65, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x03, 0x6b, 0x76, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
-	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x4b, 0x56, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05,
-	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x03, 0x6b, 0x76, 0x73, 0x22, 0x4d, 0x0a, 0x14, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72,
-	0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x28, 0x0a, 0x14, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6d, 0x73, 0x67, 0x22, 0x52, 0x0a, 0x0e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70,
-	0x6f, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27,
-	0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x98, 0x01, 0x0a, 0x11, 0x54, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a,
-	0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08,
-	0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x42,
-	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42,
-	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x4e, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x73,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x22, 0x29, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6d,
-	0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x30, 0x0a,
-	0x0e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1e, 0x0a, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
-	0x91, 0x01, 0x0a, 0x0b, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x12,
-	0x1b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
-	0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
-	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0a, 0x70, 0x65,
-	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
-	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x22, 0x95, 0x01, 0x0a, 0x0e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74,
-	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04,
-	0x6d, 0x65, 0x74, 0x61, 0x12, 0x45, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c,
-	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
-	0x10, 0x01, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4b, 0x0a, 0x12, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
-	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x26, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
-	0x22, 0x62, 0x0a, 0x0a, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x12, 0x19,
-	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x72, 0x02, 0x10, 0x01, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x62,
-	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x62,
-	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
-	0x02, 0x10, 0x01, 0x52, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x25, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x61,
-	0x75, 0x74, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x6b, 0x75, 0x62, 0x65,
-	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31,
-	0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x6b, 0x75, 0x62,
-	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x22, 0x23, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
-	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x23, 0x0a, 0x0f,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73,
-	0x67, 0x22, 0x80, 0x01, 0x0a, 0x16, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
-	0x41, 0x75, 0x74, 0x68, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x2c, 0x0a, 0x0a,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x42, 0x0c, 0xfa, 0x42, 0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0a,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x10, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x72, 0x02,
-	0x10, 0x01, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
-	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
-	0x01, 0x52, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x0a,
-	0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
-	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x41,
-	0x75, 0x74, 0x68, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0a, 0x6b,
-	0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
-	0x65, 0x22, 0x27, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72,
-	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x27, 0x0a, 0x13, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6d, 0x73, 0x67, 0x22, 0xac, 0x01, 0x0a, 0x18, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65,
-	0x47, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x2b, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
-	0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a,
-	0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f,
-	0x75, 0x73, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74,
-	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61,
-	0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x22, 0xae, 0x01, 0x0a, 0x19, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x52,
-	0x65, 0x70, 0x6f, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x2b, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
-	0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a,
-	0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f,
-	0x75, 0x73, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74,
-	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x65, 0x74,
-	0x61, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x22, 0xb4, 0x01, 0x0a, 0x1c, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
-	0x02, 0x10, 0x01, 0x52, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x25, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x64,
-	0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
-	0x10, 0x01, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0xb8, 0x01, 0x0a, 0x1e, 0x41,
-	0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a,
-	0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x09, 0x64, 0x65,
-	0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65,
-	0x72, 0x12, 0x42, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
-	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x4d,
-	0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x1f, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f,
-	0x6c, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0c, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
-	0x10, 0x01, 0x52, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x12, 0x43, 0x0a,
-	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x65, 0x74, 0x61,
-	0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x22, 0x2c, 0x0a, 0x18, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x72,
-	0x6f, 0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
-	0x22, 0x2d, 0x0a, 0x19, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f,
-	0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x2a,
-	0x9b, 0x01, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
-	0x15, 0x0a, 0x0b, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x00,
-	0x1a, 0x04, 0xa8, 0x45, 0x91, 0x03, 0x12, 0x1a, 0x0a, 0x10, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x01, 0x1a, 0x04, 0xa8, 0x45,
-	0x93, 0x03, 0x12, 0x1c, 0x0a, 0x12, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4e,
-	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03,
-	0x12, 0x19, 0x0a, 0x0f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x41, 0x52, 0x47, 0x5f, 0x45, 0x52,
-	0x52, 0x4f, 0x52, 0x10, 0x03, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x49,
-	0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f,
-	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x32, 0xbd, 0x0d,
-	0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x61, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x47, 0x69, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c,
-	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74,
-	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47,
-	0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x3a,
-	0x01, 0x2a, 0x22, 0x07, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x69, 0x74, 0x12, 0x9f, 0x01, 0x0a, 0x09,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x69, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x50, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x4a, 0x2a, 0x48, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x69, 0x74, 0x2f, 0x7b, 0x6d, 0x65,
-	0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74,
-	0x61, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74,
-	0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x12, 0x61, 0x0a,
-	0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6b, 0x69, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x6b, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x6b, 0x69, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x12, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x0c, 0x3a, 0x01, 0x2a, 0x22, 0x07, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6b, 0x69,
-	0x12, 0x67, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6b, 0x69, 0x12, 0x1d, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x6b, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6b, 0x69, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x2a, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6b, 0x69,
-	0x2f, 0x7b, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x7d, 0x12, 0x6c, 0x0a, 0x11, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x3a, 0x01, 0x2a, 0x22, 0x08, 0x2f,
-	0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x9a, 0x01, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74,
-	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74,
-	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34,
-	0x2a, 0x32, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61,
-	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x7d, 0x12, 0xb1, 0x01, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x65, 0x70, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
-	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
-	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x51, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4b, 0x2a, 0x49, 0x2f,
-	0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x7d, 0x12, 0x79, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x74,
-	0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x12, 0x23, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13,
-	0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2f,
-	0x67, 0x69, 0x74, 0x12, 0x81, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x12, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
-	0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x2a, 0x18, 0x2f,
-	0x76, 0x31, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2f, 0x67, 0x69, 0x74, 0x2f, 0x7b, 0x6d,
-	0x65, 0x74, 0x61, 0x2e, 0x69, 0x64, 0x7d, 0x12, 0x7f, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x24, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
-	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1b, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x12, 0x86, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x24, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70,
-	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x22, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x2a, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e,
-	0x74, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x64,
-	0x7d, 0x12, 0x71, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72,
-	0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c,
-	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x16, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x10, 0x3a, 0x01, 0x2a, 0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x12, 0xa6, 0x01, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75,
-	0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x45, 0x2a, 0x43, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74,
-	0x61, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74,
-	0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x32, 0xf9, 0x03,
-	0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x65, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x41, 0x75, 0x74, 0x68, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74,
-	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74,
-	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
-	0x75, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d,
-	0x3a, 0x01, 0x2a, 0x22, 0x08, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x12, 0x71, 0x0a,
-	0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x12, 0x1e, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x2a, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74,
-	0x68, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
-	0x12, 0x85, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72,
-	0x6f, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70,
-	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
-	0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f,
-	0x61, 0x75, 0x74, 0x68, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x8e, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f,
-	0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x2a,
-	0x28, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x7b, 0x64,
-	0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x7d, 0x32, 0x9c, 0x0f, 0x0a, 0x09, 0x41, 0x75,
-	0x74, 0x68, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0xb4, 0x01, 0x0a, 0x16, 0x47, 0x72, 0x61, 0x6e,
-	0x74, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x47, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x12, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72,
-	0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x47,
-	0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c,
-	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x40, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x3a, 0x3a, 0x01, 0x2a, 0x22, 0x35, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74,
-	0x68, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
-	0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x7b, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72,
-	0x7d, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x2f, 0x67, 0x69, 0x74, 0x12, 0xb3,
-	0x01, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c,
-	0x65, 0x47, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2b, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x47, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
-	0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f,
-	0x6b, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x2a, 0x35, 0x2f,
-	0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x7b, 0x64, 0x65, 0x73,
-	0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x7d, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
-	0x2f, 0x67, 0x69, 0x74, 0x12, 0xb7, 0x01, 0x0a, 0x17, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x75,
-	0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
-	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x70,
-	0x6f, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x41, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x3b, 0x3a, 0x01, 0x2a, 0x22, 0x36, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68,
-	0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
-	0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x7b, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x7d,
-	0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x12, 0xb6,
-	0x01, 0x0a, 0x18, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c,
-	0x65, 0x52, 0x65, 0x70, 0x6f, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2c, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x2a,
-	0x36, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x7b, 0x64,
-	0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x7d, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69,
-	0x65, 0x73, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x12, 0xc0, 0x01, 0x0a, 0x1a, 0x47, 0x72, 0x61, 0x6e,
-	0x74, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75,
-	0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x72,
-	0x6f, 0x6c, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
-	0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e,
-	0x74, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x3a, 0x01, 0x2a, 0x22,
-	0x39, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x7b, 0x64,
-	0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x7d, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69,
-	0x65, 0x73, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0xbf, 0x01, 0x0a, 0x1b, 0x52,
-	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2f, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x3b, 0x2a, 0x39, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x7b, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f,
-	0x7b, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x7d, 0x2f, 0x70, 0x6f, 0x6c, 0x69,
-	0x63, 0x69, 0x65, 0x73, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0xc7, 0x01, 0x0a,
-	0x1c, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x54, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x31, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x47, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
-	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f,
-	0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x47, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x41, 0x3a, 0x01, 0x2a, 0x22, 0x3c, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75,
-	0x74, 0x68, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x7b, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65,
-	0x72, 0x7d, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x2f, 0x74, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x2f, 0x67, 0x69, 0x74, 0x12, 0xc6, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x76, 0x6f, 0x6b,
-	0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47,
-	0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x31, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74,
-	0x68, 0x72, 0x6f, 0x6c, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x3e, 0x2a, 0x3c, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x7b, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f,
-	0x7b, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x7d, 0x2f, 0x70, 0x6f, 0x6c, 0x69,
-	0x63, 0x69, 0x65, 0x73, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2f, 0x67, 0x69, 0x74, 0x12,
-	0xca, 0x01, 0x0a, 0x1d, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c,
-	0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x12, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
-	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x54, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c,
-	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41,
-	0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x48, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x42, 0x3a, 0x01, 0x2a, 0x22, 0x3d, 0x2f,
-	0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x7b, 0x64, 0x65, 0x73,
-	0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x7d, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
-	0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x12, 0xc9, 0x01, 0x0a,
-	0x1e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x54,
-	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
-	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x54, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70,
-	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75,
-	0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x2a, 0x3d, 0x2f, 0x76, 0x31, 0x2f, 0x61,
-	0x75, 0x74, 0x68, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x7b, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73,
-	0x65, 0x72, 0x7d, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x2f, 0x74, 0x65, 0x6e,
-	0x61, 0x6e, 0x74, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x42, 0x32, 0x0a, 0x11, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a,
-	0x1b, 0x76, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x61, 0x75, 0x6c,
-	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+func (x *TenantGitRequest) GetExpectedVersion() int32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
 }
 
-var (
-	file_api_vaultproxy_v1_vaultproxy_proto_rawDescOnce sync.Once
-	file_api_vaultproxy_v1_vaultproxy_proto_rawDescData = file_api_vaultproxy_v1_vaultproxy_proto_rawDesc
-)
+func (x *TenantGitRequest) GetTtl() string {
+	if x != nil {
+		return x.Ttl
+	}
+	return ""
+}
 
-func file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP() []byte {
-	file_api_vaultproxy_v1_vaultproxy_proto_rawDescOnce.Do(func() {
-		file_api_vaultproxy_v1_vaultproxy_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_vaultproxy_v1_vaultproxy_proto_rawDescData)
-	})
-	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescData
+func (x *TenantGitRequest) GetExpireTime() string {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return ""
 }
 
-var file_api_vaultproxy_v1_vaultproxy_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_vaultproxy_v1_vaultproxy_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
-var file_api_vaultproxy_v1_vaultproxy_proto_goTypes = []interface{}{
-	(ErrorReason)(0),                        // 0: api.vaultproxy.v1.ErrorReason
-	(*SecretInfo)(nil),                      // 1: api.vaultproxy.v1.SecretInfo
-	(*GitKVs)(nil),                          // 2: api.vaultproxy.v1.GitKVs
-	(*GitMeta)(nil),                         // 3: api.vaultproxy.v1.GitMeta
-	(*GitRequest)(nil),                      // 4: api.vaultproxy.v1.GitRequest
-	(*CreateGitReply)(nil),                  // 5: api.vaultproxy.v1.CreateGitReply
-	(*DeleteGitReply)(nil),                  // 6: api.vaultproxy.v1.DeleteGitReply
-	(*PkiRequest)(nil),                      // 7: api.vaultproxy.v1.PkiRequest
-	(*CreatePkiReply)(nil),                  // 8: api.vaultproxy.v1.CreatePkiReply
-	(*DeletePkiReply)(nil),                  // 9: api.vaultproxy.v1.DeletePkiReply
-	(*RepoAccount)(nil),                     // 10: api.vaultproxy.v1.RepoAccount
-	(*Token)(nil),                           // 11: api.vaultproxy.v1.Token
-	(*Account)(nil),                         // 12: api.vaultproxy.v1.Account
-	(*RepoMeta)(nil),                        // 13: api.vaultproxy.v1.RepoMeta
-	(*RepoRequest)(nil),                     // 14: api.vaultproxy.v1.RepoRequest
-	(*CreateRepoReply)(nil),                 // 15: api.vaultproxy.v1.CreateRepoReply
-	(*DeleteRepoReply)(nil),                 // 16: api.vaultproxy.v1.DeleteRepoReply
-	(*TenantGitMeta)(nil),                   // 17: api.vaultproxy.v1.TenantGitMeta
-	(*TenantGitRequest)(nil),                // 18: api.vaultproxy.v1.TenantGitRequest
-	(*CreateTenantGitReply)(nil),            // 19: api.vaultproxy.v1.CreateTenantGitReply
-	(*DeleteTenantGitReply)(nil),            // 20: api.vaultproxy.v1.DeleteTenantGitReply
-	(*TenantRepoMeta)(nil),                  // 21: api.vaultproxy.v1.TenantRepoMeta
-	(*TenantRepoRequest)(nil),               // 22: api.vaultproxy.v1.TenantRepoRequest
-	(*CreateTenantRepoReply)(nil),           // 23: api.vaultproxy.v1.CreateTenantRepoReply
-	(*DeleteTenantRepoReply)(nil),           // 24: api.vaultproxy.v1.DeleteTenantRepoReply
-	(*ClusterAccount)(nil),                  // 25: api.vaultproxy.v1.ClusterAccount
-	(*ClusterMeta)(nil),                     // 26: api.vaultproxy.v1.ClusterMeta
-	(*ClusterRequest)(nil),                  // 27: api.vaultproxy.v1.ClusterRequest
-	(*CreateClusterReply)(nil),              // 28: api.vaultproxy.v1.CreateClusterReply
-	(*DeleteClusterReply)(nil),              // 29: api.vaultproxy.v1.DeleteClusterReply
-	(*Kubernetes)(nil),                      // 30: api.vaultproxy.v1.Kubernetes
-	(*AuthRequest)(nil),                     // 31: api.vaultproxy.v1.AuthRequest
-	(*CreateAuthReply)(nil),                 // 32: api.vaultproxy.v1.CreateAuthReply
-	(*DeleteAuthReply)(nil),                 // 33: api.vaultproxy.v1.DeleteAuthReply
-	(*KubernetesAuthRoleMeta)(nil),          // 34: api.vaultproxy.v1.KubernetesAuthRoleMeta
-	(*AuthroleRequest)(nil),                 // 35: api.vaultproxy.v1.AuthroleRequest
-	(*CreateAuthroleReply)(nil),             // 36: api.vaultproxy.v1.CreateAuthroleReply
-	(*DeleteAuthroleReply)(nil),             // 37: api.vaultproxy.v1.DeleteAuthroleReply
-	(*AuthroleGitPolicyRequest)(nil),        // 38: api.vaultproxy.v1.AuthroleGitPolicyRequest
-	(*AuthroleRepoPolicyRequest)(nil),       // 39: api.vaultproxy.v1.AuthroleRepoPolicyRequest
-	(*AuthroleClusterPolicyRequest)(nil),    // 40: api.vaultproxy.v1.AuthroleClusterPolicyRequest
-	(*AuthroleTenantGitPolicyRequest)(nil),  // 41: api.vaultproxy.v1.AuthroleTenantGitPolicyRequest
-	(*AuthroleTenantRepoPolicyRequest)(nil), // 42: api.vaultproxy.v1.AuthroleTenantRepoPolicyRequest
-	(*GrantAuthrolePolicyReply)(nil),        // 43: api.vaultproxy.v1.GrantAuthrolePolicyReply
-	(*RevokeAuthrolePolicyReply)(nil),       // 44: api.vaultproxy.v1.RevokeAuthrolePolicyReply
-	nil,                                     // 45: api.vaultproxy.v1.GitKVs.AdditionalsEntry
-	nil,                                     // 46: api.vaultproxy.v1.RepoAccount.AdditionalsEntry
+type CreateTenantGitReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// What the request would change in vault, it is only set when the request is a dry run
+	DryRun *DryRunPlan `protobuf:"bytes,15,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
 }
-var file_api_vaultproxy_v1_vaultproxy_proto_depIdxs = []int32{
-	45, // 0: api.vaultproxy.v1.GitKVs.additionals:type_name -> api.vaultproxy.v1.GitKVs.AdditionalsEntry
-	3,  // 1: api.vaultproxy.v1.GitRequest.meta:type_name -> api.vaultproxy.v1.GitMeta
-	2,  // 2: api.vaultproxy.v1.GitRequest.kvs:type_name -> api.vaultproxy.v1.GitKVs
-	1,  // 3: api.vaultproxy.v1.CreateGitReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
-	1,  // 4: api.vaultproxy.v1.CreatePkiReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
-	11, // 5: api.vaultproxy.v1.RepoAccount.token:type_name -> api.vaultproxy.v1.Token
-	12, // 6: api.vaultproxy.v1.RepoAccount.account:type_name -> api.vaultproxy.v1.Account
-	46, // 7: api.vaultproxy.v1.RepoAccount.additionals:type_name -> api.vaultproxy.v1.RepoAccount.AdditionalsEntry
-	13, // 8: api.vaultproxy.v1.RepoRequest.meta:type_name -> api.vaultproxy.v1.RepoMeta
-	10, // 9: api.vaultproxy.v1.RepoRequest.account:type_name -> api.vaultproxy.v1.RepoAccount
-	1,  // 10: api.vaultproxy.v1.CreateRepoReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
-	17, // 11: api.vaultproxy.v1.TenantGitRequest.meta:type_name -> api.vaultproxy.v1.TenantGitMeta
-	2,  // 12: api.vaultproxy.v1.TenantGitRequest.kvs:type_name -> api.vaultproxy.v1.GitKVs
-	1,  // 13: api.vaultproxy.v1.CreateTenantGitReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
-	21, // 14: api.vaultproxy.v1.TenantRepoRequest.meta:type_name -> api.vaultproxy.v1.TenantRepoMeta
-	10, // 15: api.vaultproxy.v1.TenantRepoRequest.account:type_name -> api.vaultproxy.v1.RepoAccount
-	1,  // 16: api.vaultproxy.v1.CreateTenantRepoReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
-	26, // 17: api.vaultproxy.v1.ClusterRequest.meta:type_name -> api.vaultproxy.v1.ClusterMeta
-	25, // 18: api.vaultproxy.v1.ClusterRequest.account:type_name -> api.vaultproxy.v1.ClusterAccount
-	1,  // 19: api.vaultproxy.v1.CreateClusterReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
-	30, // 20: api.vaultproxy.v1.AuthRequest.kubernetes:type_name -> api.vaultproxy.v1.Kubernetes
-	34, // 21: api.vaultproxy.v1.AuthroleRequest.kubernetes:type_name -> api.vaultproxy.v1.KubernetesAuthRoleMeta
-	3,  // 22: api.vaultproxy.v1.AuthroleGitPolicyRequest.secret:type_name -> api.vaultproxy.v1.GitMeta
-	13, // 23: api.vaultproxy.v1.AuthroleRepoPolicyRequest.secret:type_name -> api.vaultproxy.v1.RepoMeta
-	26, // 24: api.vaultproxy.v1.AuthroleClusterPolicyRequest.secret:type_name -> api.vaultproxy.v1.ClusterMeta
-	17, // 25: api.vaultproxy.v1.AuthroleTenantGitPolicyRequest.secret:type_name -> api.vaultproxy.v1.TenantGitMeta
-	21, // 26: api.vaultproxy.v1.AuthroleTenantRepoPolicyRequest.secret:type_name -> api.vaultproxy.v1.TenantRepoMeta
-	4,  // 27: api.vaultproxy.v1.Secret.CreateGit:input_type -> api.vaultproxy.v1.GitRequest
-	4,  // 28: api.vaultproxy.v1.Secret.DeleteGit:input_type -> api.vaultproxy.v1.GitRequest
-	7,  // 29: api.vaultproxy.v1.Secret.CreatePki:input_type -> api.vaultproxy.v1.PkiRequest
-	7,  // 30: api.vaultproxy.v1.Secret.DeletePki:input_type -> api.vaultproxy.v1.PkiRequest
-	14, // 31: api.vaultproxy.v1.Secret.CreateRepoAccount:input_type -> api.vaultproxy.v1.RepoRequest
-	14, // 32: api.vaultproxy.v1.Secret.DeleteRepoAccountProduct:input_type -> api.vaultproxy.v1.RepoRequest
-	14, // 33: api.vaultproxy.v1.Secret.DeleteRepoAccountProject:input_type -> api.vaultproxy.v1.RepoRequest
-	18, // 34: api.vaultproxy.v1.Secret.CreteTenantGit:input_type -> api.vaultproxy.v1.TenantGitRequest
-	18, // 35: api.vaultproxy.v1.Secret.DeleteTenantGit:input_type -> api.vaultproxy.v1.TenantGitRequest
-	22, // 36: api.vaultproxy.v1.Secret.CreateTenantRepo:input_type -> api.vaultproxy.v1.TenantRepoRequest
-	22, // 37: api.vaultproxy.v1.Secret.DeleteTenantRepo:input_type -> api.vaultproxy.v1.TenantRepoRequest
-	27, // 38: api.vaultproxy.v1.Secret.CreateCluster:input_type -> api.vaultproxy.v1.ClusterRequest
-	27, // 39: api.vaultproxy.v1.Secret.DeleteCluster:input_type -> api.vaultproxy.v1.ClusterRequest
-	31, // 40: api.vaultproxy.v1.Auth.CreateAuth:input_type -> api.vaultproxy.v1.AuthRequest
-	31, // 41: api.vaultproxy.v1.Auth.DeleteAuth:input_type -> api.vaultproxy.v1.AuthRequest
-	35, // 42: api.vaultproxy.v1.Auth.CreateAuthrole:input_type -> api.vaultproxy.v1.AuthroleRequest
-	35, // 43: api.vaultproxy.v1.Auth.DeleteAuthrole:input_type -> api.vaultproxy.v1.AuthroleRequest
-	38, // 44: api.vaultproxy.v1.AuthGrant.GrantAuthroleGitPolicy:input_type -> api.vaultproxy.v1.AuthroleGitPolicyRequest
-	38, // 45: api.vaultproxy.v1.AuthGrant.RevokeAuthroleGitPolicy:input_type -> api.vaultproxy.v1.AuthroleGitPolicyRequest
-	39, // 46: api.vaultproxy.v1.AuthGrant.GrantAuthroleRepoPolicy:input_type -> api.vaultproxy.v1.AuthroleRepoPolicyRequest
-	39, // 47: api.vaultproxy.v1.AuthGrant.RevokeAuthroleRepoPolicy:input_type -> api.vaultproxy.v1.AuthroleRepoPolicyRequest
-	40, // 48: api.vaultproxy.v1.AuthGrant.GrantAuthroleClusterPolicy:input_type -> api.vaultproxy.v1.AuthroleClusterPolicyRequest
-	40, // 49: api.vaultproxy.v1.AuthGrant.RevokeAuthroleClusterPolicy:input_type -> api.vaultproxy.v1.AuthroleClusterPolicyRequest
-	41, // 50: api.vaultproxy.v1.AuthGrant.GrantAuthroleTenantGitPolicy:input_type -> api.vaultproxy.v1.AuthroleTenantGitPolicyRequest
-	41, // 51: api.vaultproxy.v1.AuthGrant.RevokeAuthroleTenantGitPolicy:input_type -> api.vaultproxy.v1.AuthroleTenantGitPolicyRequest
-	42, // 52: api.vaultproxy.v1.AuthGrant.GrantAuthroleTenantRepoPolicy:input_type -> api.vaultproxy.v1.AuthroleTenantRepoPolicyRequest
-	42, // 53: api.vaultproxy.v1.AuthGrant.RevokeAuthroleTenantRepoPolicy:input_type -> api.vaultproxy.v1.AuthroleTenantRepoPolicyRequest
-	5,  // 54: api.vaultproxy.v1.Secret.CreateGit:output_type -> api.vaultproxy.v1.CreateGitReply
-	6,  // 55: api.vaultproxy.v1.Secret.DeleteGit:output_type -> api.vaultproxy.v1.DeleteGitReply
-	8,  // 56: api.vaultproxy.v1.Secret.CreatePki:output_type -> api.vaultproxy.v1.CreatePkiReply
-	9,  // 57: api.vaultproxy.v1.Secret.DeletePki:output_type -> api.vaultproxy.v1.DeletePkiReply
-	15, // 58: api.vaultproxy.v1.Secret.CreateRepoAccount:output_type -> api.vaultproxy.v1.CreateRepoReply
-	16, // 59: api.vaultproxy.v1.Secret.DeleteRepoAccountProduct:output_type -> api.vaultproxy.v1.DeleteRepoReply
-	16, // 60: api.vaultproxy.v1.Secret.DeleteRepoAccountProject:output_type -> api.vaultproxy.v1.DeleteRepoReply
-	19, // 61: api.vaultproxy.v1.Secret.CreteTenantGit:output_type -> api.vaultproxy.v1.CreateTenantGitReply
-	20, // 62: api.vaultproxy.v1.Secret.DeleteTenantGit:output_type -> api.vaultproxy.v1.DeleteTenantGitReply
-	23, // 63: api.vaultproxy.v1.Secret.CreateTenantRepo:output_type -> api.vaultproxy.v1.CreateTenantRepoReply
-	24, // 64: api.vaultproxy.v1.Secret.DeleteTenantRepo:output_type -> api.vaultproxy.v1.DeleteTenantRepoReply
-	28, // 65: api.vaultproxy.v1.Secret.CreateCluster:output_type -> api.vaultproxy.v1.CreateClusterReply
-	29, // 66: api.vaultproxy.v1.Secret.DeleteCluster:output_type -> api.vaultproxy.v1.DeleteClusterReply
-	32, // 67: api.vaultproxy.v1.Auth.CreateAuth:output_type -> api.vaultproxy.v1.CreateAuthReply
-	33, // 68: api.vaultproxy.v1.Auth.DeleteAuth:output_type -> api.vaultproxy.v1.DeleteAuthReply
-	36, // 69: api.vaultproxy.v1.Auth.CreateAuthrole:output_type -> api.vaultproxy.v1.CreateAuthroleReply
-	37, // 70: api.vaultproxy.v1.Auth.DeleteAuthrole:output_type -> api.vaultproxy.v1.DeleteAuthroleReply
-	43, // 71: api.vaultproxy.v1.AuthGrant.GrantAuthroleGitPolicy:output_type -> api.vaultproxy.v1.GrantAuthrolePolicyReply
-	44, // 72: api.vaultproxy.v1.AuthGrant.RevokeAuthroleGitPolicy:output_type -> api.vaultproxy.v1.RevokeAuthrolePolicyReply
-	43, // 73: api.vaultproxy.v1.AuthGrant.GrantAuthroleRepoPolicy:output_type -> api.vaultproxy.v1.GrantAuthrolePolicyReply
-	44, // 74: api.vaultproxy.v1.AuthGrant.RevokeAuthroleRepoPolicy:output_type -> api.vaultproxy.v1.RevokeAuthrolePolicyReply
-	43, // 75: api.vaultproxy.v1.AuthGrant.GrantAuthroleClusterPolicy:output_type -> api.vaultproxy.v1.GrantAuthrolePolicyReply
-	44, // 76: api.vaultproxy.v1.AuthGrant.RevokeAuthroleClusterPolicy:output_type -> api.vaultproxy.v1.RevokeAuthrolePolicyReply
-	43, // 77: api.vaultproxy.v1.AuthGrant.GrantAuthroleTenantGitPolicy:output_type -> api.vaultproxy.v1.GrantAuthrolePolicyReply
-	44, // 78: api.vaultproxy.v1.AuthGrant.RevokeAuthroleTenantGitPolicy:output_type -> api.vaultproxy.v1.RevokeAuthrolePolicyReply
-	43, // 79: api.vaultproxy.v1.AuthGrant.GrantAuthroleTenantRepoPolicy:output_type -> api.vaultproxy.v1.GrantAuthrolePolicyReply
-	44, // 80: api.vaultproxy.v1.AuthGrant.RevokeAuthroleTenantRepoPolicy:output_type -> api.vaultproxy.v1.RevokeAuthrolePolicyReply
-	54, // [54:81] is the sub-list for method output_type
-	27, // [27:54] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+
+func (x *CreateTenantGitReply) Reset() {
+	*x = CreateTenantGitReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func init() { file_api_vaultproxy_v1_vaultproxy_proto_init() }
-func file_api_vaultproxy_v1_vaultproxy_proto_init() {
-	if File_api_vaultproxy_v1_vaultproxy_proto != nil {
-		return
+func (x *CreateTenantGitReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantGitReply) ProtoMessage() {}
+
+func (x *CreateTenantGitReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SecretInfo); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantGitReply.ProtoReflect.Descriptor instead.
+func (*CreateTenantGitReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateTenantGitReply) GetSecret() *SecretInfo {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *CreateTenantGitReply) GetDryRun() *DryRunPlan {
+	if x != nil {
+		return x.DryRun
+	}
+	return nil
+}
+
+type DeleteTenantGitReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// Paths of the roles which the policy of the secret is revoked from
+	RevokedRoles []string `protobuf:"bytes,2,rep,name=revoked_roles,proto3" json:"revoked_roles,omitempty"`
+	// What the request would change in vault, it is only set when the request is a dry run
+	DryRun *DryRunPlan `protobuf:"bytes,15,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *DeleteTenantGitReply) Reset() {
+	*x = DeleteTenantGitReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTenantGitReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantGitReply) ProtoMessage() {}
+
+func (x *DeleteTenantGitReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantGitReply.ProtoReflect.Descriptor instead.
+func (*DeleteTenantGitReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeleteTenantGitReply) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *DeleteTenantGitReply) GetRevokedRoles() []string {
+	if x != nil {
+		return x.RevokedRoles
+	}
+	return nil
+}
+
+func (x *DeleteTenantGitReply) GetDryRun() *DryRunPlan {
+	if x != nil {
+		return x.DryRun
+	}
+	return nil
+}
+
+type GetTenantGitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meta *TenantGitMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (x *GetTenantGitRequest) Reset() {
+	*x = GetTenantGitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTenantGitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantGitRequest) ProtoMessage() {}
+
+func (x *GetTenantGitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantGitRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantGitRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetTenantGitRequest) GetMeta() *TenantGitMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type GetTenantGitReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret   *SecretInfo     `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Metadata *SecretMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *GetTenantGitReply) Reset() {
+	*x = GetTenantGitReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTenantGitReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantGitReply) ProtoMessage() {}
+
+func (x *GetTenantGitReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantGitReply.ProtoReflect.Descriptor instead.
+func (*GetTenantGitReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetTenantGitReply) GetSecret() *SecretInfo {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *GetTenantGitReply) GetMetadata() *SecretMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ListTenantGitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Fields are joined as the path prefix to list, fields after the first empty one are ignored
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Permission string `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+	// Max number of secrets in one page, default is 100
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	// The next_page_token of the previous reply, empty for the first page
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListTenantGitRequest) Reset() {
+	*x = ListTenantGitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTenantGitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantGitRequest) ProtoMessage() {}
+
+func (x *ListTenantGitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantGitRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantGitRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListTenantGitRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListTenantGitRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *ListTenantGitRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTenantGitRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListTenantGitReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secrets       []*SecretInfo `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListTenantGitReply) Reset() {
+	*x = ListTenantGitReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTenantGitReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantGitReply) ProtoMessage() {}
+
+func (x *ListTenantGitReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantGitReply.ProtoReflect.Descriptor instead.
+func (*ListTenantGitReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListTenantGitReply) GetSecrets() []*SecretInfo {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *ListTenantGitReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListTenantGitVersionsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Versions []*SecretVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+}
+
+func (x *ListTenantGitVersionsReply) Reset() {
+	*x = ListTenantGitVersionsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTenantGitVersionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantGitVersionsReply) ProtoMessage() {}
+
+func (x *ListTenantGitVersionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantGitVersionsReply.ProtoReflect.Descriptor instead.
+func (*ListTenantGitVersionsReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListTenantGitVersionsReply) GetVersions() []*SecretVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type RollbackTenantGitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meta *TenantGitMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// The version to roll back to, it must not be deleted or destroyed
+	Version int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *RollbackTenantGitRequest) Reset() {
+	*x = RollbackTenantGitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackTenantGitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackTenantGitRequest) ProtoMessage() {}
+
+func (x *RollbackTenantGitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackTenantGitRequest.ProtoReflect.Descriptor instead.
+func (*RollbackTenantGitRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RollbackTenantGitRequest) GetMeta() *TenantGitMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RollbackTenantGitRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type RollbackTenantGitReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *RollbackTenantGitReply) Reset() {
+	*x = RollbackTenantGitReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackTenantGitReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackTenantGitReply) ProtoMessage() {}
+
+func (x *RollbackTenantGitReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackTenantGitReply.ProtoReflect.Descriptor instead.
+func (*RollbackTenantGitReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RollbackTenantGitReply) GetSecret() *SecretInfo {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type UndeleteTenantGitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meta *TenantGitMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (x *UndeleteTenantGitRequest) Reset() {
+	*x = UndeleteTenantGitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndeleteTenantGitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteTenantGitRequest) ProtoMessage() {}
+
+func (x *UndeleteTenantGitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteTenantGitRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteTenantGitRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UndeleteTenantGitRequest) GetMeta() *TenantGitMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type UndeleteTenantGitReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Paths of the roles which the policy of the secret is granted to again
+	GrantedRoles []string `protobuf:"bytes,2,rep,name=granted_roles,proto3" json:"granted_roles,omitempty"`
+}
+
+func (x *UndeleteTenantGitReply) Reset() {
+	*x = UndeleteTenantGitReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndeleteTenantGitReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteTenantGitReply) ProtoMessage() {}
+
+func (x *UndeleteTenantGitReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteTenantGitReply.ProtoReflect.Descriptor instead.
+func (*UndeleteTenantGitReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UndeleteTenantGitReply) GetSecret() *SecretInfo {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *UndeleteTenantGitReply) GetGrantedRoles() []string {
+	if x != nil {
+		return x.GrantedRoles
+	}
+	return nil
+}
+
+type PurgeTenantGitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meta *TenantGitMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (x *PurgeTenantGitRequest) Reset() {
+	*x = PurgeTenantGitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeTenantGitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTenantGitRequest) ProtoMessage() {}
+
+func (x *PurgeTenantGitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTenantGitRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTenantGitRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PurgeTenantGitRequest) GetMeta() *TenantGitMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type TenantRepoMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Permission string `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+}
+
+func (x *TenantRepoMeta) Reset() {
+	*x = TenantRepoMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantRepoMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantRepoMeta) ProtoMessage() {}
+
+func (x *TenantRepoMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantRepoMeta.ProtoReflect.Descriptor instead.
+func (*TenantRepoMeta) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *TenantRepoMeta) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TenantRepoMeta) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+type TenantRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meta    *TenantRepoMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Account *RepoAccount    `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// Expected current version of the secret, it is only used by create.
+	// 0 means the secret must not exist, the secret is written without check if it is not set.
+	ExpectedVersion *int32 `protobuf:"varint,3,opt,name=expected_version,proto3,oneof" json:"expected_version,omitempty"`
+	// Time to live of the secret, such as "24h", the secret is purged by the reaper after it. It is only used by create.
+	Ttl string `protobuf:"bytes,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// Time the secret is purged by the reaper in RFC3339 format, it can not be set together with ttl. It is only used by create.
+	ExpireTime string `protobuf:"bytes,5,opt,name=expire_time,proto3" json:"expire_time,omitempty"`
+}
+
+func (x *TenantRepoRequest) Reset() {
+	*x = TenantRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantRepoRequest) ProtoMessage() {}
+
+func (x *TenantRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantRepoRequest.ProtoReflect.Descriptor instead.
+func (*TenantRepoRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *TenantRepoRequest) GetMeta() *TenantRepoMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *TenantRepoRequest) GetAccount() *RepoAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *TenantRepoRequest) GetExpectedVersion() int32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *TenantRepoRequest) GetTtl() string {
+	if x != nil {
+		return x.Ttl
+	}
+	return ""
+}
+
+func (x *TenantRepoRequest) GetExpireTime() string {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return ""
+}
+
+type CreateTenantRepoReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// The generated password, it is only returned by this reply
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// What the request would change in vault, it is only set when the request is a dry run
+	DryRun *DryRunPlan `protobuf:"bytes,15,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *CreateTenantRepoReply) Reset() {
+	*x = CreateTenantRepoReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTenantRepoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantRepoReply) ProtoMessage() {}
+
+func (x *CreateTenantRepoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantRepoReply.ProtoReflect.Descriptor instead.
+func (*CreateTenantRepoReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreateTenantRepoReply) GetSecret() *SecretInfo {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *CreateTenantRepoReply) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateTenantRepoReply) GetDryRun() *DryRunPlan {
+	if x != nil {
+		return x.DryRun
+	}
+	return nil
+}
+
+type DeleteTenantRepoReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// Paths of the roles which the policy of the secret is revoked from
+	RevokedRoles []string `protobuf:"bytes,2,rep,name=revoked_roles,proto3" json:"revoked_roles,omitempty"`
+	// What the request would change in vault, it is only set when the request is a dry run
+	DryRun *DryRunPlan `protobuf:"bytes,15,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *DeleteTenantRepoReply) Reset() {
+	*x = DeleteTenantRepoReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTenantRepoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantRepoReply) ProtoMessage() {}
+
+func (x *DeleteTenantRepoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantRepoReply.ProtoReflect.Descriptor instead.
+func (*DeleteTenantRepoReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DeleteTenantRepoReply) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *DeleteTenantRepoReply) GetRevokedRoles() []string {
+	if x != nil {
+		return x.RevokedRoles
+	}
+	return nil
+}
+
+func (x *DeleteTenantRepoReply) GetDryRun() *DryRunPlan {
+	if x != nil {
+		return x.DryRun
+	}
+	return nil
+}
+
+type GetTenantRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meta *TenantRepoMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (x *GetTenantRepoRequest) Reset() {
+	*x = GetTenantRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTenantRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantRepoRequest) ProtoMessage() {}
+
+func (x *GetTenantRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantRepoRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantRepoRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetTenantRepoRequest) GetMeta() *TenantRepoMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type GetTenantRepoReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret   *SecretInfo     `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Metadata *SecretMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *GetTenantRepoReply) Reset() {
+	*x = GetTenantRepoReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTenantRepoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantRepoReply) ProtoMessage() {}
+
+func (x *GetTenantRepoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantRepoReply.ProtoReflect.Descriptor instead.
+func (*GetTenantRepoReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetTenantRepoReply) GetSecret() *SecretInfo {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *GetTenantRepoReply) GetMetadata() *SecretMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ListTenantRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Fields are joined as the path prefix to list, fields after the first empty one are ignored
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Permission string `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+	// Max number of secrets in one page, default is 100
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	// The next_page_token of the previous reply, empty for the first page
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListTenantRepoRequest) Reset() {
+	*x = ListTenantRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTenantRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantRepoRequest) ProtoMessage() {}
+
+func (x *ListTenantRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantRepoRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantRepoRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListTenantRepoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListTenantRepoRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *ListTenantRepoRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTenantRepoRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListTenantRepoReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secrets       []*SecretInfo `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListTenantRepoReply) Reset() {
+	*x = ListTenantRepoReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTenantRepoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantRepoReply) ProtoMessage() {}
+
+func (x *ListTenantRepoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantRepoReply.ProtoReflect.Descriptor instead.
+func (*ListTenantRepoReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListTenantRepoReply) GetSecrets() []*SecretInfo {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *ListTenantRepoReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListTenantRepoVersionsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Versions []*SecretVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+}
+
+func (x *ListTenantRepoVersionsReply) Reset() {
+	*x = ListTenantRepoVersionsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTenantRepoVersionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantRepoVersionsReply) ProtoMessage() {}
+
+func (x *ListTenantRepoVersionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantRepoVersionsReply.ProtoReflect.Descriptor instead.
+func (*ListTenantRepoVersionsReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListTenantRepoVersionsReply) GetVersions() []*SecretVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type RollbackTenantRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meta *TenantRepoMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// The version to roll back to, it must not be deleted or destroyed
+	Version int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *RollbackTenantRepoRequest) Reset() {
+	*x = RollbackTenantRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackTenantRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackTenantRepoRequest) ProtoMessage() {}
+
+func (x *RollbackTenantRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackTenantRepoRequest.ProtoReflect.Descriptor instead.
+func (*RollbackTenantRepoRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RollbackTenantRepoRequest) GetMeta() *TenantRepoMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RollbackTenantRepoRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type RollbackTenantRepoReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *RollbackTenantRepoReply) Reset() {
+	*x = RollbackTenantRepoReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackTenantRepoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackTenantRepoReply) ProtoMessage() {}
+
+func (x *RollbackTenantRepoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackTenantRepoReply.ProtoReflect.Descriptor instead.
+func (*RollbackTenantRepoReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RollbackTenantRepoReply) GetSecret() *SecretInfo {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type UndeleteTenantRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meta *TenantRepoMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (x *UndeleteTenantRepoRequest) Reset() {
+	*x = UndeleteTenantRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndeleteTenantRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteTenantRepoRequest) ProtoMessage() {}
+
+func (x *UndeleteTenantRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteTenantRepoRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteTenantRepoRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UndeleteTenantRepoRequest) GetMeta() *TenantRepoMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type UndeleteTenantRepoReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Paths of the roles which the policy of the secret is granted to again
+	GrantedRoles []string `protobuf:"bytes,2,rep,name=granted_roles,proto3" json:"granted_roles,omitempty"`
+}
+
+func (x *UndeleteTenantRepoReply) Reset() {
+	*x = UndeleteTenantRepoReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndeleteTenantRepoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteTenantRepoReply) ProtoMessage() {}
+
+func (x *UndeleteTenantRepoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteTenantRepoReply.ProtoReflect.Descriptor instead.
+func (*UndeleteTenantRepoReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UndeleteTenantRepoReply) GetSecret() *SecretInfo {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *UndeleteTenantRepoReply) GetGrantedRoles() []string {
+	if x != nil {
+		return x.GrantedRoles
+	}
+	return nil
+}
+
+type PurgeTenantRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meta *TenantRepoMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (x *PurgeTenantRepoRequest) Reset() {
+	*x = PurgeTenantRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeTenantRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTenantRepoRequest) ProtoMessage() {}
+
+func (x *PurgeTenantRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTenantRepoRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTenantRepoRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *PurgeTenantRepoRequest) GetMeta() *TenantRepoMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type RenderTenantRepoDockerConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meta *TenantRepoMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Name of the kubernetes secret, default is the path of the repo secret with "/" replaced by "-"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Namespace of the kubernetes secret, it is not set in the manifest if it is empty
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *RenderTenantRepoDockerConfigRequest) Reset() {
+	*x = RenderTenantRepoDockerConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenderTenantRepoDockerConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderTenantRepoDockerConfigRequest) ProtoMessage() {}
+
+func (x *RenderTenantRepoDockerConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderTenantRepoDockerConfigRequest.ProtoReflect.Descriptor instead.
+func (*RenderTenantRepoDockerConfigRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *RenderTenantRepoDockerConfigRequest) GetMeta() *TenantRepoMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RenderTenantRepoDockerConfigRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RenderTenantRepoDockerConfigRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type DockerConfigReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Kubernetes secret of type kubernetes.io/dockerconfigjson in yaml
+	Manifest string `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+}
+
+func (x *DockerConfigReply) Reset() {
+	*x = DockerConfigReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DockerConfigReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DockerConfigReply) ProtoMessage() {}
+
+func (x *DockerConfigReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DockerConfigReply.ProtoReflect.Descriptor instead.
+func (*DockerConfigReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DockerConfigReply) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
+
+// CLUSTER DEFINE
+type ClusterAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Kubeconfig file to access cluster
+	Kubeconfig string `protobuf:"bytes,1,opt,name=kubeconfig,proto3" json:"kubeconfig,omitempty"`
+	// Remove the contexts except the current one, and the clusters and users they refer to
+	StripContexts bool `protobuf:"varint,2,opt,name=strip_contexts,proto3" json:"strip_contexts,omitempty"`
+}
+
+func (x *ClusterAccount) Reset() {
+	*x = ClusterAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterAccount) ProtoMessage() {}
+
+func (x *ClusterAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterAccount.ProtoReflect.Descriptor instead.
+func (*ClusterAccount) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ClusterAccount) GetKubeconfig() string {
+	if x != nil {
+		return x.Kubeconfig
+	}
+	return ""
+}
+
+func (x *ClusterAccount) GetStripContexts() bool {
+	if x != nil {
+		return x.StripContexts
+	}
+	return false
+}
+
+type ClusterMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type of cluster, such as k8s, aws, virtual machine, only support "kubernetes"
+	Type       string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id         string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Username   string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Permission string `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
+}
+
+func (x *ClusterMeta) Reset() {
+	*x = ClusterMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterMeta) ProtoMessage() {}
+
+func (x *ClusterMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterMeta.ProtoReflect.Descriptor instead.
+func (*ClusterMeta) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ClusterMeta) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ClusterMeta) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClusterMeta) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ClusterMeta) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+type ClusterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meta *ClusterMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Cluster access info, such as kubeconfig when clsuter type is kubernetes
+	Account *ClusterAccount `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// Expected current version of the secret, it is only used by create.
+	// 0 means the secret must not exist, the secret is written without check if it is not set.
+	ExpectedVersion *int32 `protobuf:"varint,3,opt,name=expected_version,proto3,oneof" json:"expected_version,omitempty"`
+	// Time to live of the secret, such as "24h", the secret is purged by the reaper after it. It is only used by create.
+	Ttl string `protobuf:"bytes,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// Time the secret is purged by the reaper in RFC3339 format, it can not be set together with ttl. It is only used by create.
+	ExpireTime string `protobuf:"bytes,5,opt,name=expire_time,proto3" json:"expire_time,omitempty"`
+}
+
+func (x *ClusterRequest) Reset() {
+	*x = ClusterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterRequest) ProtoMessage() {}
+
+func (x *ClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterRequest.ProtoReflect.Descriptor instead.
+func (*ClusterRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ClusterRequest) GetMeta() *ClusterMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ClusterRequest) GetAccount() *ClusterAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *ClusterRequest) GetExpectedVersion() int32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *ClusterRequest) GetTtl() string {
+	if x != nil {
+		return x.Ttl
+	}
+	return ""
+}
+
+func (x *ClusterRequest) GetExpireTime() string {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return ""
+}
+
+type CreateClusterReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// What the request would change in vault, it is only set when the request is a dry run
+	DryRun *DryRunPlan `protobuf:"bytes,15,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *CreateClusterReply) Reset() {
+	*x = CreateClusterReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateClusterReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClusterReply) ProtoMessage() {}
+
+func (x *CreateClusterReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClusterReply.ProtoReflect.Descriptor instead.
+func (*CreateClusterReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CreateClusterReply) GetSecret() *SecretInfo {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *CreateClusterReply) GetDryRun() *DryRunPlan {
+	if x != nil {
+		return x.DryRun
+	}
+	return nil
+}
+
+type DeleteClusterReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// Paths of the roles which the policy of the secret is revoked from
+	RevokedRoles []string `protobuf:"bytes,2,rep,name=revoked_roles,proto3" json:"revoked_roles,omitempty"`
+	// What the request would change in vault, it is only set when the request is a dry run
+	DryRun *DryRunPlan `protobuf:"bytes,15,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *DeleteClusterReply) Reset() {
+	*x = DeleteClusterReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteClusterReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClusterReply) ProtoMessage() {}
+
+func (x *DeleteClusterReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClusterReply.ProtoReflect.Descriptor instead.
+func (*DeleteClusterReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DeleteClusterReply) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *DeleteClusterReply) GetRevokedRoles() []string {
+	if x != nil {
+		return x.RevokedRoles
+	}
+	return nil
+}
+
+func (x *DeleteClusterReply) GetDryRun() *DryRunPlan {
+	if x != nil {
+		return x.DryRun
+	}
+	return nil
+}
+
+type GetClusterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meta *ClusterMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (x *GetClusterRequest) Reset() {
+	*x = GetClusterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterRequest) ProtoMessage() {}
+
+func (x *GetClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterRequest.ProtoReflect.Descriptor instead.
+func (*GetClusterRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetClusterRequest) GetMeta() *ClusterMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type GetClusterReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret   *SecretInfo     `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Metadata *SecretMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *GetClusterReply) Reset() {
+	*x = GetClusterReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClusterReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterReply) ProtoMessage() {}
+
+func (x *GetClusterReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterReply.ProtoReflect.Descriptor instead.
+func (*GetClusterReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetClusterReply) GetSecret() *SecretInfo {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *GetClusterReply) GetMetadata() *SecretMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ListClusterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Fields are joined as the path prefix to list, fields after the first empty one are ignored
+	Type       string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id         string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Username   string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Permission string `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
+	// Max number of secrets in one page, default is 100
+	PageSize int32 `protobuf:"varint,5,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	// The next_page_token of the previous reply, empty for the first page
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListClusterRequest) Reset() {
+	*x = ListClusterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClusterRequest) ProtoMessage() {}
+
+func (x *ListClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClusterRequest.ProtoReflect.Descriptor instead.
+func (*ListClusterRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListClusterRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListClusterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListClusterRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ListClusterRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *ListClusterRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListClusterRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListClusterReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secrets       []*SecretInfo `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListClusterReply) Reset() {
+	*x = ListClusterReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListClusterReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClusterReply) ProtoMessage() {}
+
+func (x *ListClusterReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClusterReply.ProtoReflect.Descriptor instead.
+func (*ListClusterReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListClusterReply) GetSecrets() []*SecretInfo {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *ListClusterReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListClusterVersionsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Versions []*SecretVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+}
+
+func (x *ListClusterVersionsReply) Reset() {
+	*x = ListClusterVersionsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListClusterVersionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClusterVersionsReply) ProtoMessage() {}
+
+func (x *ListClusterVersionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClusterVersionsReply.ProtoReflect.Descriptor instead.
+func (*ListClusterVersionsReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListClusterVersionsReply) GetVersions() []*SecretVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type RollbackClusterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meta *ClusterMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// The version to roll back to, it must not be deleted or destroyed
+	Version int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *RollbackClusterRequest) Reset() {
+	*x = RollbackClusterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackClusterRequest) ProtoMessage() {}
+
+func (x *RollbackClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackClusterRequest.ProtoReflect.Descriptor instead.
+func (*RollbackClusterRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *RollbackClusterRequest) GetMeta() *ClusterMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RollbackClusterRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type RollbackClusterReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *RollbackClusterReply) Reset() {
+	*x = RollbackClusterReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackClusterReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackClusterReply) ProtoMessage() {}
+
+func (x *RollbackClusterReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackClusterReply.ProtoReflect.Descriptor instead.
+func (*RollbackClusterReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *RollbackClusterReply) GetSecret() *SecretInfo {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type UndeleteClusterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meta *ClusterMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (x *UndeleteClusterRequest) Reset() {
+	*x = UndeleteClusterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndeleteClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteClusterRequest) ProtoMessage() {}
+
+func (x *UndeleteClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteClusterRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteClusterRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *UndeleteClusterRequest) GetMeta() *ClusterMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type UndeleteClusterReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Paths of the roles which the policy of the secret is granted to again
+	GrantedRoles []string `protobuf:"bytes,2,rep,name=granted_roles,proto3" json:"granted_roles,omitempty"`
+}
+
+func (x *UndeleteClusterReply) Reset() {
+	*x = UndeleteClusterReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndeleteClusterReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteClusterReply) ProtoMessage() {}
+
+func (x *UndeleteClusterReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteClusterReply.ProtoReflect.Descriptor instead.
+func (*UndeleteClusterReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *UndeleteClusterReply) GetSecret() *SecretInfo {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *UndeleteClusterReply) GetGrantedRoles() []string {
+	if x != nil {
+		return x.GrantedRoles
+	}
+	return nil
+}
+
+type PurgeClusterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meta *ClusterMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (x *PurgeClusterRequest) Reset() {
+	*x = PurgeClusterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeClusterRequest) ProtoMessage() {}
+
+func (x *PurgeClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeClusterRequest.ProtoReflect.Descriptor instead.
+func (*PurgeClusterRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *PurgeClusterRequest) GetMeta() *ClusterMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+// GENERIC DEFINE
+type CreateSecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the registered secret type
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Fields of the path template of the secret type
+	Meta map[string]string `protobuf:"bytes,2,rep,name=meta,proto3" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The key values will store in vault
+	Data map[string]string `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Expected current version of the secret.
+	// 0 means the secret must not exist, the secret is written without check if it is not set.
+	ExpectedVersion *int32 `protobuf:"varint,4,opt,name=expected_version,proto3,oneof" json:"expected_version,omitempty"`
+	// Time to live of the secret, such as "24h", the secret is purged by the reaper after it. It is only used by create.
+	Ttl string `protobuf:"bytes,5,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// Time the secret is purged by the reaper in RFC3339 format, it can not be set together with ttl. It is only used by create.
+	ExpireTime string `protobuf:"bytes,6,opt,name=expire_time,proto3" json:"expire_time,omitempty"`
+}
+
+func (x *CreateSecretRequest) Reset() {
+	*x = CreateSecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSecretRequest) ProtoMessage() {}
+
+func (x *CreateSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSecretRequest.ProtoReflect.Descriptor instead.
+func (*CreateSecretRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *CreateSecretRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateSecretRequest) GetMeta() map[string]string {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CreateSecretRequest) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateSecretRequest) GetExpectedVersion() int32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *CreateSecretRequest) GetTtl() string {
+	if x != nil {
+		return x.Ttl
+	}
+	return ""
+}
+
+func (x *CreateSecretRequest) GetExpireTime() string {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return ""
+}
+
+type CreateSecretReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// What the request would change in vault, it is only set when the request is a dry run
+	DryRun *DryRunPlan `protobuf:"bytes,15,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *CreateSecretReply) Reset() {
+	*x = CreateSecretReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSecretReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSecretReply) ProtoMessage() {}
+
+func (x *CreateSecretReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSecretReply.ProtoReflect.Descriptor instead.
+func (*CreateSecretReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *CreateSecretReply) GetSecret() *SecretInfo {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *CreateSecretReply) GetDryRun() *DryRunPlan {
+	if x != nil {
+		return x.DryRun
+	}
+	return nil
+}
+
+type GetSecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type string            `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Meta map[string]string `protobuf:"bytes,2,rep,name=meta,proto3" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetSecretRequest) Reset() {
+	*x = GetSecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretRequest) ProtoMessage() {}
+
+func (x *GetSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretRequest.ProtoReflect.Descriptor instead.
+func (*GetSecretRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetSecretRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GetSecretRequest) GetMeta() map[string]string {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type GetSecretReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret   *SecretInfo     `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Metadata *SecretMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *GetSecretReply) Reset() {
+	*x = GetSecretReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSecretReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretReply) ProtoMessage() {}
+
+func (x *GetSecretReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretReply.ProtoReflect.Descriptor instead.
+func (*GetSecretReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetSecretReply) GetSecret() *SecretInfo {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *GetSecretReply) GetMetadata() *SecretMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type DeleteSecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type string            `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Meta map[string]string `protobuf:"bytes,2,rep,name=meta,proto3" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *DeleteSecretRequest) Reset() {
+	*x = DeleteSecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSecretRequest) ProtoMessage() {}
+
+func (x *DeleteSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSecretRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *DeleteSecretRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DeleteSecretRequest) GetMeta() map[string]string {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type DeleteSecretReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// Paths of the roles which the policy of the secret is revoked from
+	RevokedRoles []string `protobuf:"bytes,2,rep,name=revoked_roles,proto3" json:"revoked_roles,omitempty"`
+	// What the request would change in vault, it is only set when the request is a dry run
+	DryRun *DryRunPlan `protobuf:"bytes,15,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *DeleteSecretReply) Reset() {
+	*x = DeleteSecretReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSecretReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSecretReply) ProtoMessage() {}
+
+func (x *DeleteSecretReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSecretReply.ProtoReflect.Descriptor instead.
+func (*DeleteSecretReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *DeleteSecretReply) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *DeleteSecretReply) GetRevokedRoles() []string {
+	if x != nil {
+		return x.RevokedRoles
+	}
+	return nil
+}
+
+func (x *DeleteSecretReply) GetDryRun() *DryRunPlan {
+	if x != nil {
+		return x.DryRun
+	}
+	return nil
+}
+
+type UndeleteSecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type string            `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Meta map[string]string `protobuf:"bytes,2,rep,name=meta,proto3" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *UndeleteSecretRequest) Reset() {
+	*x = UndeleteSecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndeleteSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteSecretRequest) ProtoMessage() {}
+
+func (x *UndeleteSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteSecretRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteSecretRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *UndeleteSecretRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UndeleteSecretRequest) GetMeta() map[string]string {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type UndeleteSecretReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Paths of the roles which the policy of the secret is granted to again
+	GrantedRoles []string `protobuf:"bytes,2,rep,name=granted_roles,proto3" json:"granted_roles,omitempty"`
+}
+
+func (x *UndeleteSecretReply) Reset() {
+	*x = UndeleteSecretReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndeleteSecretReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteSecretReply) ProtoMessage() {}
+
+func (x *UndeleteSecretReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteSecretReply.ProtoReflect.Descriptor instead.
+func (*UndeleteSecretReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *UndeleteSecretReply) GetSecret() *SecretInfo {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *UndeleteSecretReply) GetGrantedRoles() []string {
+	if x != nil {
+		return x.GrantedRoles
+	}
+	return nil
+}
+
+type PurgeSecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type string            `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Meta map[string]string `protobuf:"bytes,2,rep,name=meta,proto3" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *PurgeSecretRequest) Reset() {
+	*x = PurgeSecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeSecretRequest) ProtoMessage() {}
+
+func (x *PurgeSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeSecretRequest.ProtoReflect.Descriptor instead.
+func (*PurgeSecretRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *PurgeSecretRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PurgeSecretRequest) GetMeta() map[string]string {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type UpdateSecretOwnersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type string            `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Meta map[string]string `protobuf:"bytes,2,rep,name=meta,proto3" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Common names of the client certs, the co-owners are removed if it is empty
+	CoOwners []string `protobuf:"bytes,3,rep,name=co_owners,proto3" json:"co_owners,omitempty"`
+}
+
+func (x *UpdateSecretOwnersRequest) Reset() {
+	*x = UpdateSecretOwnersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSecretOwnersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSecretOwnersRequest) ProtoMessage() {}
+
+func (x *UpdateSecretOwnersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSecretOwnersRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSecretOwnersRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *UpdateSecretOwnersRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UpdateSecretOwnersRequest) GetMeta() map[string]string {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *UpdateSecretOwnersRequest) GetCoOwners() []string {
+	if x != nil {
+		return x.CoOwners
+	}
+	return nil
+}
+
+type UpdateSecretOwnersReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret   *SecretInfo     `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Metadata *SecretMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *UpdateSecretOwnersReply) Reset() {
+	*x = UpdateSecretOwnersReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSecretOwnersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSecretOwnersReply) ProtoMessage() {}
+
+func (x *UpdateSecretOwnersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSecretOwnersReply.ProtoReflect.Descriptor instead.
+func (*UpdateSecretOwnersReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *UpdateSecretOwnersReply) GetSecret() *SecretInfo {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *UpdateSecretOwnersReply) GetMetadata() *SecretMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type BatchCreateSecretsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*CreateSecretRequest `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// All or nothing. The written secrets are rolled back if any of them fails,
+	// otherwise each secret is written on its own and its result is returned
+	Atomic bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+}
+
+func (x *BatchCreateSecretsRequest) Reset() {
+	*x = BatchCreateSecretsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateSecretsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateSecretsRequest) ProtoMessage() {}
+
+func (x *BatchCreateSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateSecretsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *BatchCreateSecretsRequest) GetItems() []*CreateSecretRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchCreateSecretsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type BatchDeleteSecretsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*DeleteSecretRequest `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// All or nothing. The deleted secrets are undeleted if any of them fails,
+	// otherwise each secret is deleted on its own and its result is returned
+	Atomic bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+}
+
+func (x *BatchDeleteSecretsRequest) Reset() {
+	*x = BatchDeleteSecretsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchDeleteSecretsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteSecretsRequest) ProtoMessage() {}
+
+func (x *BatchDeleteSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteSecretsRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *BatchDeleteSecretsRequest) GetItems() []*DeleteSecretRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchDeleteSecretsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+// Result of a secret in the batch request, in the order of the items
+type BatchSecretResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret *SecretInfo `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Paths of the roles which the policy of the secret is revoked from by the delete
+	RevokedRoles []string `protobuf:"bytes,2,rep,name=revoked_roles,proto3" json:"revoked_roles,omitempty"`
+	// Reason and message of the error, they are empty if the secret succeeds
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// The secret is written and then rolled back, because another secret in the atomic batch failed
+	RolledBack bool `protobuf:"varint,5,opt,name=rolled_back,proto3" json:"rolled_back,omitempty"`
+}
+
+func (x *BatchSecretResult) Reset() {
+	*x = BatchSecretResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchSecretResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSecretResult) ProtoMessage() {}
+
+func (x *BatchSecretResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSecretResult.ProtoReflect.Descriptor instead.
+func (*BatchSecretResult) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *BatchSecretResult) GetSecret() *SecretInfo {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *BatchSecretResult) GetRevokedRoles() []string {
+	if x != nil {
+		return x.RevokedRoles
+	}
+	return nil
+}
+
+func (x *BatchSecretResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BatchSecretResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BatchSecretResult) GetRolledBack() bool {
+	if x != nil {
+		return x.RolledBack
+	}
+	return false
+}
+
+type BatchSecretsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*BatchSecretResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchSecretsReply) Reset() {
+	*x = BatchSecretsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchSecretsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSecretsReply) ProtoMessage() {}
+
+func (x *BatchSecretsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSecretsReply.ProtoReflect.Descriptor instead.
+func (*BatchSecretsReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *BatchSecretsReply) GetResults() []*BatchSecretResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type Kubernetes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Kubernetes URL
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Kubernetes cabundle when url is https
+	Cabundle string `protobuf:"bytes,2,opt,name=cabundle,proto3" json:"cabundle,omitempty"`
+	// The k8s service account token witch has "system:auth-delegator" role
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *Kubernetes) Reset() {
+	*x = Kubernetes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Kubernetes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Kubernetes) ProtoMessage() {}
+
+func (x *Kubernetes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Kubernetes.ProtoReflect.Descriptor instead.
+func (*Kubernetes) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *Kubernetes) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Kubernetes) GetCabundle() string {
+	if x != nil {
+		return x.Cabundle
+	}
+	return ""
+}
+
+func (x *Kubernetes) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type AuthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Vault auth path
+	ClusterName string `protobuf:"bytes,1,opt,name=cluster_name,proto3" json:"cluster_name,omitempty"`
+	// Vault auth type
+	AuthType string `protobuf:"bytes,2,opt,name=auth_type,proto3" json:"auth_type,omitempty"`
+	// Vault auth setting when type is k8s
+	Kubernetes *Kubernetes `protobuf:"bytes,3,opt,name=kubernetes,proto3" json:"kubernetes,omitempty"`
+}
+
+func (x *AuthRequest) Reset() {
+	*x = AuthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthRequest) ProtoMessage() {}
+
+func (x *AuthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthRequest.ProtoReflect.Descriptor instead.
+func (*AuthRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *AuthRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *AuthRequest) GetAuthType() string {
+	if x != nil {
+		return x.AuthType
+	}
+	return ""
+}
+
+func (x *AuthRequest) GetKubernetes() *Kubernetes {
+	if x != nil {
+		return x.Kubernetes
+	}
+	return nil
+}
+
+type CreateAuthReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// What the request would change in vault, it is only set when the request is a dry run
+	DryRun *DryRunPlan `protobuf:"bytes,15,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *CreateAuthReply) Reset() {
+	*x = CreateAuthReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAuthReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAuthReply) ProtoMessage() {}
+
+func (x *CreateAuthReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAuthReply.ProtoReflect.Descriptor instead.
+func (*CreateAuthReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *CreateAuthReply) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *CreateAuthReply) GetDryRun() *DryRunPlan {
+	if x != nil {
+		return x.DryRun
+	}
+	return nil
+}
+
+type DeleteAuthReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *DeleteAuthReply) Reset() {
+	*x = DeleteAuthReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAuthReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAuthReply) ProtoMessage() {}
+
+func (x *DeleteAuthReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAuthReply.ProtoReflect.Descriptor instead.
+func (*DeleteAuthReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *DeleteAuthReply) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type KubernetesAuthRoleMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespaces      []string `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	ServiceAccounts []string `protobuf:"bytes,2,rep,name=service_accounts,proto3" json:"service_accounts,omitempty"`
+}
+
+func (x *KubernetesAuthRoleMeta) Reset() {
+	*x = KubernetesAuthRoleMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KubernetesAuthRoleMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KubernetesAuthRoleMeta) ProtoMessage() {}
+
+func (x *KubernetesAuthRoleMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KubernetesAuthRoleMeta.ProtoReflect.Descriptor instead.
+func (*KubernetesAuthRoleMeta) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *KubernetesAuthRoleMeta) GetNamespaces() []string {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *KubernetesAuthRoleMeta) GetServiceAccounts() []string {
+	if x != nil {
+		return x.ServiceAccounts
+	}
+	return nil
+}
+
+type AuthroleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Vault path of kubernetes auth
+	ClusterName string `protobuf:"bytes,1,opt,name=cluster_name,proto3" json:"cluster_name,omitempty"`
+	// Vault role name in kubernetes auth
+	DestUser string `protobuf:"bytes,2,opt,name=dest_user,proto3" json:"dest_user,omitempty"`
+	// Types that are assignable to Role:
+	//
+	//	*AuthroleRequest_Kubernetes
+	Role isAuthroleRequest_Role `protobuf_oneof:"role"`
+}
+
+func (x *AuthroleRequest) Reset() {
+	*x = AuthroleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthroleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthroleRequest) ProtoMessage() {}
+
+func (x *AuthroleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthroleRequest.ProtoReflect.Descriptor instead.
+func (*AuthroleRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *AuthroleRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *AuthroleRequest) GetDestUser() string {
+	if x != nil {
+		return x.DestUser
+	}
+	return ""
+}
+
+func (m *AuthroleRequest) GetRole() isAuthroleRequest_Role {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+func (x *AuthroleRequest) GetKubernetes() *KubernetesAuthRoleMeta {
+	if x, ok := x.GetRole().(*AuthroleRequest_Kubernetes); ok {
+		return x.Kubernetes
+	}
+	return nil
+}
+
+type isAuthroleRequest_Role interface {
+	isAuthroleRequest_Role()
+}
+
+type AuthroleRequest_Kubernetes struct {
+	Kubernetes *KubernetesAuthRoleMeta `protobuf:"bytes,3,opt,name=kubernetes,proto3,oneof"`
+}
+
+func (*AuthroleRequest_Kubernetes) isAuthroleRequest_Role() {}
+
+type CreateAuthroleReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// What the request would change in vault, it is only set when the request is a dry run
+	DryRun *DryRunPlan `protobuf:"bytes,15,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *CreateAuthroleReply) Reset() {
+	*x = CreateAuthroleReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAuthroleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAuthroleReply) ProtoMessage() {}
+
+func (x *CreateAuthroleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAuthroleReply.ProtoReflect.Descriptor instead.
+func (*CreateAuthroleReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *CreateAuthroleReply) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *CreateAuthroleReply) GetDryRun() *DryRunPlan {
+	if x != nil {
+		return x.DryRun
+	}
+	return nil
+}
+
+type DeleteAuthroleReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *DeleteAuthroleReply) Reset() {
+	*x = DeleteAuthroleReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAuthroleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAuthroleReply) ProtoMessage() {}
+
+func (x *DeleteAuthroleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAuthroleReply.ProtoReflect.Descriptor instead.
+func (*DeleteAuthroleReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *DeleteAuthroleReply) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type AuthroleGitPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClusterName string   `protobuf:"bytes,1,opt,name=cluster_name,proto3" json:"cluster_name,omitempty"`
+	DestUser    string   `protobuf:"bytes,2,opt,name=dest_user,proto3" json:"dest_user,omitempty"`
+	Secret      *GitMeta `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *AuthroleGitPolicyRequest) Reset() {
+	*x = AuthroleGitPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthroleGitPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthroleGitPolicyRequest) ProtoMessage() {}
+
+func (x *AuthroleGitPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthroleGitPolicyRequest.ProtoReflect.Descriptor instead.
+func (*AuthroleGitPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *AuthroleGitPolicyRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *AuthroleGitPolicyRequest) GetDestUser() string {
+	if x != nil {
+		return x.DestUser
+	}
+	return ""
+}
+
+func (x *AuthroleGitPolicyRequest) GetSecret() *GitMeta {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type AuthroleRepoPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClusterName string    `protobuf:"bytes,1,opt,name=cluster_name,proto3" json:"cluster_name,omitempty"`
+	DestUser    string    `protobuf:"bytes,2,opt,name=dest_user,proto3" json:"dest_user,omitempty"`
+	Secret      *RepoMeta `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *AuthroleRepoPolicyRequest) Reset() {
+	*x = AuthroleRepoPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthroleRepoPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthroleRepoPolicyRequest) ProtoMessage() {}
+
+func (x *AuthroleRepoPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthroleRepoPolicyRequest.ProtoReflect.Descriptor instead.
+func (*AuthroleRepoPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *AuthroleRepoPolicyRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *AuthroleRepoPolicyRequest) GetDestUser() string {
+	if x != nil {
+		return x.DestUser
+	}
+	return ""
+}
+
+func (x *AuthroleRepoPolicyRequest) GetSecret() *RepoMeta {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type AuthroleClusterPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClusterName string       `protobuf:"bytes,1,opt,name=cluster_name,proto3" json:"cluster_name,omitempty"`
+	DestUser    string       `protobuf:"bytes,2,opt,name=dest_user,proto3" json:"dest_user,omitempty"`
+	Secret      *ClusterMeta `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *AuthroleClusterPolicyRequest) Reset() {
+	*x = AuthroleClusterPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthroleClusterPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthroleClusterPolicyRequest) ProtoMessage() {}
+
+func (x *AuthroleClusterPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthroleClusterPolicyRequest.ProtoReflect.Descriptor instead.
+func (*AuthroleClusterPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *AuthroleClusterPolicyRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *AuthroleClusterPolicyRequest) GetDestUser() string {
+	if x != nil {
+		return x.DestUser
+	}
+	return ""
+}
+
+func (x *AuthroleClusterPolicyRequest) GetSecret() *ClusterMeta {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type AuthroleTenantGitPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClusterName string         `protobuf:"bytes,1,opt,name=cluster_name,proto3" json:"cluster_name,omitempty"`
+	DestUser    string         `protobuf:"bytes,2,opt,name=dest_user,proto3" json:"dest_user,omitempty"`
+	Secret      *TenantGitMeta `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *AuthroleTenantGitPolicyRequest) Reset() {
+	*x = AuthroleTenantGitPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthroleTenantGitPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthroleTenantGitPolicyRequest) ProtoMessage() {}
+
+func (x *AuthroleTenantGitPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthroleTenantGitPolicyRequest.ProtoReflect.Descriptor instead.
+func (*AuthroleTenantGitPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *AuthroleTenantGitPolicyRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *AuthroleTenantGitPolicyRequest) GetDestUser() string {
+	if x != nil {
+		return x.DestUser
+	}
+	return ""
+}
+
+func (x *AuthroleTenantGitPolicyRequest) GetSecret() *TenantGitMeta {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type AuthroleTenantRepoPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClusterName string          `protobuf:"bytes,1,opt,name=cluster_name,proto3" json:"cluster_name,omitempty"`
+	DestUser    string          `protobuf:"bytes,2,opt,name=dest_user,proto3" json:"dest_user,omitempty"`
+	Secret      *TenantRepoMeta `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *AuthroleTenantRepoPolicyRequest) Reset() {
+	*x = AuthroleTenantRepoPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthroleTenantRepoPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthroleTenantRepoPolicyRequest) ProtoMessage() {}
+
+func (x *AuthroleTenantRepoPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthroleTenantRepoPolicyRequest.ProtoReflect.Descriptor instead.
+func (*AuthroleTenantRepoPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *AuthroleTenantRepoPolicyRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *AuthroleTenantRepoPolicyRequest) GetDestUser() string {
+	if x != nil {
+		return x.DestUser
+	}
+	return ""
+}
+
+func (x *AuthroleTenantRepoPolicyRequest) GetSecret() *TenantRepoMeta {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type GrantAuthrolePolicyReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// What the request would change in vault, it is only set when the request is a dry run
+	DryRun *DryRunPlan `protobuf:"bytes,15,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *GrantAuthrolePolicyReply) Reset() {
+	*x = GrantAuthrolePolicyReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantAuthrolePolicyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantAuthrolePolicyReply) ProtoMessage() {}
+
+func (x *GrantAuthrolePolicyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantAuthrolePolicyReply.ProtoReflect.Descriptor instead.
+func (*GrantAuthrolePolicyReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GrantAuthrolePolicyReply) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GrantAuthrolePolicyReply) GetDryRun() *DryRunPlan {
+	if x != nil {
+		return x.DryRun
+	}
+	return nil
+}
+
+type RevokeAuthrolePolicyReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// What the request would change in vault, it is only set when the request is a dry run
+	DryRun *DryRunPlan `protobuf:"bytes,15,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *RevokeAuthrolePolicyReply) Reset() {
+	*x = RevokeAuthrolePolicyReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeAuthrolePolicyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAuthrolePolicyReply) ProtoMessage() {}
+
+func (x *RevokeAuthrolePolicyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAuthrolePolicyReply.ProtoReflect.Descriptor instead.
+func (*RevokeAuthrolePolicyReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *RevokeAuthrolePolicyReply) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *RevokeAuthrolePolicyReply) GetDryRun() *DryRunPlan {
+	if x != nil {
+		return x.DryRun
+	}
+	return nil
+}
+
+// Drift between secrets, policies and roles
+type Drift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// policy_without_secret, secret_without_policy or role_with_missing_policy
+	Kind       string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	SecretName string `protobuf:"bytes,2,opt,name=secret_name,proto3" json:"secret_name,omitempty"`
+	SecretPath string `protobuf:"bytes,3,opt,name=secret_path,proto3" json:"secret_path,omitempty"`
+	PolicyName string `protobuf:"bytes,4,opt,name=policy_name,proto3" json:"policy_name,omitempty"`
+	RolePath   string `protobuf:"bytes,5,opt,name=role_path,proto3" json:"role_path,omitempty"`
+	Repaired   bool   `protobuf:"varint,6,opt,name=repaired,proto3" json:"repaired,omitempty"`
+}
+
+func (x *Drift) Reset() {
+	*x = Drift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Drift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Drift) ProtoMessage() {}
+
+func (x *Drift) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Drift.ProtoReflect.Descriptor instead.
+func (*Drift) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *Drift) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Drift) GetSecretName() string {
+	if x != nil {
+		return x.SecretName
+	}
+	return ""
+}
+
+func (x *Drift) GetSecretPath() string {
+	if x != nil {
+		return x.SecretPath
+	}
+	return ""
+}
+
+func (x *Drift) GetPolicyName() string {
+	if x != nil {
+		return x.PolicyName
+	}
+	return ""
+}
+
+func (x *Drift) GetRolePath() string {
+	if x != nil {
+		return x.RolePath
+	}
+	return ""
+}
+
+func (x *Drift) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
+}
+
+type GetDriftRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetDriftRequest) Reset() {
+	*x = GetDriftRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDriftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDriftRequest) ProtoMessage() {}
+
+func (x *GetDriftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDriftRequest.ProtoReflect.Descriptor instead.
+func (*GetDriftRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{121}
+}
+
+type ReconcileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Repair the drifts after they are found
+	Repair bool `protobuf:"varint,1,opt,name=repair,proto3" json:"repair,omitempty"`
+}
+
+func (x *ReconcileRequest) Reset() {
+	*x = ReconcileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileRequest) ProtoMessage() {}
+
+func (x *ReconcileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ReconcileRequest) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
+}
+
+type DriftReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Drifts []*Drift `protobuf:"bytes,1,rep,name=drifts,proto3" json:"drifts,omitempty"`
+	// Time of the reconcile in RFC3339 format, it is empty if there is no reconcile yet
+	CheckedTime string `protobuf:"bytes,2,opt,name=checked_time,proto3" json:"checked_time,omitempty"`
+}
+
+func (x *DriftReply) Reset() {
+	*x = DriftReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DriftReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriftReply) ProtoMessage() {}
+
+func (x *DriftReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriftReply.ProtoReflect.Descriptor instead.
+func (*DriftReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *DriftReply) GetDrifts() []*Drift {
+	if x != nil {
+		return x.Drifts
+	}
+	return nil
+}
+
+func (x *DriftReply) GetCheckedTime() string {
+	if x != nil {
+		return x.CheckedTime
+	}
+	return ""
+}
+
+type ListExpirationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only the secrets expire within the duration are returned, such as "24h". All the secrets with expire time are returned if it is empty
+	Within string `protobuf:"bytes,1,opt,name=within,proto3" json:"within,omitempty"`
+}
+
+func (x *ListExpirationsRequest) Reset() {
+	*x = ListExpirationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExpirationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpirationsRequest) ProtoMessage() {}
+
+func (x *ListExpirationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpirationsRequest.ProtoReflect.Descriptor instead.
+func (*ListExpirationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ListExpirationsRequest) GetWithin() string {
+	if x != nil {
+		return x.Within
+	}
+	return ""
+}
+
+// A secret which will be purged by the reaper
+type SecretExpiration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SecretName string `protobuf:"bytes,1,opt,name=secret_name,proto3" json:"secret_name,omitempty"`
+	SecretPath string `protobuf:"bytes,2,opt,name=secret_path,proto3" json:"secret_path,omitempty"`
+	// Time the secret is purged in RFC3339 format
+	ExpireTime string `protobuf:"bytes,3,opt,name=expire_time,proto3" json:"expire_time,omitempty"`
+}
+
+func (x *SecretExpiration) Reset() {
+	*x = SecretExpiration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecretExpiration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretExpiration) ProtoMessage() {}
+
+func (x *SecretExpiration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretExpiration.ProtoReflect.Descriptor instead.
+func (*SecretExpiration) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *SecretExpiration) GetSecretName() string {
+	if x != nil {
+		return x.SecretName
+	}
+	return ""
+}
+
+func (x *SecretExpiration) GetSecretPath() string {
+	if x != nil {
+		return x.SecretPath
+	}
+	return ""
+}
+
+func (x *SecretExpiration) GetExpireTime() string {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return ""
+}
+
+type ListExpirationsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Secrets sorted by the expire time
+	Expirations []*SecretExpiration `protobuf:"bytes,1,rep,name=expirations,proto3" json:"expirations,omitempty"`
+}
+
+func (x *ListExpirationsReply) Reset() {
+	*x = ListExpirationsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExpirationsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpirationsReply) ProtoMessage() {}
+
+func (x *ListExpirationsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpirationsReply.ProtoReflect.Descriptor instead.
+func (*ListExpirationsReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ListExpirationsReply) GetExpirations() []*SecretExpiration {
+	if x != nil {
+		return x.Expirations
+	}
+	return nil
+}
+
+type ListRotationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only the secrets older than their rotation period are returned
+	StaleOnly bool `protobuf:"varint,1,opt,name=stale_only,proto3" json:"stale_only,omitempty"`
+}
+
+func (x *ListRotationsRequest) Reset() {
+	*x = ListRotationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRotationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRotationsRequest) ProtoMessage() {}
+
+func (x *ListRotationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRotationsRequest.ProtoReflect.Descriptor instead.
+func (*ListRotationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ListRotationsRequest) GetStaleOnly() bool {
+	if x != nil {
+		return x.StaleOnly
+	}
+	return false
+}
+
+// Request of GET /v1/rotations/export, which downloads the rotation report as a file
+type ExportRotationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StaleOnly bool `protobuf:"varint,1,opt,name=stale_only,proto3" json:"stale_only,omitempty"`
+	// Format of the file, csv or json. Default is csv
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (x *ExportRotationsRequest) Reset() {
+	*x = ExportRotationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportRotationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRotationsRequest) ProtoMessage() {}
+
+func (x *ExportRotationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRotationsRequest.ProtoReflect.Descriptor instead.
+func (*ExportRotationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ExportRotationsRequest) GetStaleOnly() bool {
+	if x != nil {
+		return x.StaleOnly
+	}
+	return false
+}
+
+func (x *ExportRotationsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// Age of the current version of a secret
+type SecretRotation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SecretName string `protobuf:"bytes,1,opt,name=secret_name,proto3" json:"secret_name,omitempty"`
+	SecretPath string `protobuf:"bytes,2,opt,name=secret_path,proto3" json:"secret_path,omitempty"`
+	SecretType string `protobuf:"bytes,3,opt,name=secret_type,proto3" json:"secret_type,omitempty"`
+	Version    int32  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	// Time the current version is written in RFC3339 format
+	VersionTime string `protobuf:"bytes,5,opt,name=version_time,proto3" json:"version_time,omitempty"`
+	AgeSeconds  int64  `protobuf:"varint,6,opt,name=age_seconds,proto3" json:"age_seconds,omitempty"`
+	// Common name of the client cert which creates the secret
+	CreatedBy string `protobuf:"bytes,7,opt,name=created_by,proto3" json:"created_by,omitempty"`
+	// Roles which are granted the secret, in the format of auth/<auth name>/role/<role name>
+	Roles []string `protobuf:"bytes,8,rep,name=roles,proto3" json:"roles,omitempty"`
+	// Rotation period of the secret type, it is 0 if the period is not configured
+	RotationPeriodSeconds int64 `protobuf:"varint,9,opt,name=rotation_period_seconds,proto3" json:"rotation_period_seconds,omitempty"`
+	// The current version is older than the rotation period
+	Stale bool `protobuf:"varint,10,opt,name=stale,proto3" json:"stale,omitempty"`
+}
+
+func (x *SecretRotation) Reset() {
+	*x = SecretRotation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecretRotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretRotation) ProtoMessage() {}
+
+func (x *SecretRotation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretRotation.ProtoReflect.Descriptor instead.
+func (*SecretRotation) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *SecretRotation) GetSecretName() string {
+	if x != nil {
+		return x.SecretName
+	}
+	return ""
+}
+
+func (x *SecretRotation) GetSecretPath() string {
+	if x != nil {
+		return x.SecretPath
+	}
+	return ""
+}
+
+func (x *SecretRotation) GetSecretType() string {
+	if x != nil {
+		return x.SecretType
+	}
+	return ""
+}
+
+func (x *SecretRotation) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SecretRotation) GetVersionTime() string {
+	if x != nil {
+		return x.VersionTime
+	}
+	return ""
+}
+
+func (x *SecretRotation) GetAgeSeconds() int64 {
+	if x != nil {
+		return x.AgeSeconds
+	}
+	return 0
+}
+
+func (x *SecretRotation) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *SecretRotation) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *SecretRotation) GetRotationPeriodSeconds() int64 {
+	if x != nil {
+		return x.RotationPeriodSeconds
+	}
+	return 0
+}
+
+func (x *SecretRotation) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+type ListRotationsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rotations []*SecretRotation `protobuf:"bytes,1,rep,name=rotations,proto3" json:"rotations,omitempty"`
+	// Time the report is generated in RFC3339 format
+	CheckedTime string `protobuf:"bytes,2,opt,name=checked_time,proto3" json:"checked_time,omitempty"`
+}
+
+func (x *ListRotationsReply) Reset() {
+	*x = ListRotationsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRotationsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRotationsReply) ProtoMessage() {}
+
+func (x *ListRotationsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRotationsReply.ProtoReflect.Descriptor instead.
+func (*ListRotationsReply) Descriptor() ([]byte, []int) {
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ListRotationsReply) GetRotations() []*SecretRotation {
+	if x != nil {
+		return x.Rotations
+	}
+	return nil
+}
+
+func (x *ListRotationsReply) GetCheckedTime() string {
+	if x != nil {
+		return x.CheckedTime
+	}
+	return ""
+}
+
+var File_api_vaultproxy_v1_vaultproxy_proto protoreflect.FileDescriptor
+
+var file_api_vaultproxy_v1_vaultproxy_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x87, 0x02, 0x0a, 0x0a, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x50, 0x6c,
+	0x61, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x12, 0x31, 0x0a, 0x05, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x90, 0x02,
+	0x0a, 0x08, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x69, 0x66, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f,
+	0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x6f, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x12, 0x3f, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x64, 0x64, 0x65, 0x64,
+	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x4e, 0x0a, 0x0a, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0xb2, 0x02, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x5f, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x5f, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64,
+	0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x65, 0x64, 0x22, 0xda, 0x01, 0x0a, 0x06, 0x47, 0x69,
+	0x74, 0x4b, 0x56, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x5f, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x4c, 0x0a, 0x0b, 0x61, 0x64, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x69, 0x74, 0x4b, 0x56, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x64, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9f, 0x01, 0x0a, 0x07, 0x47, 0x69, 0x74, 0x4d, 0x65,
+	0x74, 0x61, 0x12, 0x2d, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x27, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xf3, 0x02, 0x0a, 0x0a, 0x47, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74,
+	0x61, 0x12, 0x35, 0x0a, 0x03, 0x6b, 0x76, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x4b, 0x56, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x03, 0x6b, 0x76, 0x73, 0x12, 0x38, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x48, 0x00, 0x52, 0x10, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x30, 0x0a, 0x13, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x64,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x13, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x5f, 0x6b, 0x65, 0x79, 0x12, 0x3f, 0x0a, 0x0f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x6b,
+	0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xfa,
+	0x42, 0x12, 0x72, 0x10, 0x52, 0x00, 0x52, 0x07, 0x65, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x52,
+	0x03, 0x72, 0x73, 0x61, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x6b, 0x65, 0x79,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa0,
+	0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f,
+	0x72, 0x75, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75,
+	0x6e, 0x22, 0x81, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x69, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x07, 0x64, 0x72,
+	0x79, 0x5f, 0x72, 0x75, 0x6e, 0x22, 0x49, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61,
+	0x22, 0x83, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x3d, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xcc, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x47,
+	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a,
+	0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x71, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x69, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x28,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x54, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x47, 0x69, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x3c, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x71,
+	0x0a, 0x12, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x21,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x49, 0x0a, 0x10, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x47, 0x69, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x4e, 0x0a, 0x12,
+	0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x6f, 0x0a, 0x10,
+	0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x4b, 0x0a,
+	0x0f, 0x50, 0x75, 0x72, 0x67, 0x65, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x38, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0xee, 0x01, 0x0a, 0x0a, 0x50,
+	0x6b, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x68, 0x01, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61,
+	0x63, 0x65, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x63, 0x65,
+	0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x65, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x48, 0x00, 0x52, 0x10, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x74, 0x74, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x80, 0x01, 0x0a, 0x0e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6b, 0x69, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35,
+	0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x22, 0x81,
+	0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6b, 0x69, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6d, 0x73, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x72,
+	0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x07, 0x64, 0x72, 0x79,
+	0x5f, 0x72, 0x75, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6b, 0x69, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x3d, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x35, 0x0a, 0x12, 0x55, 0x6e, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x6b, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x68, 0x01, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22,
+	0x6f, 0x0a, 0x10, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6b, 0x69, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72,
+	0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0d, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x22, 0x32, 0x0a, 0x0f, 0x50, 0x75, 0x72, 0x67, 0x65, 0x50, 0x6b, 0x69, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x68, 0x01, 0x52, 0x06, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x22, 0xd2, 0x02, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x51, 0x0a, 0x0b, 0x61, 0x64, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x41, 0x64,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b,
+	0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x64, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x26, 0x0a, 0x05, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x4a, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x72, 0x0a,
+	0x08, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x0b, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x22, 0xa3, 0x02, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x39, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x38, 0x0a, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x48, 0x00, 0x52, 0x10, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x74, 0x6c,
+	0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x9d, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x37,
+	0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x22, 0xa8, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x24, 0x0a,
+	0x0d, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x37, 0x0a, 0x07, 0x64, 0x72, 0x79,
+	0x5f, 0x72, 0x75, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x22, 0x4b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x65, 0x74, 0x61, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22,
+	0x84, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x3d, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb1, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x28, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x72, 0x0a, 0x0d, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x07, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x55,
+	0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x73, 0x0a, 0x13, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x04,
+	0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20,
+	0x00, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4a, 0x0a, 0x11, 0x52, 0x6f,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x50, 0x0a, 0x13, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a,
+	0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
+	0x10, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x70, 0x0a, 0x11, 0x55, 0x6e, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0xe8, 0x01, 0x0a, 0x1d, 0x52,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x04,
+	0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x41, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xfa, 0x42, 0x2a, 0x72, 0x28, 0x18, 0xfd, 0x01, 0x32,
+	0x23, 0x5e, 0x28, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x5b, 0x2d, 0x61, 0x2d,
+	0x7a, 0x30, 0x2d, 0x39, 0x2e, 0x5d, 0x2a, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29,
+	0x3f, 0x29, 0x3f, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xfa,
+	0x42, 0x28, 0x72, 0x26, 0x18, 0x3f, 0x32, 0x22, 0x5e, 0x28, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d,
+	0x39, 0x5d, 0x28, 0x5b, 0x2d, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x2a, 0x5b, 0x61, 0x2d,
+	0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x3f, 0x29, 0x3f, 0x24, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x4d, 0x0a, 0x10, 0x50, 0x75, 0x72, 0x67, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x04, 0x6d, 0x65, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04,
+	0x6d, 0x65, 0x74, 0x61, 0x22, 0x51, 0x0a, 0x0d, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27,
+	0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8c, 0x02, 0x0a, 0x10, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x04,
+	0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x03,
+	0x6b, 0x76, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69,
+	0x74, 0x4b, 0x56, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x03,
+	0x6b, 0x76, 0x73, 0x12, 0x38, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x48, 0x00, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x10, 0x0a,
+	0x03, 0x74, 0x74, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12,
+	0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x86, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75,
+	0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x22,
+	0x87, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x12, 0x37, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e,
+	0x52, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x22, 0x55, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3e, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61,
+	0x22, 0x89, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x3d, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x90, 0x01, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18,
+	0xe8, 0x07, 0x28, 0x00, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x77, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x28,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5a, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7d, 0x0a, 0x18, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3e, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61,
+	0x12, 0x21, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x16, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x22, 0x5a, 0x0a, 0x18, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3e, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61,
+	0x22, 0x75, 0x0a, 0x16, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65,
+	0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x57, 0x0a, 0x15, 0x50, 0x75, 0x72, 0x67, 0x65,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3e, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61,
+	0x22, 0x52, 0x0a, 0x0e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x65,
+	0x74, 0x61, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0a, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x9b, 0x02, 0x0a, 0x11, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x04, 0x6d, 0x65,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x42, 0x0a, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x38, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02,
+	0x28, 0x00, 0x48, 0x00, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x13, 0x0a,
+	0x11, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0xa3, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x37, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x52,
+	0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x22, 0x88, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6d, 0x73, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x07, 0x64, 0x72,
+	0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x07, 0x64, 0x72, 0x79, 0x5f,
+	0x72, 0x75, 0x6e, 0x22, 0x57, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x04, 0x6d,
+	0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x8a, 0x01, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x3d, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x91, 0x01, 0x0a, 0x15, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07,
+	0x28, 0x00, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x78, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x28, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5b, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7f, 0x0a, 0x19, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3f, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x65,
+	0x74, 0x61, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x65,
+	0x74, 0x61, 0x12, 0x21, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x17, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x5c, 0x0a, 0x19, 0x55, 0x6e, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x76, 0x0a, 0x17, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x59, 0x0a,
+	0x16, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
+	0x10, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0xf4, 0x01, 0x0a, 0x23, 0x52, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x44, 0x6f, 0x63,
+	0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3f, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x65, 0x74,
+	0x61, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74,
+	0x61, 0x12, 0x41, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2d, 0xfa, 0x42, 0x2a, 0x72, 0x28, 0x18, 0xfd, 0x01, 0x32, 0x23, 0x5e, 0x28, 0x5b, 0x61, 0x2d,
+	0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x5b, 0x2d, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2e, 0x5d,
+	0x2a, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x3f, 0x29, 0x3f, 0x24, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xfa, 0x42, 0x28, 0x72, 0x26, 0x18, 0x3f,
+	0x32, 0x22, 0x5e, 0x28, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x5b, 0x2d, 0x61,
+	0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x2a, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29,
+	0x3f, 0x29, 0x3f, 0x24, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22,
+	0x2f, 0x0a, 0x11, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x22, 0x58, 0x0a, 0x0e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x74, 0x72, 0x69,
+	0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x0b, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x23, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x98,
+	0x02, 0x0a, 0x0e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3c, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12,
+	0x45, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x48, 0x00, 0x52, 0x10, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74,
+	0x74, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x22, 0x85, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12,
+	0x37, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x52,
+	0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x22, 0x51, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a,
+	0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x87, 0x01, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x3d, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xbe, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x75, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x58, 0x0a,
+	0x18, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x08, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x79, 0x0a, 0x16, 0x52, 0x6f, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3c, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12,
+	0x21, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x14, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x22, 0x56, 0x0a, 0x16, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x04, 0x6d,
+	0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x73, 0x0a, 0x14, 0x55, 0x6e, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0d, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x53,
+	0x0a, 0x13, 0x50, 0x75, 0x72, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4d,
+	0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d,
+	0x65, 0x74, 0x61, 0x22, 0xb3, 0x03, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x44, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x44,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x48, 0x00, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x10,
+	0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x74, 0x6c,
+	0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37, 0x0a, 0x09, 0x44,
+	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x11, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75,
+	0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x22,
+	0xab, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x41, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04,
+	0x6d, 0x65, 0x74, 0x61, 0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x86, 0x01,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x3d, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb1, 0x01, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x44, 0x0a, 0x04, 0x6d,
+	0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x6d, 0x65, 0x74,
+	0x61, 0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x84, 0x01, 0x0a, 0x11, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
+	0x73, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f,
+	0x72, 0x75, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75,
+	0x6e, 0x22, 0xb5, 0x01, 0x0a, 0x15, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61,
+	0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x72, 0x0a, 0x13, 0x55, 0x6e, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0xaf, 0x01,
+	0x0a, 0x12, 0x50, 0x75, 0x72, 0x67, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x43, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xf4, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x4a, 0x0a, 0x04, 0x6d, 0x65,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x09, 0x63, 0x6f, 0x5f, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x92, 0x01,
+	0x11, 0x18, 0x01, 0x22, 0x0d, 0x72, 0x0b, 0x10, 0x01, 0x32, 0x07, 0x5e, 0x5b, 0x5e, 0x2c, 0x5d,
+	0x2b, 0x24, 0x52, 0x09, 0x63, 0x6f, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x1a, 0x37, 0x0a,
+	0x09, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8f, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x3d, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x7d, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x0a, 0xfa, 0x42,
+	0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x64, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x22, 0x7d, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x0a, 0xfa, 0x42, 0x07,
+	0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x64, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x22, 0xc4, 0x01, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x35, 0x0a, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x72,
+	0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x53, 0x0a,
+	0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x3e, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x22, 0x62, 0x0a, 0x0a, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
+	0x12, 0x19, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x61, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x61, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x6b, 0x75,
+	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x6b,
+	0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x22, 0x5c, 0x0a, 0x0f, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x37,
+	0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x22, 0x23, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x80, 0x01, 0x0a,
+	0x16, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x41, 0x75, 0x74, 0x68, 0x52,
+	0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x2c, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0c, 0xfa, 0x42, 0x09,
+	0x92, 0x01, 0x06, 0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x0c, 0xfa, 0x42, 0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x10, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22,
+	0xba, 0x01, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x25, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x64, 0x65,
+	0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x41, 0x75, 0x74, 0x68, 0x52, 0x6f,
+	0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e,
+	0x65, 0x74, 0x65, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x60, 0x0a, 0x13,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x37, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x22, 0x27,
+	0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0xac, 0x01, 0x0a, 0x18, 0x41, 0x75, 0x74, 0x68,
+	0x72, 0x6f, 0x6c, 0x65, 0x47, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x25, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x64,
+	0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0xae, 0x01, 0x0a, 0x19, 0x41, 0x75, 0x74, 0x68, 0x72,
+	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x25, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x64,
+	0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0xb4, 0x01, 0x0a, 0x1c, 0x41, 0x75, 0x74, 0x68,
+	0x72, 0x6f, 0x6c, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0xb8,
+	0x01, 0x0a, 0x1e, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x47, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2b, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25,
+	0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x64, 0x65, 0x73, 0x74,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x47, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x1f, 0x41, 0x75,
+	0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a,
+	0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x09, 0x64, 0x65,
+	0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x12, 0x43, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x65, 0x0a, 0x18, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41,
+	0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6d, 0x73, 0x67, 0x12, 0x37, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x50, 0x6c, 0x61, 0x6e, 0x52, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x22, 0x66, 0x0a,
+	0x19, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x37, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x07, 0x64, 0x72,
+	0x79, 0x5f, 0x72, 0x75, 0x6e, 0x22, 0xbb, 0x01, 0x0a, 0x05, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f, 0x6c,
+	0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f,
+	0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69,
+	0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69,
+	0x72, 0x65, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2a, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x70, 0x61,
+	0x69, 0x72, 0x22, 0x62, 0x0a, 0x0a, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x30, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x66, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x06, 0x64, 0x72, 0x69, 0x66,
+	0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x22, 0x78, 0x0a, 0x10, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x22, 0x5d, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x45, 0x0a, 0x0b, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x36, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x6c, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73,
+	0x74, 0x61, 0x6c, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x22, 0x64, 0x0a, 0x16, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f, 0x6f, 0x6e, 0x6c,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f, 0x6f,
+	0x6e, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x12, 0xfa, 0x42, 0x0f, 0x72, 0x0d, 0x52, 0x00, 0x52, 0x03, 0x63, 0x73,
+	0x76, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22,
+	0xdc, 0x02, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x38,
+	0x0a, 0x17, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x17, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x22, 0x79,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x3f, 0x0a, 0x09, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x2a, 0xd7, 0x01, 0x0a, 0x0b, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x0b, 0x41, 0x55, 0x54,
+	0x48, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x04, 0xa8, 0x45, 0x91, 0x03,
+	0x12, 0x1a, 0x0a, 0x10, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41,
+	0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x1c, 0x0a, 0x12,
+	0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x02, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x19, 0x0a, 0x0f, 0x49, 0x4e,
+	0x50, 0x55, 0x54, 0x5f, 0x41, 0x52, 0x47, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x1a,
+	0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41,
+	0x4c, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0x04, 0x12, 0x1a, 0x0a, 0x10, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e,
+	0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x05, 0x1a, 0x04, 0xa8, 0x45, 0x99, 0x03, 0x12, 0x1e, 0x0a,
+	0x14, 0x49, 0x44, 0x45, 0x4d, 0x50, 0x4f, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x43, 0x4f, 0x4e,
+	0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x06, 0x1a, 0x04, 0xa8, 0x45, 0x99, 0x03, 0x1a, 0x04, 0xa0,
+	0x45, 0xf4, 0x03, 0x32, 0xbd, 0x4b, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x61,
+	0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x69, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x12, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x3a, 0x01, 0x2a, 0x22, 0x07, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x69,
+	0x74, 0x12, 0x9f, 0x01, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x69, 0x74, 0x12,
+	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x50, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4a, 0x2a, 0x48, 0x2f, 0x76, 0x31, 0x2f, 0x67,
+	0x69, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x64,
+	0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x7d, 0x12, 0x9c, 0x01, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x12, 0x20,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x50, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4a, 0x12, 0x48, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x69,
+	0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x64, 0x7d,
+	0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x7d, 0x12, 0x5e, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x69, 0x74, 0x12, 0x21, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x0f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x09, 0x12, 0x07, 0x2f, 0x76, 0x31, 0x2f, 0x67,
+	0x69, 0x74, 0x12, 0xb7, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x69, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x47, 0x69, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x59, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x53, 0x12, 0x51, 0x2f, 0x76, 0x31, 0x2f, 0x67,
+	0x69, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x64,
+	0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xb7, 0x01, 0x0a,
+	0x0b, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x47, 0x69, 0x74, 0x12, 0x25, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x5c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x56,
+	0x3a, 0x01, 0x2a, 0x22, 0x51, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x69, 0x74, 0x2f, 0x7b, 0x6d, 0x65,
+	0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74,
+	0x61, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74,
+	0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x72, 0x6f,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0xb7, 0x01, 0x0a, 0x0b, 0x55, 0x6e, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x47, 0x69, 0x74, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x5c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x56, 0x3a, 0x01, 0x2a, 0x22, 0x51, 0x2f,
+	0x76, 0x31, 0x2f, 0x67, 0x69, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74,
+	0x61, 0x2e, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x75, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x12, 0xa9, 0x01, 0x0a, 0x08, 0x50, 0x75, 0x72, 0x67, 0x65, 0x47, 0x69, 0x74, 0x12, 0x22, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x69, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x56, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x50, 0x2a, 0x4e, 0x2f, 0x76,
+	0x31, 0x2f, 0x67, 0x69, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61,
+	0x2e, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x70, 0x75, 0x72, 0x67, 0x65, 0x12, 0x61, 0x0a, 0x09,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6b, 0x69, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6b,
+	0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x6b, 0x69, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x12, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x0c, 0x3a, 0x01, 0x2a, 0x22, 0x07, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6b, 0x69, 0x12,
+	0x67, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6b, 0x69, 0x12, 0x1d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x6b, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6b, 0x69, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x18,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x2a, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6b, 0x69, 0x2f,
+	0x7b, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x7d, 0x12, 0x61, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x50,
+	0x6b, 0x69, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6b, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6b, 0x69, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x6b, 0x69, 0x2f, 0x7b, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x7d, 0x12, 0x7f, 0x0a, 0x0b, 0x55,
+	0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6b, 0x69, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6b, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6b,
+	0x69, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01,
+	0x2a, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6b, 0x69, 0x2f, 0x7b, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x7d, 0x2f, 0x75, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x71, 0x0a, 0x08,
+	0x50, 0x75, 0x72, 0x67, 0x65, 0x50, 0x6b, 0x69, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72,
+	0x67, 0x65, 0x50, 0x6b, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6b, 0x69, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x2a, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6b, 0x69,
+	0x2f, 0x7b, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x7d, 0x2f, 0x70, 0x75, 0x72, 0x67, 0x65, 0x12,
+	0x6c, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d,
+	0x3a, 0x01, 0x2a, 0x22, 0x08, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x9a, 0x01,
+	0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x2a, 0x32, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f,
+	0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74,
+	0x61, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x7d, 0x12, 0xb1, 0x01, 0x0a, 0x18, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x51, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x4b, 0x2a, 0x49, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x2f, 0x7b, 0x6d,
+	0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f,
+	0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x7d, 0x12, 0x97,
+	0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3a, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x2f, 0x7b,
+	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x7d, 0x12, 0xae, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x51, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4b, 0x12, 0x49,
+	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61,
+	0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x7d, 0x12, 0x69, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x10, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08, 0x2f, 0x76, 0x31, 0x2f,
+	0x72, 0x65, 0x70, 0x6f, 0x12, 0xb2, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x43, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x12, 0x3b, 0x2f, 0x76,
+	0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x7d,
+	0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xb2, 0x01, 0x0a, 0x1a, 0x52, 0x6f,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70,
+	0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x46, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x40, 0x3a, 0x01,
+	0x2a, 0x22, 0x3b, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x2f, 0x7b, 0x6d, 0x65, 0x74,
+	0x61, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0xb2,
+	0x01, 0x0a, 0x1a, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x26, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x46, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x40, 0x3a, 0x01, 0x2a, 0x22, 0x3b, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f,
+	0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74,
+	0x61, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x7d, 0x2f, 0x75, 0x6e, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x12, 0xa4, 0x01, 0x0a, 0x17, 0x50, 0x75, 0x72, 0x67, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12,
+	0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a,
+	0x2a, 0x38, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61,
+	0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x7d, 0x2f, 0x70, 0x75, 0x72, 0x67, 0x65, 0x12, 0xc9, 0x01, 0x0a, 0x1e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x5a, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x54, 0x12, 0x52, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x2f, 0x7b, 0x6d, 0x65,
+	0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b,
+	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x7d, 0x2f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xc9, 0x01, 0x0a, 0x1a, 0x52, 0x6f, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x5d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x57, 0x3a, 0x01, 0x2a, 0x22, 0x52,
+	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61,
+	0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x12, 0xc9, 0x01, 0x0a, 0x1a, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x5d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x57, 0x3a, 0x01, 0x2a, 0x22, 0x52, 0x2f, 0x76, 0x31, 0x2f,
+	0x72, 0x65, 0x70, 0x6f, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2f,
+	0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x7d, 0x2f, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x7d, 0x2f, 0x75, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0xbb,
+	0x01, 0x0a, 0x17, 0x50, 0x75, 0x72, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x23, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x75, 0x72, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x57, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x51, 0x2a, 0x4f, 0x2f, 0x76, 0x31,
+	0x2f, 0x72, 0x65, 0x70, 0x6f, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x7d, 0x2f,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x7d, 0x2f, 0x70, 0x75, 0x72, 0x67, 0x65, 0x12, 0xcb, 0x01, 0x0a,
+	0x24, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x70, 0x6f, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4b, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x45, 0x12, 0x43, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x2f,
+	0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61,
+	0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x7d, 0x2f, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0xe2, 0x01, 0x0a, 0x24, 0x52,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x70, 0x6f, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x62, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x5c, 0x12, 0x5a, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x2f, 0x7b, 0x6d,
+	0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f,
+	0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x7d, 0x2f, 0x64,
+	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x6a, 0x73, 0x6f, 0x6e, 0x12,
+	0x79, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69,
+	0x74, 0x12, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2f, 0x67, 0x69, 0x74, 0x12, 0x81, 0x01, 0x0a, 0x0f, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x12, 0x23,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1a, 0x2a, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x2f, 0x67, 0x69, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x64, 0x7d, 0x12, 0x90,
+	0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x12,
+	0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x32, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x2f, 0x67, 0x69, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x64, 0x7d, 0x2f,
+	0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x7d, 0x12, 0x77, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47,
+	0x69, 0x74, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2f, 0x67, 0x69, 0x74, 0x12, 0xab, 0x01, 0x0a, 0x15, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x35, 0x12, 0x33, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2f,
+	0x67, 0x69, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x6d,
+	0x65, 0x74, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xab, 0x01, 0x0a, 0x11, 0x52, 0x6f, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x12, 0x2b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x3a, 0x01,
+	0x2a, 0x22, 0x33, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2f, 0x67, 0x69,
+	0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74,
+	0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x72, 0x6f,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0xab, 0x01, 0x0a, 0x11, 0x55, 0x6e, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x12, 0x2b, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47,
+	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x3a, 0x01, 0x2a, 0x22,
+	0x33, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2f, 0x67, 0x69, 0x74, 0x2f,
+	0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e,
+	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x75, 0x6e, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x9d, 0x01, 0x0a, 0x0e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67,
+	0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x32, 0x2a, 0x30, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2f, 0x67,
+	0x69, 0x74, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x6d, 0x65,
+	0x74, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x70,
+	0x75, 0x72, 0x67, 0x65, 0x12, 0x7f, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15,
+	0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2f,
+	0x72, 0x65, 0x70, 0x6f, 0x73, 0x12, 0x86, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1c, 0x2a, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2f, 0x72,
+	0x65, 0x70, 0x6f, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x64, 0x7d, 0x12, 0x95,
+	0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61,
+	0x2e, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x12, 0x7c, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2f, 0x72,
+	0x65, 0x70, 0x6f, 0x73, 0x12, 0xb0, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37,
+	0x12, 0x35, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2f, 0x72, 0x65, 0x70,
+	0x6f, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x6d, 0x65,
+	0x74, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xb0, 0x01, 0x0a, 0x12, 0x52, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x2c,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a,
+	0x3a, 0x01, 0x2a, 0x22, 0x35, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2f,
+	0x72, 0x65, 0x70, 0x6f, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x64, 0x7d, 0x2f,
+	0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0xb0, 0x01, 0x0a, 0x12, 0x55,
+	0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x40, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x3a, 0x3a, 0x01, 0x2a, 0x22, 0x35, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69,
+	0x64, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x75, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0xa2, 0x01,
+	0x0a, 0x0f, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x12, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x2a, 0x32,
+	0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x73,
+	0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61,
+	0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x70, 0x75, 0x72,
+	0x67, 0x65, 0x12, 0xc3, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x36, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x12, 0x3d, 0x2f, 0x76, 0x31, 0x2f, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x74,
+	0x61, 0x2e, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x71, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x3a, 0x01, 0x2a, 0x22, 0x0b,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0xa6, 0x01, 0x0a, 0x0d,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x21, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x45, 0x2a,
+	0x43, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x7b, 0x6d, 0x65,
+	0x74, 0x61, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69,
+	0x64, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x7d, 0x12, 0xa3, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4b, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x45, 0x12, 0x43, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b,
+	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x12, 0x6e, 0x0a, 0x0b, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0xbe, 0x01, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x54, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4e, 0x12, 0x4c, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x64, 0x7d,
+	0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xbe, 0x01, 0x0a, 0x0f,
+	0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12,
+	0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x57, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x51, 0x3a, 0x01, 0x2a, 0x22, 0x4c,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x7b, 0x6d, 0x65, 0x74,
+	0x61, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x64,
+	0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0xbe, 0x01, 0x0a,
+	0x0f, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x57, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x51, 0x3a, 0x01, 0x2a, 0x22,
+	0x4c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x7b, 0x6d, 0x65,
+	0x74, 0x61, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69,
+	0x64, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x75, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0xb0, 0x01,
+	0x0a, 0x0c, 0x50, 0x75, 0x72, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x26,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x51, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x4b, 0x2a, 0x49, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b,
+	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x70, 0x75, 0x72, 0x67, 0x65,
+	0x12, 0x7b, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x12, 0x6f, 0x0a,
+	0x09, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x23, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x12, 0x78,
+	0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x26,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1a, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x14, 0x2a, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x73, 0x2f, 0x7b, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x12, 0x8a, 0x01, 0x0a, 0x0e, 0x55, 0x6e, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x28, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x26, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x75, 0x6e, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x7c, 0x0a, 0x0b, 0x50, 0x75, 0x72, 0x67, 0x65, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x2a, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x70, 0x75,
+	0x72, 0x67, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x8d, 0x01, 0x0a,
+	0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x73, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a,
+	0x01, 0x2a, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x94, 0x01, 0x0a,
+	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x73, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x1a, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x73, 0x32, 0xf9, 0x03, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x65, 0x0a, 0x0a,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x13,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x3a, 0x01, 0x2a, 0x22, 0x08, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x75, 0x74, 0x68, 0x12, 0x71, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x74,
+	0x68, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x2a, 0x17, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x85, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a,
+	0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x8e,
+	0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c,
+	0x65, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x30, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x2a, 0x28, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f,
+	0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72,
+	0x6f, 0x6c, 0x65, 0x2f, 0x7b, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x7d, 0x32,
+	0x9c, 0x0f, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0xb4, 0x01,
+	0x0a, 0x16, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x47,
+	0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x72, 0x6f, 0x6c, 0x65, 0x47, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41,
+	0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x3a, 0x01, 0x2a, 0x22, 0x35, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x7b, 0x64, 0x65, 0x73,
+	0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x7d, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
+	0x2f, 0x67, 0x69, 0x74, 0x12, 0xb3, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41,
+	0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x47, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x47, 0x69, 0x74,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x37, 0x2a, 0x35, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x7b, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x6f, 0x6c,
+	0x65, 0x2f, 0x7b, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x7d, 0x2f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x2f, 0x67, 0x69, 0x74, 0x12, 0xb7, 0x01, 0x0a, 0x17, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x72,
+	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x75,
+	0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x3a, 0x01, 0x2a, 0x22, 0x36, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x7b, 0x64, 0x65, 0x73, 0x74,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x7d, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x2f,
+	0x72, 0x65, 0x70, 0x6f, 0x12, 0xb6, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41,
+	0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f,
+	0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x38, 0x2a, 0x36, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f,
+	0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72,
+	0x6f, 0x6c, 0x65, 0x2f, 0x7b, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x7d, 0x2f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x12, 0xc0, 0x01,
+	0x0a, 0x1a, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x44, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x3e, 0x3a, 0x01, 0x2a, 0x22, 0x39, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f,
+	0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72,
+	0x6f, 0x6c, 0x65, 0x2f, 0x7b, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x7d, 0x2f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0xbf, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72,
+	0x6f, 0x6c, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x72, 0x6f, 0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x2a, 0x39, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74,
+	0x68, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x7b, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x7d, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x12, 0xc7, 0x01, 0x0a, 0x1c, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68,
+	0x72, 0x6f, 0x6c, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x31, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x47, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x3a, 0x01, 0x2a, 0x22, 0x3c,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x7b, 0x64, 0x65,
+	0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x7d, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2f, 0x67, 0x69, 0x74, 0x12, 0xc6, 0x01, 0x0a,
+	0x1d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x47, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x31,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x47, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x72, 0x6f, 0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x2a, 0x3c, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74,
+	0x68, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x7b, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x7d, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x2f, 0x67, 0x69, 0x74, 0x12, 0xca, 0x01, 0x0a, 0x1d, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41,
+	0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x72, 0x6f, 0x6c, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x48, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x42,
+	0x3a, 0x01, 0x2a, 0x22, 0x3d, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x7b, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x6f, 0x6c,
+	0x65, 0x2f, 0x7b, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x7d, 0x2f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2f, 0x72, 0x65,
+	0x70, 0x6f, 0x12, 0xc9, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74,
+	0x68, 0x72, 0x6f, 0x6c, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f,
+	0x6c, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x41, 0x75, 0x74, 0x68, 0x72, 0x6f, 0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x2a,
+	0x3d, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2f, 0x7b, 0x64,
+	0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x7d, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x32, 0xd4,
+	0x01, 0x0a, 0x09, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12, 0x60, 0x0a, 0x08,
+	0x47, 0x65, 0x74, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x72, 0x69, 0x66, 0x74, 0x12, 0x65,
+	0x0a, 0x09, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12, 0x23, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f,
+	0x64, 0x72, 0x69, 0x66, 0x74, 0x32, 0x8c, 0x01, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x7e, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x17, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x32, 0x82, 0x01, 0x0a, 0x08, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x76, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76, 0x31, 0x2f,
+	0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x32, 0x0a, 0x11, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x76, 0x31, 0x50, 0x01,
+	0x5a, 0x1b, 0x76, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_vaultproxy_v1_vaultproxy_proto_rawDescOnce sync.Once
+	file_api_vaultproxy_v1_vaultproxy_proto_rawDescData = file_api_vaultproxy_v1_vaultproxy_proto_rawDesc
+)
+
+func file_api_vaultproxy_v1_vaultproxy_proto_rawDescGZIP() []byte {
+	file_api_vaultproxy_v1_vaultproxy_proto_rawDescOnce.Do(func() {
+		file_api_vaultproxy_v1_vaultproxy_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_vaultproxy_v1_vaultproxy_proto_rawDescData)
+	})
+	return file_api_vaultproxy_v1_vaultproxy_proto_rawDescData
+}
+
+var file_api_vaultproxy_v1_vaultproxy_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_vaultproxy_v1_vaultproxy_proto_msgTypes = make([]protoimpl.MessageInfo, 141)
+var file_api_vaultproxy_v1_vaultproxy_proto_goTypes = []interface{}{
+	(ErrorReason)(0),                            // 0: api.vaultproxy.v1.ErrorReason
+	(*DryRunPlan)(nil),                          // 1: api.vaultproxy.v1.DryRunPlan
+	(*RoleDiff)(nil),                            // 2: api.vaultproxy.v1.RoleDiff
+	(*SecretInfo)(nil),                          // 3: api.vaultproxy.v1.SecretInfo
+	(*SecretMetadata)(nil),                      // 4: api.vaultproxy.v1.SecretMetadata
+	(*SecretVersion)(nil),                       // 5: api.vaultproxy.v1.SecretVersion
+	(*GitKVs)(nil),                              // 6: api.vaultproxy.v1.GitKVs
+	(*GitMeta)(nil),                             // 7: api.vaultproxy.v1.GitMeta
+	(*GitRequest)(nil),                          // 8: api.vaultproxy.v1.GitRequest
+	(*CreateGitReply)(nil),                      // 9: api.vaultproxy.v1.CreateGitReply
+	(*DeleteGitReply)(nil),                      // 10: api.vaultproxy.v1.DeleteGitReply
+	(*GetGitRequest)(nil),                       // 11: api.vaultproxy.v1.GetGitRequest
+	(*GetGitReply)(nil),                         // 12: api.vaultproxy.v1.GetGitReply
+	(*ListGitRequest)(nil),                      // 13: api.vaultproxy.v1.ListGitRequest
+	(*ListGitReply)(nil),                        // 14: api.vaultproxy.v1.ListGitReply
+	(*ListGitVersionsReply)(nil),                // 15: api.vaultproxy.v1.ListGitVersionsReply
+	(*RollbackGitRequest)(nil),                  // 16: api.vaultproxy.v1.RollbackGitRequest
+	(*RollbackGitReply)(nil),                    // 17: api.vaultproxy.v1.RollbackGitReply
+	(*UndeleteGitRequest)(nil),                  // 18: api.vaultproxy.v1.UndeleteGitRequest
+	(*UndeleteGitReply)(nil),                    // 19: api.vaultproxy.v1.UndeleteGitReply
+	(*PurgeGitRequest)(nil),                     // 20: api.vaultproxy.v1.PurgeGitRequest
+	(*PkiRequest)(nil),                          // 21: api.vaultproxy.v1.PkiRequest
+	(*CreatePkiReply)(nil),                      // 22: api.vaultproxy.v1.CreatePkiReply
+	(*DeletePkiReply)(nil),                      // 23: api.vaultproxy.v1.DeletePkiReply
+	(*GetPkiReply)(nil),                         // 24: api.vaultproxy.v1.GetPkiReply
+	(*UndeletePkiRequest)(nil),                  // 25: api.vaultproxy.v1.UndeletePkiRequest
+	(*UndeletePkiReply)(nil),                    // 26: api.vaultproxy.v1.UndeletePkiReply
+	(*PurgePkiRequest)(nil),                     // 27: api.vaultproxy.v1.PurgePkiRequest
+	(*RepoAccount)(nil),                         // 28: api.vaultproxy.v1.RepoAccount
+	(*Token)(nil),                               // 29: api.vaultproxy.v1.Token
+	(*Account)(nil),                             // 30: api.vaultproxy.v1.Account
+	(*RepoMeta)(nil),                            // 31: api.vaultproxy.v1.RepoMeta
+	(*RepoRequest)(nil),                         // 32: api.vaultproxy.v1.RepoRequest
+	(*CreateRepoReply)(nil),                     // 33: api.vaultproxy.v1.CreateRepoReply
+	(*DeleteRepoReply)(nil),                     // 34: api.vaultproxy.v1.DeleteRepoReply
+	(*GetRepoRequest)(nil),                      // 35: api.vaultproxy.v1.GetRepoRequest
+	(*GetRepoReply)(nil),                        // 36: api.vaultproxy.v1.GetRepoReply
+	(*ListRepoRequest)(nil),                     // 37: api.vaultproxy.v1.ListRepoRequest
+	(*ListRepoReply)(nil),                       // 38: api.vaultproxy.v1.ListRepoReply
+	(*ListRepoVersionsReply)(nil),               // 39: api.vaultproxy.v1.ListRepoVersionsReply
+	(*RollbackRepoRequest)(nil),                 // 40: api.vaultproxy.v1.RollbackRepoRequest
+	(*RollbackRepoReply)(nil),                   // 41: api.vaultproxy.v1.RollbackRepoReply
+	(*UndeleteRepoRequest)(nil),                 // 42: api.vaultproxy.v1.UndeleteRepoRequest
+	(*UndeleteRepoReply)(nil),                   // 43: api.vaultproxy.v1.UndeleteRepoReply
+	(*RenderRepoDockerConfigRequest)(nil),       // 44: api.vaultproxy.v1.RenderRepoDockerConfigRequest
+	(*PurgeRepoRequest)(nil),                    // 45: api.vaultproxy.v1.PurgeRepoRequest
+	(*TenantGitMeta)(nil),                       // 46: api.vaultproxy.v1.TenantGitMeta
+	(*TenantGitRequest)(nil),                    // 47: api.vaultproxy.v1.TenantGitRequest
+	(*CreateTenantGitReply)(nil),                // 48: api.vaultproxy.v1.CreateTenantGitReply
+	(*DeleteTenantGitReply)(nil),                // 49: api.vaultproxy.v1.DeleteTenantGitReply
+	(*GetTenantGitRequest)(nil),                 // 50: api.vaultproxy.v1.GetTenantGitRequest
+	(*GetTenantGitReply)(nil),                   // 51: api.vaultproxy.v1.GetTenantGitReply
+	(*ListTenantGitRequest)(nil),                // 52: api.vaultproxy.v1.ListTenantGitRequest
+	(*ListTenantGitReply)(nil),                  // 53: api.vaultproxy.v1.ListTenantGitReply
+	(*ListTenantGitVersionsReply)(nil),          // 54: api.vaultproxy.v1.ListTenantGitVersionsReply
+	(*RollbackTenantGitRequest)(nil),            // 55: api.vaultproxy.v1.RollbackTenantGitRequest
+	(*RollbackTenantGitReply)(nil),              // 56: api.vaultproxy.v1.RollbackTenantGitReply
+	(*UndeleteTenantGitRequest)(nil),            // 57: api.vaultproxy.v1.UndeleteTenantGitRequest
+	(*UndeleteTenantGitReply)(nil),              // 58: api.vaultproxy.v1.UndeleteTenantGitReply
+	(*PurgeTenantGitRequest)(nil),               // 59: api.vaultproxy.v1.PurgeTenantGitRequest
+	(*TenantRepoMeta)(nil),                      // 60: api.vaultproxy.v1.TenantRepoMeta
+	(*TenantRepoRequest)(nil),                   // 61: api.vaultproxy.v1.TenantRepoRequest
+	(*CreateTenantRepoReply)(nil),               // 62: api.vaultproxy.v1.CreateTenantRepoReply
+	(*DeleteTenantRepoReply)(nil),               // 63: api.vaultproxy.v1.DeleteTenantRepoReply
+	(*GetTenantRepoRequest)(nil),                // 64: api.vaultproxy.v1.GetTenantRepoRequest
+	(*GetTenantRepoReply)(nil),                  // 65: api.vaultproxy.v1.GetTenantRepoReply
+	(*ListTenantRepoRequest)(nil),               // 66: api.vaultproxy.v1.ListTenantRepoRequest
+	(*ListTenantRepoReply)(nil),                 // 67: api.vaultproxy.v1.ListTenantRepoReply
+	(*ListTenantRepoVersionsReply)(nil),         // 68: api.vaultproxy.v1.ListTenantRepoVersionsReply
+	(*RollbackTenantRepoRequest)(nil),           // 69: api.vaultproxy.v1.RollbackTenantRepoRequest
+	(*RollbackTenantRepoReply)(nil),             // 70: api.vaultproxy.v1.RollbackTenantRepoReply
+	(*UndeleteTenantRepoRequest)(nil),           // 71: api.vaultproxy.v1.UndeleteTenantRepoRequest
+	(*UndeleteTenantRepoReply)(nil),             // 72: api.vaultproxy.v1.UndeleteTenantRepoReply
+	(*PurgeTenantRepoRequest)(nil),              // 73: api.vaultproxy.v1.PurgeTenantRepoRequest
+	(*RenderTenantRepoDockerConfigRequest)(nil), // 74: api.vaultproxy.v1.RenderTenantRepoDockerConfigRequest
+	(*DockerConfigReply)(nil),                   // 75: api.vaultproxy.v1.DockerConfigReply
+	(*ClusterAccount)(nil),                      // 76: api.vaultproxy.v1.ClusterAccount
+	(*ClusterMeta)(nil),                         // 77: api.vaultproxy.v1.ClusterMeta
+	(*ClusterRequest)(nil),                      // 78: api.vaultproxy.v1.ClusterRequest
+	(*CreateClusterReply)(nil),                  // 79: api.vaultproxy.v1.CreateClusterReply
+	(*DeleteClusterReply)(nil),                  // 80: api.vaultproxy.v1.DeleteClusterReply
+	(*GetClusterRequest)(nil),                   // 81: api.vaultproxy.v1.GetClusterRequest
+	(*GetClusterReply)(nil),                     // 82: api.vaultproxy.v1.GetClusterReply
+	(*ListClusterRequest)(nil),                  // 83: api.vaultproxy.v1.ListClusterRequest
+	(*ListClusterReply)(nil),                    // 84: api.vaultproxy.v1.ListClusterReply
+	(*ListClusterVersionsReply)(nil),            // 85: api.vaultproxy.v1.ListClusterVersionsReply
+	(*RollbackClusterRequest)(nil),              // 86: api.vaultproxy.v1.RollbackClusterRequest
+	(*RollbackClusterReply)(nil),                // 87: api.vaultproxy.v1.RollbackClusterReply
+	(*UndeleteClusterRequest)(nil),              // 88: api.vaultproxy.v1.UndeleteClusterRequest
+	(*UndeleteClusterReply)(nil),                // 89: api.vaultproxy.v1.UndeleteClusterReply
+	(*PurgeClusterRequest)(nil),                 // 90: api.vaultproxy.v1.PurgeClusterRequest
+	(*CreateSecretRequest)(nil),                 // 91: api.vaultproxy.v1.CreateSecretRequest
+	(*CreateSecretReply)(nil),                   // 92: api.vaultproxy.v1.CreateSecretReply
+	(*GetSecretRequest)(nil),                    // 93: api.vaultproxy.v1.GetSecretRequest
+	(*GetSecretReply)(nil),                      // 94: api.vaultproxy.v1.GetSecretReply
+	(*DeleteSecretRequest)(nil),                 // 95: api.vaultproxy.v1.DeleteSecretRequest
+	(*DeleteSecretReply)(nil),                   // 96: api.vaultproxy.v1.DeleteSecretReply
+	(*UndeleteSecretRequest)(nil),               // 97: api.vaultproxy.v1.UndeleteSecretRequest
+	(*UndeleteSecretReply)(nil),                 // 98: api.vaultproxy.v1.UndeleteSecretReply
+	(*PurgeSecretRequest)(nil),                  // 99: api.vaultproxy.v1.PurgeSecretRequest
+	(*UpdateSecretOwnersRequest)(nil),           // 100: api.vaultproxy.v1.UpdateSecretOwnersRequest
+	(*UpdateSecretOwnersReply)(nil),             // 101: api.vaultproxy.v1.UpdateSecretOwnersReply
+	(*BatchCreateSecretsRequest)(nil),           // 102: api.vaultproxy.v1.BatchCreateSecretsRequest
+	(*BatchDeleteSecretsRequest)(nil),           // 103: api.vaultproxy.v1.BatchDeleteSecretsRequest
+	(*BatchSecretResult)(nil),                   // 104: api.vaultproxy.v1.BatchSecretResult
+	(*BatchSecretsReply)(nil),                   // 105: api.vaultproxy.v1.BatchSecretsReply
+	(*Kubernetes)(nil),                          // 106: api.vaultproxy.v1.Kubernetes
+	(*AuthRequest)(nil),                         // 107: api.vaultproxy.v1.AuthRequest
+	(*CreateAuthReply)(nil),                     // 108: api.vaultproxy.v1.CreateAuthReply
+	(*DeleteAuthReply)(nil),                     // 109: api.vaultproxy.v1.DeleteAuthReply
+	(*KubernetesAuthRoleMeta)(nil),              // 110: api.vaultproxy.v1.KubernetesAuthRoleMeta
+	(*AuthroleRequest)(nil),                     // 111: api.vaultproxy.v1.AuthroleRequest
+	(*CreateAuthroleReply)(nil),                 // 112: api.vaultproxy.v1.CreateAuthroleReply
+	(*DeleteAuthroleReply)(nil),                 // 113: api.vaultproxy.v1.DeleteAuthroleReply
+	(*AuthroleGitPolicyRequest)(nil),            // 114: api.vaultproxy.v1.AuthroleGitPolicyRequest
+	(*AuthroleRepoPolicyRequest)(nil),           // 115: api.vaultproxy.v1.AuthroleRepoPolicyRequest
+	(*AuthroleClusterPolicyRequest)(nil),        // 116: api.vaultproxy.v1.AuthroleClusterPolicyRequest
+	(*AuthroleTenantGitPolicyRequest)(nil),      // 117: api.vaultproxy.v1.AuthroleTenantGitPolicyRequest
+	(*AuthroleTenantRepoPolicyRequest)(nil),     // 118: api.vaultproxy.v1.AuthroleTenantRepoPolicyRequest
+	(*GrantAuthrolePolicyReply)(nil),            // 119: api.vaultproxy.v1.GrantAuthrolePolicyReply
+	(*RevokeAuthrolePolicyReply)(nil),           // 120: api.vaultproxy.v1.RevokeAuthrolePolicyReply
+	(*Drift)(nil),                               // 121: api.vaultproxy.v1.Drift
+	(*GetDriftRequest)(nil),                     // 122: api.vaultproxy.v1.GetDriftRequest
+	(*ReconcileRequest)(nil),                    // 123: api.vaultproxy.v1.ReconcileRequest
+	(*DriftReply)(nil),                          // 124: api.vaultproxy.v1.DriftReply
+	(*ListExpirationsRequest)(nil),              // 125: api.vaultproxy.v1.ListExpirationsRequest
+	(*SecretExpiration)(nil),                    // 126: api.vaultproxy.v1.SecretExpiration
+	(*ListExpirationsReply)(nil),                // 127: api.vaultproxy.v1.ListExpirationsReply
+	(*ListRotationsRequest)(nil),                // 128: api.vaultproxy.v1.ListRotationsRequest
+	(*ExportRotationsRequest)(nil),              // 129: api.vaultproxy.v1.ExportRotationsRequest
+	(*SecretRotation)(nil),                      // 130: api.vaultproxy.v1.SecretRotation
+	(*ListRotationsReply)(nil),                  // 131: api.vaultproxy.v1.ListRotationsReply
+	nil,                                         // 132: api.vaultproxy.v1.RoleDiff.ConfigEntry
+	nil,                                         // 133: api.vaultproxy.v1.GitKVs.AdditionalsEntry
+	nil,                                         // 134: api.vaultproxy.v1.RepoAccount.AdditionalsEntry
+	nil,                                         // 135: api.vaultproxy.v1.CreateSecretRequest.MetaEntry
+	nil,                                         // 136: api.vaultproxy.v1.CreateSecretRequest.DataEntry
+	nil,                                         // 137: api.vaultproxy.v1.GetSecretRequest.MetaEntry
+	nil,                                         // 138: api.vaultproxy.v1.DeleteSecretRequest.MetaEntry
+	nil,                                         // 139: api.vaultproxy.v1.UndeleteSecretRequest.MetaEntry
+	nil,                                         // 140: api.vaultproxy.v1.PurgeSecretRequest.MetaEntry
+	nil,                                         // 141: api.vaultproxy.v1.UpdateSecretOwnersRequest.MetaEntry
+}
+var file_api_vaultproxy_v1_vaultproxy_proto_depIdxs = []int32{
+	2,   // 0: api.vaultproxy.v1.DryRunPlan.roles:type_name -> api.vaultproxy.v1.RoleDiff
+	132, // 1: api.vaultproxy.v1.RoleDiff.config:type_name -> api.vaultproxy.v1.RoleDiff.ConfigEntry
+	133, // 2: api.vaultproxy.v1.GitKVs.additionals:type_name -> api.vaultproxy.v1.GitKVs.AdditionalsEntry
+	7,   // 3: api.vaultproxy.v1.GitRequest.meta:type_name -> api.vaultproxy.v1.GitMeta
+	6,   // 4: api.vaultproxy.v1.GitRequest.kvs:type_name -> api.vaultproxy.v1.GitKVs
+	3,   // 5: api.vaultproxy.v1.CreateGitReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	1,   // 6: api.vaultproxy.v1.CreateGitReply.dry_run:type_name -> api.vaultproxy.v1.DryRunPlan
+	1,   // 7: api.vaultproxy.v1.DeleteGitReply.dry_run:type_name -> api.vaultproxy.v1.DryRunPlan
+	7,   // 8: api.vaultproxy.v1.GetGitRequest.meta:type_name -> api.vaultproxy.v1.GitMeta
+	3,   // 9: api.vaultproxy.v1.GetGitReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	4,   // 10: api.vaultproxy.v1.GetGitReply.metadata:type_name -> api.vaultproxy.v1.SecretMetadata
+	3,   // 11: api.vaultproxy.v1.ListGitReply.secrets:type_name -> api.vaultproxy.v1.SecretInfo
+	5,   // 12: api.vaultproxy.v1.ListGitVersionsReply.versions:type_name -> api.vaultproxy.v1.SecretVersion
+	7,   // 13: api.vaultproxy.v1.RollbackGitRequest.meta:type_name -> api.vaultproxy.v1.GitMeta
+	3,   // 14: api.vaultproxy.v1.RollbackGitReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	7,   // 15: api.vaultproxy.v1.UndeleteGitRequest.meta:type_name -> api.vaultproxy.v1.GitMeta
+	3,   // 16: api.vaultproxy.v1.UndeleteGitReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	7,   // 17: api.vaultproxy.v1.PurgeGitRequest.meta:type_name -> api.vaultproxy.v1.GitMeta
+	3,   // 18: api.vaultproxy.v1.CreatePkiReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	1,   // 19: api.vaultproxy.v1.CreatePkiReply.dry_run:type_name -> api.vaultproxy.v1.DryRunPlan
+	1,   // 20: api.vaultproxy.v1.DeletePkiReply.dry_run:type_name -> api.vaultproxy.v1.DryRunPlan
+	3,   // 21: api.vaultproxy.v1.GetPkiReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	4,   // 22: api.vaultproxy.v1.GetPkiReply.metadata:type_name -> api.vaultproxy.v1.SecretMetadata
+	3,   // 23: api.vaultproxy.v1.UndeletePkiReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	29,  // 24: api.vaultproxy.v1.RepoAccount.token:type_name -> api.vaultproxy.v1.Token
+	30,  // 25: api.vaultproxy.v1.RepoAccount.account:type_name -> api.vaultproxy.v1.Account
+	134, // 26: api.vaultproxy.v1.RepoAccount.additionals:type_name -> api.vaultproxy.v1.RepoAccount.AdditionalsEntry
+	31,  // 27: api.vaultproxy.v1.RepoRequest.meta:type_name -> api.vaultproxy.v1.RepoMeta
+	28,  // 28: api.vaultproxy.v1.RepoRequest.account:type_name -> api.vaultproxy.v1.RepoAccount
+	3,   // 29: api.vaultproxy.v1.CreateRepoReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	1,   // 30: api.vaultproxy.v1.CreateRepoReply.dry_run:type_name -> api.vaultproxy.v1.DryRunPlan
+	1,   // 31: api.vaultproxy.v1.DeleteRepoReply.dry_run:type_name -> api.vaultproxy.v1.DryRunPlan
+	31,  // 32: api.vaultproxy.v1.GetRepoRequest.meta:type_name -> api.vaultproxy.v1.RepoMeta
+	3,   // 33: api.vaultproxy.v1.GetRepoReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	4,   // 34: api.vaultproxy.v1.GetRepoReply.metadata:type_name -> api.vaultproxy.v1.SecretMetadata
+	3,   // 35: api.vaultproxy.v1.ListRepoReply.secrets:type_name -> api.vaultproxy.v1.SecretInfo
+	5,   // 36: api.vaultproxy.v1.ListRepoVersionsReply.versions:type_name -> api.vaultproxy.v1.SecretVersion
+	31,  // 37: api.vaultproxy.v1.RollbackRepoRequest.meta:type_name -> api.vaultproxy.v1.RepoMeta
+	3,   // 38: api.vaultproxy.v1.RollbackRepoReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	31,  // 39: api.vaultproxy.v1.UndeleteRepoRequest.meta:type_name -> api.vaultproxy.v1.RepoMeta
+	3,   // 40: api.vaultproxy.v1.UndeleteRepoReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	31,  // 41: api.vaultproxy.v1.RenderRepoDockerConfigRequest.meta:type_name -> api.vaultproxy.v1.RepoMeta
+	31,  // 42: api.vaultproxy.v1.PurgeRepoRequest.meta:type_name -> api.vaultproxy.v1.RepoMeta
+	46,  // 43: api.vaultproxy.v1.TenantGitRequest.meta:type_name -> api.vaultproxy.v1.TenantGitMeta
+	6,   // 44: api.vaultproxy.v1.TenantGitRequest.kvs:type_name -> api.vaultproxy.v1.GitKVs
+	3,   // 45: api.vaultproxy.v1.CreateTenantGitReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	1,   // 46: api.vaultproxy.v1.CreateTenantGitReply.dry_run:type_name -> api.vaultproxy.v1.DryRunPlan
+	1,   // 47: api.vaultproxy.v1.DeleteTenantGitReply.dry_run:type_name -> api.vaultproxy.v1.DryRunPlan
+	46,  // 48: api.vaultproxy.v1.GetTenantGitRequest.meta:type_name -> api.vaultproxy.v1.TenantGitMeta
+	3,   // 49: api.vaultproxy.v1.GetTenantGitReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	4,   // 50: api.vaultproxy.v1.GetTenantGitReply.metadata:type_name -> api.vaultproxy.v1.SecretMetadata
+	3,   // 51: api.vaultproxy.v1.ListTenantGitReply.secrets:type_name -> api.vaultproxy.v1.SecretInfo
+	5,   // 52: api.vaultproxy.v1.ListTenantGitVersionsReply.versions:type_name -> api.vaultproxy.v1.SecretVersion
+	46,  // 53: api.vaultproxy.v1.RollbackTenantGitRequest.meta:type_name -> api.vaultproxy.v1.TenantGitMeta
+	3,   // 54: api.vaultproxy.v1.RollbackTenantGitReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	46,  // 55: api.vaultproxy.v1.UndeleteTenantGitRequest.meta:type_name -> api.vaultproxy.v1.TenantGitMeta
+	3,   // 56: api.vaultproxy.v1.UndeleteTenantGitReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	46,  // 57: api.vaultproxy.v1.PurgeTenantGitRequest.meta:type_name -> api.vaultproxy.v1.TenantGitMeta
+	60,  // 58: api.vaultproxy.v1.TenantRepoRequest.meta:type_name -> api.vaultproxy.v1.TenantRepoMeta
+	28,  // 59: api.vaultproxy.v1.TenantRepoRequest.account:type_name -> api.vaultproxy.v1.RepoAccount
+	3,   // 60: api.vaultproxy.v1.CreateTenantRepoReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	1,   // 61: api.vaultproxy.v1.CreateTenantRepoReply.dry_run:type_name -> api.vaultproxy.v1.DryRunPlan
+	1,   // 62: api.vaultproxy.v1.DeleteTenantRepoReply.dry_run:type_name -> api.vaultproxy.v1.DryRunPlan
+	60,  // 63: api.vaultproxy.v1.GetTenantRepoRequest.meta:type_name -> api.vaultproxy.v1.TenantRepoMeta
+	3,   // 64: api.vaultproxy.v1.GetTenantRepoReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	4,   // 65: api.vaultproxy.v1.GetTenantRepoReply.metadata:type_name -> api.vaultproxy.v1.SecretMetadata
+	3,   // 66: api.vaultproxy.v1.ListTenantRepoReply.secrets:type_name -> api.vaultproxy.v1.SecretInfo
+	5,   // 67: api.vaultproxy.v1.ListTenantRepoVersionsReply.versions:type_name -> api.vaultproxy.v1.SecretVersion
+	60,  // 68: api.vaultproxy.v1.RollbackTenantRepoRequest.meta:type_name -> api.vaultproxy.v1.TenantRepoMeta
+	3,   // 69: api.vaultproxy.v1.RollbackTenantRepoReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	60,  // 70: api.vaultproxy.v1.UndeleteTenantRepoRequest.meta:type_name -> api.vaultproxy.v1.TenantRepoMeta
+	3,   // 71: api.vaultproxy.v1.UndeleteTenantRepoReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	60,  // 72: api.vaultproxy.v1.PurgeTenantRepoRequest.meta:type_name -> api.vaultproxy.v1.TenantRepoMeta
+	60,  // 73: api.vaultproxy.v1.RenderTenantRepoDockerConfigRequest.meta:type_name -> api.vaultproxy.v1.TenantRepoMeta
+	77,  // 74: api.vaultproxy.v1.ClusterRequest.meta:type_name -> api.vaultproxy.v1.ClusterMeta
+	76,  // 75: api.vaultproxy.v1.ClusterRequest.account:type_name -> api.vaultproxy.v1.ClusterAccount
+	3,   // 76: api.vaultproxy.v1.CreateClusterReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	1,   // 77: api.vaultproxy.v1.CreateClusterReply.dry_run:type_name -> api.vaultproxy.v1.DryRunPlan
+	1,   // 78: api.vaultproxy.v1.DeleteClusterReply.dry_run:type_name -> api.vaultproxy.v1.DryRunPlan
+	77,  // 79: api.vaultproxy.v1.GetClusterRequest.meta:type_name -> api.vaultproxy.v1.ClusterMeta
+	3,   // 80: api.vaultproxy.v1.GetClusterReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	4,   // 81: api.vaultproxy.v1.GetClusterReply.metadata:type_name -> api.vaultproxy.v1.SecretMetadata
+	3,   // 82: api.vaultproxy.v1.ListClusterReply.secrets:type_name -> api.vaultproxy.v1.SecretInfo
+	5,   // 83: api.vaultproxy.v1.ListClusterVersionsReply.versions:type_name -> api.vaultproxy.v1.SecretVersion
+	77,  // 84: api.vaultproxy.v1.RollbackClusterRequest.meta:type_name -> api.vaultproxy.v1.ClusterMeta
+	3,   // 85: api.vaultproxy.v1.RollbackClusterReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	77,  // 86: api.vaultproxy.v1.UndeleteClusterRequest.meta:type_name -> api.vaultproxy.v1.ClusterMeta
+	3,   // 87: api.vaultproxy.v1.UndeleteClusterReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	77,  // 88: api.vaultproxy.v1.PurgeClusterRequest.meta:type_name -> api.vaultproxy.v1.ClusterMeta
+	135, // 89: api.vaultproxy.v1.CreateSecretRequest.meta:type_name -> api.vaultproxy.v1.CreateSecretRequest.MetaEntry
+	136, // 90: api.vaultproxy.v1.CreateSecretRequest.data:type_name -> api.vaultproxy.v1.CreateSecretRequest.DataEntry
+	3,   // 91: api.vaultproxy.v1.CreateSecretReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	1,   // 92: api.vaultproxy.v1.CreateSecretReply.dry_run:type_name -> api.vaultproxy.v1.DryRunPlan
+	137, // 93: api.vaultproxy.v1.GetSecretRequest.meta:type_name -> api.vaultproxy.v1.GetSecretRequest.MetaEntry
+	3,   // 94: api.vaultproxy.v1.GetSecretReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	4,   // 95: api.vaultproxy.v1.GetSecretReply.metadata:type_name -> api.vaultproxy.v1.SecretMetadata
+	138, // 96: api.vaultproxy.v1.DeleteSecretRequest.meta:type_name -> api.vaultproxy.v1.DeleteSecretRequest.MetaEntry
+	1,   // 97: api.vaultproxy.v1.DeleteSecretReply.dry_run:type_name -> api.vaultproxy.v1.DryRunPlan
+	139, // 98: api.vaultproxy.v1.UndeleteSecretRequest.meta:type_name -> api.vaultproxy.v1.UndeleteSecretRequest.MetaEntry
+	3,   // 99: api.vaultproxy.v1.UndeleteSecretReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	140, // 100: api.vaultproxy.v1.PurgeSecretRequest.meta:type_name -> api.vaultproxy.v1.PurgeSecretRequest.MetaEntry
+	141, // 101: api.vaultproxy.v1.UpdateSecretOwnersRequest.meta:type_name -> api.vaultproxy.v1.UpdateSecretOwnersRequest.MetaEntry
+	3,   // 102: api.vaultproxy.v1.UpdateSecretOwnersReply.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	4,   // 103: api.vaultproxy.v1.UpdateSecretOwnersReply.metadata:type_name -> api.vaultproxy.v1.SecretMetadata
+	91,  // 104: api.vaultproxy.v1.BatchCreateSecretsRequest.items:type_name -> api.vaultproxy.v1.CreateSecretRequest
+	95,  // 105: api.vaultproxy.v1.BatchDeleteSecretsRequest.items:type_name -> api.vaultproxy.v1.DeleteSecretRequest
+	3,   // 106: api.vaultproxy.v1.BatchSecretResult.secret:type_name -> api.vaultproxy.v1.SecretInfo
+	104, // 107: api.vaultproxy.v1.BatchSecretsReply.results:type_name -> api.vaultproxy.v1.BatchSecretResult
+	106, // 108: api.vaultproxy.v1.AuthRequest.kubernetes:type_name -> api.vaultproxy.v1.Kubernetes
+	1,   // 109: api.vaultproxy.v1.CreateAuthReply.dry_run:type_name -> api.vaultproxy.v1.DryRunPlan
+	110, // 110: api.vaultproxy.v1.AuthroleRequest.kubernetes:type_name -> api.vaultproxy.v1.KubernetesAuthRoleMeta
+	1,   // 111: api.vaultproxy.v1.CreateAuthroleReply.dry_run:type_name -> api.vaultproxy.v1.DryRunPlan
+	7,   // 112: api.vaultproxy.v1.AuthroleGitPolicyRequest.secret:type_name -> api.vaultproxy.v1.GitMeta
+	31,  // 113: api.vaultproxy.v1.AuthroleRepoPolicyRequest.secret:type_name -> api.vaultproxy.v1.RepoMeta
+	77,  // 114: api.vaultproxy.v1.AuthroleClusterPolicyRequest.secret:type_name -> api.vaultproxy.v1.ClusterMeta
+	46,  // 115: api.vaultproxy.v1.AuthroleTenantGitPolicyRequest.secret:type_name -> api.vaultproxy.v1.TenantGitMeta
+	60,  // 116: api.vaultproxy.v1.AuthroleTenantRepoPolicyRequest.secret:type_name -> api.vaultproxy.v1.TenantRepoMeta
+	1,   // 117: api.vaultproxy.v1.GrantAuthrolePolicyReply.dry_run:type_name -> api.vaultproxy.v1.DryRunPlan
+	1,   // 118: api.vaultproxy.v1.RevokeAuthrolePolicyReply.dry_run:type_name -> api.vaultproxy.v1.DryRunPlan
+	121, // 119: api.vaultproxy.v1.DriftReply.drifts:type_name -> api.vaultproxy.v1.Drift
+	126, // 120: api.vaultproxy.v1.ListExpirationsReply.expirations:type_name -> api.vaultproxy.v1.SecretExpiration
+	130, // 121: api.vaultproxy.v1.ListRotationsReply.rotations:type_name -> api.vaultproxy.v1.SecretRotation
+	8,   // 122: api.vaultproxy.v1.Secret.CreateGit:input_type -> api.vaultproxy.v1.GitRequest
+	8,   // 123: api.vaultproxy.v1.Secret.DeleteGit:input_type -> api.vaultproxy.v1.GitRequest
+	11,  // 124: api.vaultproxy.v1.Secret.GetGit:input_type -> api.vaultproxy.v1.GetGitRequest
+	13,  // 125: api.vaultproxy.v1.Secret.ListGit:input_type -> api.vaultproxy.v1.ListGitRequest
+	11,  // 126: api.vaultproxy.v1.Secret.ListGitVersions:input_type -> api.vaultproxy.v1.GetGitRequest
+	16,  // 127: api.vaultproxy.v1.Secret.RollbackGit:input_type -> api.vaultproxy.v1.RollbackGitRequest
+	18,  // 128: api.vaultproxy.v1.Secret.UndeleteGit:input_type -> api.vaultproxy.v1.UndeleteGitRequest
+	20,  // 129: api.vaultproxy.v1.Secret.PurgeGit:input_type -> api.vaultproxy.v1.PurgeGitRequest
+	21,  // 130: api.vaultproxy.v1.Secret.CreatePki:input_type -> api.vaultproxy.v1.PkiRequest
+	21,  // 131: api.vaultproxy.v1.Secret.DeletePki:input_type -> api.vaultproxy.v1.PkiRequest
+	21,  // 132: api.vaultproxy.v1.Secret.GetPki:input_type -> api.vaultproxy.v1.PkiRequest
+	25,  // 133: api.vaultproxy.v1.Secret.UndeletePki:input_type -> api.vaultproxy.v1.UndeletePkiRequest
+	27,  // 134: api.vaultproxy.v1.Secret.PurgePki:input_type -> api.vaultproxy.v1.PurgePkiRequest
+	32,  // 135: api.vaultproxy.v1.Secret.CreateRepoAccount:input_type -> api.vaultproxy.v1.RepoRequest
+	32,  // 136: api.vaultproxy.v1.Secret.DeleteRepoAccountProduct:input_type -> api.vaultproxy.v1.RepoRequest
+	32,  // 137: api.vaultproxy.v1.Secret.DeleteRepoAccountProject:input_type -> api.vaultproxy.v1.RepoRequest
+	35,  // 138: api.vaultproxy.v1.Secret.GetRepoAccountProduct:input_type -> api.vaultproxy.v1.GetRepoRequest
+	35,  // 139: api.vaultproxy.v1.Secret.GetRepoAccountProject:input_type -> api.vaultproxy.v1.GetRepoRequest
+	37,  // 140: api.vaultproxy.v1.Secret.ListRepoAccount:input_type -> api.vaultproxy.v1.ListRepoRequest
+	35,  // 141: api.vaultproxy.v1.Secret.ListRepoAccountProductVersions:input_type -> api.vaultproxy.v1.GetRepoRequest
+	40,  // 142: api.vaultproxy.v1.Secret.RollbackRepoAccountProduct:input_type -> api.vaultproxy.v1.RollbackRepoRequest
+	42,  // 143: api.vaultproxy.v1.Secret.UndeleteRepoAccountProduct:input_type -> api.vaultproxy.v1.UndeleteRepoRequest
+	45,  // 144: api.vaultproxy.v1.Secret.PurgeRepoAccountProduct:input_type -> api.vaultproxy.v1.PurgeRepoRequest
+	35,  // 145: api.vaultproxy.v1.Secret.ListRepoAccountProjectVersions:input_type -> api.vaultproxy.v1.GetRepoRequest
+	40,  // 146: api.vaultproxy.v1.Secret.RollbackRepoAccountProject:input_type -> api.vaultproxy.v1.RollbackRepoRequest
+	42,  // 147: api.vaultproxy.v1.Secret.UndeleteRepoAccountProject:input_type -> api.vaultproxy.v1.UndeleteRepoRequest
+	45,  // 148: api.vaultproxy.v1.Secret.PurgeRepoAccountProject:input_type -> api.vaultproxy.v1.PurgeRepoRequest
+	44,  // 149: api.vaultproxy.v1.Secret.RenderRepoAccountProductDockerConfig:input_type -> api.vaultproxy.v1.RenderRepoDockerConfigRequest
+	44,  // 150: api.vaultproxy.v1.Secret.RenderRepoAccountProjectDockerConfig:input_type -> api.vaultproxy.v1.RenderRepoDockerConfigRequest
+	47,  // 151: api.vaultproxy.v1.Secret.CreteTenantGit:input_type -> api.vaultproxy.v1.TenantGitRequest
+	47,  // 152: api.vaultproxy.v1.Secret.DeleteTenantGit:input_type -> api.vaultproxy.v1.TenantGitRequest
+	50,  // 153: api.vaultproxy.v1.Secret.GetTenantGit:input_type -> api.vaultproxy.v1.GetTenantGitRequest
+	52,  // 154: api.vaultproxy.v1.Secret.ListTenantGit:input_type -> api.vaultproxy.v1.ListTenantGitRequest
+	50,  // 155: api.vaultproxy.v1.Secret.ListTenantGitVersions:input_type -> api.vaultproxy.v1.GetTenantGitRequest
+	55,  // 156: api.vaultproxy.v1.Secret.RollbackTenantGit:input_type -> api.vaultproxy.v1.RollbackTenantGitRequest
+	57,  // 157: api.vaultproxy.v1.Secret.UndeleteTenantGit:input_type -> api.vaultproxy.v1.UndeleteTenantGitRequest
+	59,  // 158: api.vaultproxy.v1.Secret.PurgeTenantGit:input_type -> api.vaultproxy.v1.PurgeTenantGitRequest
+	61,  // 159: api.vaultproxy.v1.Secret.CreateTenantRepo:input_type -> api.vaultproxy.v1.TenantRepoRequest
+	61,  // 160: api.vaultproxy.v1.Secret.DeleteTenantRepo:input_type -> api.vaultproxy.v1.TenantRepoRequest
+	64,  // 161: api.vaultproxy.v1.Secret.GetTenantRepo:input_type -> api.vaultproxy.v1.GetTenantRepoRequest
+	66,  // 162: api.vaultproxy.v1.Secret.ListTenantRepo:input_type -> api.vaultproxy.v1.ListTenantRepoRequest
+	64,  // 163: api.vaultproxy.v1.Secret.ListTenantRepoVersions:input_type -> api.vaultproxy.v1.GetTenantRepoRequest
+	69,  // 164: api.vaultproxy.v1.Secret.RollbackTenantRepo:input_type -> api.vaultproxy.v1.RollbackTenantRepoRequest
+	71,  // 165: api.vaultproxy.v1.Secret.UndeleteTenantRepo:input_type -> api.vaultproxy.v1.UndeleteTenantRepoRequest
+	73,  // 166: api.vaultproxy.v1.Secret.PurgeTenantRepo:input_type -> api.vaultproxy.v1.PurgeTenantRepoRequest
+	74,  // 167: api.vaultproxy.v1.Secret.RenderTenantRepoDockerConfig:input_type -> api.vaultproxy.v1.RenderTenantRepoDockerConfigRequest
+	78,  // 168: api.vaultproxy.v1.Secret.CreateCluster:input_type -> api.vaultproxy.v1.ClusterRequest
+	78,  // 169: api.vaultproxy.v1.Secret.DeleteCluster:input_type -> api.vaultproxy.v1.ClusterRequest
+	81,  // 170: api.vaultproxy.v1.Secret.GetCluster:input_type -> api.vaultproxy.v1.GetClusterRequest
+	83,  // 171: api.vaultproxy.v1.Secret.ListCluster:input_type -> api.vaultproxy.v1.ListClusterRequest
+	81,  // 172: api.vaultproxy.v1.Secret.ListClusterVersions:input_type -> api.vaultproxy.v1.GetClusterRequest
+	86,  // 173: api.vaultproxy.v1.Secret.RollbackCluster:input_type -> api.vaultproxy.v1.RollbackClusterRequest
+	88,  // 174: api.vaultproxy.v1.Secret.UndeleteCluster:input_type -> api.vaultproxy.v1.UndeleteClusterRequest
+	90,  // 175: api.vaultproxy.v1.Secret.PurgeCluster:input_type -> api.vaultproxy.v1.PurgeClusterRequest
+	91,  // 176: api.vaultproxy.v1.Secret.CreateSecret:input_type -> api.vaultproxy.v1.CreateSecretRequest
+	93,  // 177: api.vaultproxy.v1.Secret.GetSecret:input_type -> api.vaultproxy.v1.GetSecretRequest
+	95,  // 178: api.vaultproxy.v1.Secret.DeleteSecret:input_type -> api.vaultproxy.v1.DeleteSecretRequest
+	97,  // 179: api.vaultproxy.v1.Secret.UndeleteSecret:input_type -> api.vaultproxy.v1.UndeleteSecretRequest
+	99,  // 180: api.vaultproxy.v1.Secret.PurgeSecret:input_type -> api.vaultproxy.v1.PurgeSecretRequest
+	102, // 181: api.vaultproxy.v1.Secret.BatchCreateSecrets:input_type -> api.vaultproxy.v1.BatchCreateSecretsRequest
+	103, // 182: api.vaultproxy.v1.Secret.BatchDeleteSecrets:input_type -> api.vaultproxy.v1.BatchDeleteSecretsRequest
+	100, // 183: api.vaultproxy.v1.Secret.UpdateSecretOwners:input_type -> api.vaultproxy.v1.UpdateSecretOwnersRequest
+	107, // 184: api.vaultproxy.v1.Auth.CreateAuth:input_type -> api.vaultproxy.v1.AuthRequest
+	107, // 185: api.vaultproxy.v1.Auth.DeleteAuth:input_type -> api.vaultproxy.v1.AuthRequest
+	111, // 186: api.vaultproxy.v1.Auth.CreateAuthrole:input_type -> api.vaultproxy.v1.AuthroleRequest
+	111, // 187: api.vaultproxy.v1.Auth.DeleteAuthrole:input_type -> api.vaultproxy.v1.AuthroleRequest
+	114, // 188: api.vaultproxy.v1.AuthGrant.GrantAuthroleGitPolicy:input_type -> api.vaultproxy.v1.AuthroleGitPolicyRequest
+	114, // 189: api.vaultproxy.v1.AuthGrant.RevokeAuthroleGitPolicy:input_type -> api.vaultproxy.v1.AuthroleGitPolicyRequest
+	115, // 190: api.vaultproxy.v1.AuthGrant.GrantAuthroleRepoPolicy:input_type -> api.vaultproxy.v1.AuthroleRepoPolicyRequest
+	115, // 191: api.vaultproxy.v1.AuthGrant.RevokeAuthroleRepoPolicy:input_type -> api.vaultproxy.v1.AuthroleRepoPolicyRequest
+	116, // 192: api.vaultproxy.v1.AuthGrant.GrantAuthroleClusterPolicy:input_type -> api.vaultproxy.v1.AuthroleClusterPolicyRequest
+	116, // 193: api.vaultproxy.v1.AuthGrant.RevokeAuthroleClusterPolicy:input_type -> api.vaultproxy.v1.AuthroleClusterPolicyRequest
+	117, // 194: api.vaultproxy.v1.AuthGrant.GrantAuthroleTenantGitPolicy:input_type -> api.vaultproxy.v1.AuthroleTenantGitPolicyRequest
+	117, // 195: api.vaultproxy.v1.AuthGrant.RevokeAuthroleTenantGitPolicy:input_type -> api.vaultproxy.v1.AuthroleTenantGitPolicyRequest
+	118, // 196: api.vaultproxy.v1.AuthGrant.GrantAuthroleTenantRepoPolicy:input_type -> api.vaultproxy.v1.AuthroleTenantRepoPolicyRequest
+	118, // 197: api.vaultproxy.v1.AuthGrant.RevokeAuthroleTenantRepoPolicy:input_type -> api.vaultproxy.v1.AuthroleTenantRepoPolicyRequest
+	122, // 198: api.vaultproxy.v1.Reconcile.GetDrift:input_type -> api.vaultproxy.v1.GetDriftRequest
+	123, // 199: api.vaultproxy.v1.Reconcile.Reconcile:input_type -> api.vaultproxy.v1.ReconcileRequest
+	125, // 200: api.vaultproxy.v1.Expiration.ListExpirations:input_type -> api.vaultproxy.v1.ListExpirationsRequest
+	128, // 201: api.vaultproxy.v1.Rotation.ListRotations:input_type -> api.vaultproxy.v1.ListRotationsRequest
+	9,   // 202: api.vaultproxy.v1.Secret.CreateGit:output_type -> api.vaultproxy.v1.CreateGitReply
+	10,  // 203: api.vaultproxy.v1.Secret.DeleteGit:output_type -> api.vaultproxy.v1.DeleteGitReply
+	12,  // 204: api.vaultproxy.v1.Secret.GetGit:output_type -> api.vaultproxy.v1.GetGitReply
+	14,  // 205: api.vaultproxy.v1.Secret.ListGit:output_type -> api.vaultproxy.v1.ListGitReply
+	15,  // 206: api.vaultproxy.v1.Secret.ListGitVersions:output_type -> api.vaultproxy.v1.ListGitVersionsReply
+	17,  // 207: api.vaultproxy.v1.Secret.RollbackGit:output_type -> api.vaultproxy.v1.RollbackGitReply
+	19,  // 208: api.vaultproxy.v1.Secret.UndeleteGit:output_type -> api.vaultproxy.v1.UndeleteGitReply
+	10,  // 209: api.vaultproxy.v1.Secret.PurgeGit:output_type -> api.vaultproxy.v1.DeleteGitReply
+	22,  // 210: api.vaultproxy.v1.Secret.CreatePki:output_type -> api.vaultproxy.v1.CreatePkiReply
+	23,  // 211: api.vaultproxy.v1.Secret.DeletePki:output_type -> api.vaultproxy.v1.DeletePkiReply
+	24,  // 212: api.vaultproxy.v1.Secret.GetPki:output_type -> api.vaultproxy.v1.GetPkiReply
+	26,  // 213: api.vaultproxy.v1.Secret.UndeletePki:output_type -> api.vaultproxy.v1.UndeletePkiReply
+	23,  // 214: api.vaultproxy.v1.Secret.PurgePki:output_type -> api.vaultproxy.v1.DeletePkiReply
+	33,  // 215: api.vaultproxy.v1.Secret.CreateRepoAccount:output_type -> api.vaultproxy.v1.CreateRepoReply
+	34,  // 216: api.vaultproxy.v1.Secret.DeleteRepoAccountProduct:output_type -> api.vaultproxy.v1.DeleteRepoReply
+	34,  // 217: api.vaultproxy.v1.Secret.DeleteRepoAccountProject:output_type -> api.vaultproxy.v1.DeleteRepoReply
+	36,  // 218: api.vaultproxy.v1.Secret.GetRepoAccountProduct:output_type -> api.vaultproxy.v1.GetRepoReply
+	36,  // 219: api.vaultproxy.v1.Secret.GetRepoAccountProject:output_type -> api.vaultproxy.v1.GetRepoReply
+	38,  // 220: api.vaultproxy.v1.Secret.ListRepoAccount:output_type -> api.vaultproxy.v1.ListRepoReply
+	39,  // 221: api.vaultproxy.v1.Secret.ListRepoAccountProductVersions:output_type -> api.vaultproxy.v1.ListRepoVersionsReply
+	41,  // 222: api.vaultproxy.v1.Secret.RollbackRepoAccountProduct:output_type -> api.vaultproxy.v1.RollbackRepoReply
+	43,  // 223: api.vaultproxy.v1.Secret.UndeleteRepoAccountProduct:output_type -> api.vaultproxy.v1.UndeleteRepoReply
+	34,  // 224: api.vaultproxy.v1.Secret.PurgeRepoAccountProduct:output_type -> api.vaultproxy.v1.DeleteRepoReply
+	39,  // 225: api.vaultproxy.v1.Secret.ListRepoAccountProjectVersions:output_type -> api.vaultproxy.v1.ListRepoVersionsReply
+	41,  // 226: api.vaultproxy.v1.Secret.RollbackRepoAccountProject:output_type -> api.vaultproxy.v1.RollbackRepoReply
+	43,  // 227: api.vaultproxy.v1.Secret.UndeleteRepoAccountProject:output_type -> api.vaultproxy.v1.UndeleteRepoReply
+	34,  // 228: api.vaultproxy.v1.Secret.PurgeRepoAccountProject:output_type -> api.vaultproxy.v1.DeleteRepoReply
+	75,  // 229: api.vaultproxy.v1.Secret.RenderRepoAccountProductDockerConfig:output_type -> api.vaultproxy.v1.DockerConfigReply
+	75,  // 230: api.vaultproxy.v1.Secret.RenderRepoAccountProjectDockerConfig:output_type -> api.vaultproxy.v1.DockerConfigReply
+	48,  // 231: api.vaultproxy.v1.Secret.CreteTenantGit:output_type -> api.vaultproxy.v1.CreateTenantGitReply
+	49,  // 232: api.vaultproxy.v1.Secret.DeleteTenantGit:output_type -> api.vaultproxy.v1.DeleteTenantGitReply
+	51,  // 233: api.vaultproxy.v1.Secret.GetTenantGit:output_type -> api.vaultproxy.v1.GetTenantGitReply
+	53,  // 234: api.vaultproxy.v1.Secret.ListTenantGit:output_type -> api.vaultproxy.v1.ListTenantGitReply
+	54,  // 235: api.vaultproxy.v1.Secret.ListTenantGitVersions:output_type -> api.vaultproxy.v1.ListTenantGitVersionsReply
+	56,  // 236: api.vaultproxy.v1.Secret.RollbackTenantGit:output_type -> api.vaultproxy.v1.RollbackTenantGitReply
+	58,  // 237: api.vaultproxy.v1.Secret.UndeleteTenantGit:output_type -> api.vaultproxy.v1.UndeleteTenantGitReply
+	49,  // 238: api.vaultproxy.v1.Secret.PurgeTenantGit:output_type -> api.vaultproxy.v1.DeleteTenantGitReply
+	62,  // 239: api.vaultproxy.v1.Secret.CreateTenantRepo:output_type -> api.vaultproxy.v1.CreateTenantRepoReply
+	63,  // 240: api.vaultproxy.v1.Secret.DeleteTenantRepo:output_type -> api.vaultproxy.v1.DeleteTenantRepoReply
+	65,  // 241: api.vaultproxy.v1.Secret.GetTenantRepo:output_type -> api.vaultproxy.v1.GetTenantRepoReply
+	67,  // 242: api.vaultproxy.v1.Secret.ListTenantRepo:output_type -> api.vaultproxy.v1.ListTenantRepoReply
+	68,  // 243: api.vaultproxy.v1.Secret.ListTenantRepoVersions:output_type -> api.vaultproxy.v1.ListTenantRepoVersionsReply
+	70,  // 244: api.vaultproxy.v1.Secret.RollbackTenantRepo:output_type -> api.vaultproxy.v1.RollbackTenantRepoReply
+	72,  // 245: api.vaultproxy.v1.Secret.UndeleteTenantRepo:output_type -> api.vaultproxy.v1.UndeleteTenantRepoReply
+	63,  // 246: api.vaultproxy.v1.Secret.PurgeTenantRepo:output_type -> api.vaultproxy.v1.DeleteTenantRepoReply
+	75,  // 247: api.vaultproxy.v1.Secret.RenderTenantRepoDockerConfig:output_type -> api.vaultproxy.v1.DockerConfigReply
+	79,  // 248: api.vaultproxy.v1.Secret.CreateCluster:output_type -> api.vaultproxy.v1.CreateClusterReply
+	80,  // 249: api.vaultproxy.v1.Secret.DeleteCluster:output_type -> api.vaultproxy.v1.DeleteClusterReply
+	82,  // 250: api.vaultproxy.v1.Secret.GetCluster:output_type -> api.vaultproxy.v1.GetClusterReply
+	84,  // 251: api.vaultproxy.v1.Secret.ListCluster:output_type -> api.vaultproxy.v1.ListClusterReply
+	85,  // 252: api.vaultproxy.v1.Secret.ListClusterVersions:output_type -> api.vaultproxy.v1.ListClusterVersionsReply
+	87,  // 253: api.vaultproxy.v1.Secret.RollbackCluster:output_type -> api.vaultproxy.v1.RollbackClusterReply
+	89,  // 254: api.vaultproxy.v1.Secret.UndeleteCluster:output_type -> api.vaultproxy.v1.UndeleteClusterReply
+	80,  // 255: api.vaultproxy.v1.Secret.PurgeCluster:output_type -> api.vaultproxy.v1.DeleteClusterReply
+	92,  // 256: api.vaultproxy.v1.Secret.CreateSecret:output_type -> api.vaultproxy.v1.CreateSecretReply
+	94,  // 257: api.vaultproxy.v1.Secret.GetSecret:output_type -> api.vaultproxy.v1.GetSecretReply
+	96,  // 258: api.vaultproxy.v1.Secret.DeleteSecret:output_type -> api.vaultproxy.v1.DeleteSecretReply
+	98,  // 259: api.vaultproxy.v1.Secret.UndeleteSecret:output_type -> api.vaultproxy.v1.UndeleteSecretReply
+	96,  // 260: api.vaultproxy.v1.Secret.PurgeSecret:output_type -> api.vaultproxy.v1.DeleteSecretReply
+	105, // 261: api.vaultproxy.v1.Secret.BatchCreateSecrets:output_type -> api.vaultproxy.v1.BatchSecretsReply
+	105, // 262: api.vaultproxy.v1.Secret.BatchDeleteSecrets:output_type -> api.vaultproxy.v1.BatchSecretsReply
+	101, // 263: api.vaultproxy.v1.Secret.UpdateSecretOwners:output_type -> api.vaultproxy.v1.UpdateSecretOwnersReply
+	108, // 264: api.vaultproxy.v1.Auth.CreateAuth:output_type -> api.vaultproxy.v1.CreateAuthReply
+	109, // 265: api.vaultproxy.v1.Auth.DeleteAuth:output_type -> api.vaultproxy.v1.DeleteAuthReply
+	112, // 266: api.vaultproxy.v1.Auth.CreateAuthrole:output_type -> api.vaultproxy.v1.CreateAuthroleReply
+	113, // 267: api.vaultproxy.v1.Auth.DeleteAuthrole:output_type -> api.vaultproxy.v1.DeleteAuthroleReply
+	119, // 268: api.vaultproxy.v1.AuthGrant.GrantAuthroleGitPolicy:output_type -> api.vaultproxy.v1.GrantAuthrolePolicyReply
+	120, // 269: api.vaultproxy.v1.AuthGrant.RevokeAuthroleGitPolicy:output_type -> api.vaultproxy.v1.RevokeAuthrolePolicyReply
+	119, // 270: api.vaultproxy.v1.AuthGrant.GrantAuthroleRepoPolicy:output_type -> api.vaultproxy.v1.GrantAuthrolePolicyReply
+	120, // 271: api.vaultproxy.v1.AuthGrant.RevokeAuthroleRepoPolicy:output_type -> api.vaultproxy.v1.RevokeAuthrolePolicyReply
+	119, // 272: api.vaultproxy.v1.AuthGrant.GrantAuthroleClusterPolicy:output_type -> api.vaultproxy.v1.GrantAuthrolePolicyReply
+	120, // 273: api.vaultproxy.v1.AuthGrant.RevokeAuthroleClusterPolicy:output_type -> api.vaultproxy.v1.RevokeAuthrolePolicyReply
+	119, // 274: api.vaultproxy.v1.AuthGrant.GrantAuthroleTenantGitPolicy:output_type -> api.vaultproxy.v1.GrantAuthrolePolicyReply
+	120, // 275: api.vaultproxy.v1.AuthGrant.RevokeAuthroleTenantGitPolicy:output_type -> api.vaultproxy.v1.RevokeAuthrolePolicyReply
+	119, // 276: api.vaultproxy.v1.AuthGrant.GrantAuthroleTenantRepoPolicy:output_type -> api.vaultproxy.v1.GrantAuthrolePolicyReply
+	120, // 277: api.vaultproxy.v1.AuthGrant.RevokeAuthroleTenantRepoPolicy:output_type -> api.vaultproxy.v1.RevokeAuthrolePolicyReply
+	124, // 278: api.vaultproxy.v1.Reconcile.GetDrift:output_type -> api.vaultproxy.v1.DriftReply
+	124, // 279: api.vaultproxy.v1.Reconcile.Reconcile:output_type -> api.vaultproxy.v1.DriftReply
+	127, // 280: api.vaultproxy.v1.Expiration.ListExpirations:output_type -> api.vaultproxy.v1.ListExpirationsReply
+	131, // 281: api.vaultproxy.v1.Rotation.ListRotations:output_type -> api.vaultproxy.v1.ListRotationsReply
+	202, // [202:282] is the sub-list for method output_type
+	122, // [122:202] is the sub-list for method input_type
+	122, // [122:122] is the sub-list for extension type_name
+	122, // [122:122] is the sub-list for extension extendee
+	0,   // [0:122] is the sub-list for field type_name
+}
+
+func init() { file_api_vaultproxy_v1_vaultproxy_proto_init() }
+func file_api_vaultproxy_v1_vaultproxy_proto_init() {
+	if File_api_vaultproxy_v1_vaultproxy_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DryRunPlan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitKVs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGitReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteGitReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGitReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGitReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGitVersionsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackGitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackGitReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndeleteGitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndeleteGitReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeGitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PkiRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePkiReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePkiReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPkiReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndeletePkiRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndeletePkiReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgePkiRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Token); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Account); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRepoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRepoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRepoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRepoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRepoVersionsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackRepoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndeleteRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndeleteRepoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderRepoDockerConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantGitMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantGitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTenantGitReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTenantGitReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTenantGitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTenantGitReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTenantGitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTenantGitReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTenantGitVersionsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackTenantGitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackTenantGitReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndeleteTenantGitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndeleteTenantGitReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeTenantGitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantRepoMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTenantRepoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTenantRepoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTenantRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTenantRepoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTenantRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTenantRepoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTenantRepoVersionsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackTenantRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackTenantRepoReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3295,8 +11299,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitKVs); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndeleteTenantRepoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3307,8 +11311,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitMeta); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndeleteTenantRepoReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3319,8 +11323,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitRequest); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeTenantRepoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3331,8 +11335,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateGitReply); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderTenantRepoDockerConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3343,8 +11347,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteGitReply); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DockerConfigReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3355,8 +11359,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PkiRequest); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterAccount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3367,8 +11371,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePkiReply); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterMeta); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3379,8 +11383,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePkiReply); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3391,8 +11395,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoAccount); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateClusterReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3403,8 +11407,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Token); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteClusterReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3415,8 +11419,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Account); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClusterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3427,8 +11431,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoMeta); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClusterReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3439,8 +11443,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoRequest); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListClusterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3451,8 +11455,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRepoReply); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListClusterReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3463,8 +11467,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRepoReply); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListClusterVersionsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3475,8 +11479,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TenantGitMeta); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackClusterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3487,8 +11491,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TenantGitRequest); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackClusterReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3499,8 +11503,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTenantGitReply); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndeleteClusterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3511,8 +11515,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteTenantGitReply); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndeleteClusterReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3523,8 +11527,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TenantRepoMeta); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeClusterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3535,8 +11539,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TenantRepoRequest); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSecretRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3547,8 +11551,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTenantRepoReply); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSecretReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3559,8 +11563,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteTenantRepoReply); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSecretRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3571,8 +11575,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterAccount); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSecretReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3583,8 +11587,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterMeta); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSecretRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3595,8 +11599,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterRequest); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSecretReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3607,8 +11611,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateClusterReply); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndeleteSecretRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3619,8 +11623,8 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteClusterReply); i {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndeleteSecretReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3631,7 +11635,91 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeSecretRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSecretOwnersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSecretOwnersReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateSecretsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchDeleteSecretsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchSecretResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchSecretsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Kubernetes); i {
 			case 0:
 				return &v.state
@@ -3643,7 +11731,7 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AuthRequest); i {
 			case 0:
 				return &v.state
@@ -3655,7 +11743,7 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateAuthReply); i {
 			case 0:
 				return &v.state
@@ -3667,7 +11755,7 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteAuthReply); i {
 			case 0:
 				return &v.state
@@ -3679,7 +11767,7 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*KubernetesAuthRoleMeta); i {
 			case 0:
 				return &v.state
@@ -3691,7 +11779,7 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AuthroleRequest); i {
 			case 0:
 				return &v.state
@@ -3703,7 +11791,7 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateAuthroleReply); i {
 			case 0:
 				return &v.state
@@ -3715,7 +11803,7 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteAuthroleReply); i {
 			case 0:
 				return &v.state
@@ -3727,7 +11815,7 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AuthroleGitPolicyRequest); i {
 			case 0:
 				return &v.state
@@ -3739,7 +11827,7 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AuthroleRepoPolicyRequest); i {
 			case 0:
 				return &v.state
@@ -3751,7 +11839,7 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AuthroleClusterPolicyRequest); i {
 			case 0:
 				return &v.state
@@ -3763,7 +11851,7 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AuthroleTenantGitPolicyRequest); i {
 			case 0:
 				return &v.state
@@ -3775,7 +11863,7 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AuthroleTenantRepoPolicyRequest); i {
 			case 0:
 				return &v.state
@@ -3787,7 +11875,7 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GrantAuthrolePolicyReply); i {
 			case 0:
 				return &v.state
@@ -3799,7 +11887,7 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
-		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RevokeAuthrolePolicyReply); i {
 			case 0:
 				return &v.state
@@ -3811,8 +11899,147 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 				return nil
 			}
 		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Drift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDriftRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconcileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DriftReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExpirationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretExpiration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExpirationsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRotationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportRotationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretRotation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRotationsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[34].OneofWrappers = []interface{}{
+	file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[31].OneofWrappers = []interface{}{}
+	file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[46].OneofWrappers = []interface{}{}
+	file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[60].OneofWrappers = []interface{}{}
+	file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[77].OneofWrappers = []interface{}{}
+	file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[90].OneofWrappers = []interface{}{}
+	file_api_vaultproxy_v1_vaultproxy_proto_msgTypes[110].OneofWrappers = []interface{}{
 		(*AuthroleRequest_Kubernetes)(nil),
 	}
 	type x struct{}
@@ -3821,9 +12048,9 @@ func file_api_vaultproxy_v1_vaultproxy_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_vaultproxy_v1_vaultproxy_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   46,
+			NumMessages:   141,
 			NumExtensions: 0,
-			NumServices:   3,
+			NumServices:   6,
 		},
 		GoTypes:           file_api_vaultproxy_v1_vaultproxy_proto_goTypes,
 		DependencyIndexes: file_api_vaultproxy_v1_vaultproxy_proto_depIdxs,
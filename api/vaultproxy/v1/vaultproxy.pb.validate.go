@@ -35,47 +35,87 @@ var (
 	_ = sort.Sort
 )
 
-// Validate checks the field values on SecretInfo with the rules defined in the
+// Validate checks the field values on DryRunPlan with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *SecretInfo) Validate() error {
+func (m *DryRunPlan) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SecretInfo with the rules defined in
+// ValidateAll checks the field values on DryRunPlan with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SecretInfoMultiError, or
+// result is a list of violation errors wrapped in DryRunPlanMultiError, or
 // nil if none found.
-func (m *SecretInfo) ValidateAll() error {
+func (m *DryRunPlan) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SecretInfo) validate(all bool) error {
+func (m *DryRunPlan) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for SecretName
 
-	// no validation rules for Path
+	// no validation rules for SecretPath
 
-	// no validation rules for Version
+	// no validation rules for PolicyName
+
+	// no validation rules for PolicyData
+
+	// no validation rules for AuthPath
+
+	// no validation rules for EnableAuth
+
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DryRunPlanValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DryRunPlanValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DryRunPlanValidationError{
+					field:  fmt.Sprintf("Roles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return SecretInfoMultiError(errors)
+		return DryRunPlanMultiError(errors)
 	}
 
 	return nil
 }
 
-// SecretInfoMultiError is an error wrapping multiple validation errors
-// returned by SecretInfo.ValidateAll() if the designated constraints aren't met.
-type SecretInfoMultiError []error
+// DryRunPlanMultiError is an error wrapping multiple validation errors
+// returned by DryRunPlan.ValidateAll() if the designated constraints aren't met.
+type DryRunPlanMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SecretInfoMultiError) Error() string {
+func (m DryRunPlanMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -84,11 +124,11 @@ func (m SecretInfoMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SecretInfoMultiError) AllErrors() []error { return m }
+func (m DryRunPlanMultiError) AllErrors() []error { return m }
 
-// SecretInfoValidationError is the validation error returned by
-// SecretInfo.Validate if the designated constraints aren't met.
-type SecretInfoValidationError struct {
+// DryRunPlanValidationError is the validation error returned by
+// DryRunPlan.Validate if the designated constraints aren't met.
+type DryRunPlanValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -96,22 +136,22 @@ type SecretInfoValidationError struct {
 }
 
 // Field function returns field value.
-func (e SecretInfoValidationError) Field() string { return e.field }
+func (e DryRunPlanValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SecretInfoValidationError) Reason() string { return e.reason }
+func (e DryRunPlanValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SecretInfoValidationError) Cause() error { return e.cause }
+func (e DryRunPlanValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SecretInfoValidationError) Key() bool { return e.key }
+func (e DryRunPlanValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SecretInfoValidationError) ErrorName() string { return "SecretInfoValidationError" }
+func (e DryRunPlanValidationError) ErrorName() string { return "DryRunPlanValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SecretInfoValidationError) Error() string {
+func (e DryRunPlanValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -123,14 +163,14 @@ func (e SecretInfoValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSecretInfo.%s: %s%s",
+		"invalid %sDryRunPlan.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SecretInfoValidationError{}
+var _ error = DryRunPlanValidationError{}
 
 var _ interface {
 	Field() string
@@ -138,48 +178,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SecretInfoValidationError{}
+} = DryRunPlanValidationError{}
 
-// Validate checks the field values on GitKVs with the rules defined in the
+// Validate checks the field values on RoleDiff with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *GitKVs) Validate() error {
+func (m *RoleDiff) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GitKVs with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in GitKVsMultiError, or nil if none found.
-func (m *GitKVs) ValidateAll() error {
+// ValidateAll checks the field values on RoleDiff with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RoleDiffMultiError, or nil
+// if none found.
+func (m *RoleDiff) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GitKVs) validate(all bool) error {
+func (m *RoleDiff) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DeployKey
+	// no validation rules for RolePath
 
-	// no validation rules for AccessToken
+	// no validation rules for Create
 
-	// no validation rules for Additionals
+	// no validation rules for Config
 
 	if len(errors) > 0 {
-		return GitKVsMultiError(errors)
+		return RoleDiffMultiError(errors)
 	}
 
 	return nil
 }
 
-// GitKVsMultiError is an error wrapping multiple validation errors returned by
-// GitKVs.ValidateAll() if the designated constraints aren't met.
-type GitKVsMultiError []error
+// RoleDiffMultiError is an error wrapping multiple validation errors returned
+// by RoleDiff.ValidateAll() if the designated constraints aren't met.
+type RoleDiffMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GitKVsMultiError) Error() string {
+func (m RoleDiffMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -188,11 +229,11 @@ func (m GitKVsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GitKVsMultiError) AllErrors() []error { return m }
+func (m RoleDiffMultiError) AllErrors() []error { return m }
 
-// GitKVsValidationError is the validation error returned by GitKVs.Validate if
-// the designated constraints aren't met.
-type GitKVsValidationError struct {
+// RoleDiffValidationError is the validation error returned by
+// RoleDiff.Validate if the designated constraints aren't met.
+type RoleDiffValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -200,22 +241,22 @@ type GitKVsValidationError struct {
 }
 
 // Field function returns field value.
-func (e GitKVsValidationError) Field() string { return e.field }
+func (e RoleDiffValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GitKVsValidationError) Reason() string { return e.reason }
+func (e RoleDiffValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GitKVsValidationError) Cause() error { return e.cause }
+func (e RoleDiffValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GitKVsValidationError) Key() bool { return e.key }
+func (e RoleDiffValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GitKVsValidationError) ErrorName() string { return "GitKVsValidationError" }
+func (e RoleDiffValidationError) ErrorName() string { return "RoleDiffValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GitKVsValidationError) Error() string {
+func (e RoleDiffValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -227,14 +268,14 @@ func (e GitKVsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGitKVs.%s: %s%s",
+		"invalid %sRoleDiff.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GitKVsValidationError{}
+var _ error = RoleDiffValidationError{}
 
 var _ interface {
 	Field() string
@@ -242,86 +283,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GitKVsValidationError{}
+} = RoleDiffValidationError{}
 
-// Validate checks the field values on GitMeta with the rules defined in the
+// Validate checks the field values on SecretInfo with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *GitMeta) Validate() error {
+func (m *SecretInfo) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GitMeta with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in GitMetaMultiError, or nil if none found.
-func (m *GitMeta) ValidateAll() error {
+// ValidateAll checks the field values on SecretInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SecretInfoMultiError, or
+// nil if none found.
+func (m *SecretInfo) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GitMeta) validate(all bool) error {
+func (m *SecretInfo) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetProviderType()) < 1 {
-		err := GitMetaValidationError{
-			field:  "ProviderType",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetId()) < 1 {
-		err := GitMetaValidationError{
-			field:  "Id",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Name
 
-	if utf8.RuneCountInString(m.GetUsername()) < 1 {
-		err := GitMetaValidationError{
-			field:  "Username",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Path
 
-	if utf8.RuneCountInString(m.GetPermission()) < 1 {
-		err := GitMetaValidationError{
-			field:  "Permission",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Version
 
 	if len(errors) > 0 {
-		return GitMetaMultiError(errors)
+		return SecretInfoMultiError(errors)
 	}
 
 	return nil
 }
 
-// GitMetaMultiError is an error wrapping multiple validation errors returned
-// by GitMeta.ValidateAll() if the designated constraints aren't met.
-type GitMetaMultiError []error
+// SecretInfoMultiError is an error wrapping multiple validation errors
+// returned by SecretInfo.ValidateAll() if the designated constraints aren't met.
+type SecretInfoMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GitMetaMultiError) Error() string {
+func (m SecretInfoMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -330,11 +334,11 @@ func (m GitMetaMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GitMetaMultiError) AllErrors() []error { return m }
+func (m SecretInfoMultiError) AllErrors() []error { return m }
 
-// GitMetaValidationError is the validation error returned by GitMeta.Validate
-// if the designated constraints aren't met.
-type GitMetaValidationError struct {
+// SecretInfoValidationError is the validation error returned by
+// SecretInfo.Validate if the designated constraints aren't met.
+type SecretInfoValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -342,22 +346,22 @@ type GitMetaValidationError struct {
 }
 
 // Field function returns field value.
-func (e GitMetaValidationError) Field() string { return e.field }
+func (e SecretInfoValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GitMetaValidationError) Reason() string { return e.reason }
+func (e SecretInfoValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GitMetaValidationError) Cause() error { return e.cause }
+func (e SecretInfoValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GitMetaValidationError) Key() bool { return e.key }
+func (e SecretInfoValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GitMetaValidationError) ErrorName() string { return "GitMetaValidationError" }
+func (e SecretInfoValidationError) ErrorName() string { return "SecretInfoValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GitMetaValidationError) Error() string {
+func (e SecretInfoValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -369,14 +373,14 @@ func (e GitMetaValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGitMeta.%s: %s%s",
+		"invalid %sSecretInfo.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GitMetaValidationError{}
+var _ error = SecretInfoValidationError{}
 
 var _ interface {
 	Field() string
@@ -384,123 +388,60 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GitMetaValidationError{}
+} = SecretInfoValidationError{}
 
-// Validate checks the field values on GitRequest with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on SecretMetadata with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *GitRequest) Validate() error {
+func (m *SecretMetadata) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GitRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GitRequestMultiError, or
-// nil if none found.
-func (m *GitRequest) ValidateAll() error {
+// ValidateAll checks the field values on SecretMetadata with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SecretMetadataMultiError,
+// or nil if none found.
+func (m *SecretMetadata) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GitRequest) validate(all bool) error {
+func (m *SecretMetadata) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetMeta() == nil {
-		err := GitRequestValidationError{
-			field:  "Meta",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for PolicyName
 
-	if all {
-		switch v := interface{}(m.GetMeta()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GitRequestValidationError{
-					field:  "Meta",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GitRequestValidationError{
-					field:  "Meta",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GitRequestValidationError{
-				field:  "Meta",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for CreatedTime
 
-	if m.GetKvs() == nil {
-		err := GitRequestValidationError{
-			field:  "Kvs",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetKvs()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GitRequestValidationError{
-					field:  "Kvs",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GitRequestValidationError{
-					field:  "Kvs",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetKvs()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GitRequestValidationError{
-				field:  "Kvs",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if len(errors) > 0 {
-		return GitRequestMultiError(errors)
+	// no validation rules for UpdatedTime
+
+	// no validation rules for CreatedBy
+
+	// no validation rules for UpdatedBy
+
+	// no validation rules for RequestId
+
+	// no validation rules for Tenant
+
+	// no validation rules for SourceType
+
+	if len(errors) > 0 {
+		return SecretMetadataMultiError(errors)
 	}
 
 	return nil
 }
 
-// GitRequestMultiError is an error wrapping multiple validation errors
-// returned by GitRequest.ValidateAll() if the designated constraints aren't met.
-type GitRequestMultiError []error
+// SecretMetadataMultiError is an error wrapping multiple validation errors
+// returned by SecretMetadata.ValidateAll() if the designated constraints
+// aren't met.
+type SecretMetadataMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GitRequestMultiError) Error() string {
+func (m SecretMetadataMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -509,11 +450,11 @@ func (m GitRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GitRequestMultiError) AllErrors() []error { return m }
+func (m SecretMetadataMultiError) AllErrors() []error { return m }
 
-// GitRequestValidationError is the validation error returned by
-// GitRequest.Validate if the designated constraints aren't met.
-type GitRequestValidationError struct {
+// SecretMetadataValidationError is the validation error returned by
+// SecretMetadata.Validate if the designated constraints aren't met.
+type SecretMetadataValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -521,22 +462,22 @@ type GitRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GitRequestValidationError) Field() string { return e.field }
+func (e SecretMetadataValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GitRequestValidationError) Reason() string { return e.reason }
+func (e SecretMetadataValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GitRequestValidationError) Cause() error { return e.cause }
+func (e SecretMetadataValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GitRequestValidationError) Key() bool { return e.key }
+func (e SecretMetadataValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GitRequestValidationError) ErrorName() string { return "GitRequestValidationError" }
+func (e SecretMetadataValidationError) ErrorName() string { return "SecretMetadataValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GitRequestValidationError) Error() string {
+func (e SecretMetadataValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -548,14 +489,14 @@ func (e GitRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGitRequest.%s: %s%s",
+		"invalid %sSecretMetadata.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GitRequestValidationError{}
+var _ error = SecretMetadataValidationError{}
 
 var _ interface {
 	Field() string
@@ -563,73 +504,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GitRequestValidationError{}
+} = SecretMetadataValidationError{}
 
-// Validate checks the field values on CreateGitReply with the rules defined in
+// Validate checks the field values on SecretVersion with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *CreateGitReply) Validate() error {
+func (m *SecretVersion) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateGitReply with the rules defined
+// ValidateAll checks the field values on SecretVersion with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CreateGitReplyMultiError,
-// or nil if none found.
-func (m *CreateGitReply) ValidateAll() error {
+// result is a list of violation errors wrapped in SecretVersionMultiError, or
+// nil if none found.
+func (m *SecretVersion) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateGitReply) validate(all bool) error {
+func (m *SecretVersion) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSecret()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateGitReplyValidationError{
-					field:  "Secret",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateGitReplyValidationError{
-					field:  "Secret",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateGitReplyValidationError{
-				field:  "Secret",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Version
+
+	// no validation rules for CreatedTime
+
+	// no validation rules for DeletionTime
+
+	// no validation rules for Destroyed
 
 	if len(errors) > 0 {
-		return CreateGitReplyMultiError(errors)
+		return SecretVersionMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateGitReplyMultiError is an error wrapping multiple validation errors
-// returned by CreateGitReply.ValidateAll() if the designated constraints
+// SecretVersionMultiError is an error wrapping multiple validation errors
+// returned by SecretVersion.ValidateAll() if the designated constraints
 // aren't met.
-type CreateGitReplyMultiError []error
+type SecretVersionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateGitReplyMultiError) Error() string {
+func (m SecretVersionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -638,11 +558,11 @@ func (m CreateGitReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateGitReplyMultiError) AllErrors() []error { return m }
+func (m SecretVersionMultiError) AllErrors() []error { return m }
 
-// CreateGitReplyValidationError is the validation error returned by
-// CreateGitReply.Validate if the designated constraints aren't met.
-type CreateGitReplyValidationError struct {
+// SecretVersionValidationError is the validation error returned by
+// SecretVersion.Validate if the designated constraints aren't met.
+type SecretVersionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -650,22 +570,22 @@ type CreateGitReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateGitReplyValidationError) Field() string { return e.field }
+func (e SecretVersionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateGitReplyValidationError) Reason() string { return e.reason }
+func (e SecretVersionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateGitReplyValidationError) Cause() error { return e.cause }
+func (e SecretVersionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateGitReplyValidationError) Key() bool { return e.key }
+func (e SecretVersionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateGitReplyValidationError) ErrorName() string { return "CreateGitReplyValidationError" }
+func (e SecretVersionValidationError) ErrorName() string { return "SecretVersionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateGitReplyValidationError) Error() string {
+func (e SecretVersionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -677,14 +597,14 @@ func (e CreateGitReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateGitReply.%s: %s%s",
+		"invalid %sSecretVersion.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateGitReplyValidationError{}
+var _ error = SecretVersionValidationError{}
 
 var _ interface {
 	Field() string
@@ -692,46 +612,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateGitReplyValidationError{}
+} = SecretVersionValidationError{}
 
-// Validate checks the field values on DeleteGitReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on GitKVs with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *DeleteGitReply) Validate() error {
+func (m *GitKVs) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteGitReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DeleteGitReplyMultiError,
-// or nil if none found.
-func (m *DeleteGitReply) ValidateAll() error {
+// ValidateAll checks the field values on GitKVs with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in GitKVsMultiError, or nil if none found.
+func (m *GitKVs) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteGitReply) validate(all bool) error {
+func (m *GitKVs) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Msg
+	// no validation rules for DeployKey
+
+	// no validation rules for AccessToken
+
+	// no validation rules for Additionals
 
 	if len(errors) > 0 {
-		return DeleteGitReplyMultiError(errors)
+		return GitKVsMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteGitReplyMultiError is an error wrapping multiple validation errors
-// returned by DeleteGitReply.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteGitReplyMultiError []error
+// GitKVsMultiError is an error wrapping multiple validation errors returned by
+// GitKVs.ValidateAll() if the designated constraints aren't met.
+type GitKVsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteGitReplyMultiError) Error() string {
+func (m GitKVsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -740,11 +662,11 @@ func (m DeleteGitReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteGitReplyMultiError) AllErrors() []error { return m }
+func (m GitKVsMultiError) AllErrors() []error { return m }
 
-// DeleteGitReplyValidationError is the validation error returned by
-// DeleteGitReply.Validate if the designated constraints aren't met.
-type DeleteGitReplyValidationError struct {
+// GitKVsValidationError is the validation error returned by GitKVs.Validate if
+// the designated constraints aren't met.
+type GitKVsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -752,22 +674,22 @@ type DeleteGitReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteGitReplyValidationError) Field() string { return e.field }
+func (e GitKVsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteGitReplyValidationError) Reason() string { return e.reason }
+func (e GitKVsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteGitReplyValidationError) Cause() error { return e.cause }
+func (e GitKVsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteGitReplyValidationError) Key() bool { return e.key }
+func (e GitKVsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteGitReplyValidationError) ErrorName() string { return "DeleteGitReplyValidationError" }
+func (e GitKVsValidationError) ErrorName() string { return "GitKVsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteGitReplyValidationError) Error() string {
+func (e GitKVsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -779,14 +701,14 @@ func (e DeleteGitReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteGitReply.%s: %s%s",
+		"invalid %sGitKVs.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteGitReplyValidationError{}
+var _ error = GitKVsValidationError{}
 
 var _ interface {
 	Field() string
@@ -794,51 +716,86 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteGitReplyValidationError{}
+} = GitKVsValidationError{}
 
-// Validate checks the field values on PkiRequest with the rules defined in the
+// Validate checks the field values on GitMeta with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *PkiRequest) Validate() error {
+func (m *GitMeta) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PkiRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PkiRequestMultiError, or
-// nil if none found.
-func (m *PkiRequest) ValidateAll() error {
+// ValidateAll checks the field values on GitMeta with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in GitMetaMultiError, or nil if none found.
+func (m *GitMeta) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PkiRequest) validate(all bool) error {
+func (m *GitMeta) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Domain
+	if utf8.RuneCountInString(m.GetProviderType()) < 1 {
+		err := GitMetaValidationError{
+			field:  "ProviderType",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Cacert
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := GitMetaValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Cert
+	if utf8.RuneCountInString(m.GetUsername()) < 1 {
+		err := GitMetaValidationError{
+			field:  "Username",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Key
+	if utf8.RuneCountInString(m.GetPermission()) < 1 {
+		err := GitMetaValidationError{
+			field:  "Permission",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return PkiRequestMultiError(errors)
+		return GitMetaMultiError(errors)
 	}
 
 	return nil
 }
 
-// PkiRequestMultiError is an error wrapping multiple validation errors
-// returned by PkiRequest.ValidateAll() if the designated constraints aren't met.
-type PkiRequestMultiError []error
+// GitMetaMultiError is an error wrapping multiple validation errors returned
+// by GitMeta.ValidateAll() if the designated constraints aren't met.
+type GitMetaMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PkiRequestMultiError) Error() string {
+func (m GitMetaMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -847,11 +804,11 @@ func (m PkiRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PkiRequestMultiError) AllErrors() []error { return m }
+func (m GitMetaMultiError) AllErrors() []error { return m }
 
-// PkiRequestValidationError is the validation error returned by
-// PkiRequest.Validate if the designated constraints aren't met.
-type PkiRequestValidationError struct {
+// GitMetaValidationError is the validation error returned by GitMeta.Validate
+// if the designated constraints aren't met.
+type GitMetaValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -859,22 +816,22 @@ type PkiRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PkiRequestValidationError) Field() string { return e.field }
+func (e GitMetaValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PkiRequestValidationError) Reason() string { return e.reason }
+func (e GitMetaValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PkiRequestValidationError) Cause() error { return e.cause }
+func (e GitMetaValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PkiRequestValidationError) Key() bool { return e.key }
+func (e GitMetaValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PkiRequestValidationError) ErrorName() string { return "PkiRequestValidationError" }
+func (e GitMetaValidationError) ErrorName() string { return "GitMetaValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PkiRequestValidationError) Error() string {
+func (e GitMetaValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -886,14 +843,14 @@ func (e PkiRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPkiRequest.%s: %s%s",
+		"invalid %sGitMeta.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PkiRequestValidationError{}
+var _ error = GitMetaValidationError{}
 
 var _ interface {
 	Field() string
@@ -901,73 +858,155 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PkiRequestValidationError{}
+} = GitMetaValidationError{}
 
-// Validate checks the field values on CreatePkiReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CreatePkiReply) Validate() error {
+// Validate checks the field values on GitRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GitRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreatePkiReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CreatePkiReplyMultiError,
-// or nil if none found.
-func (m *CreatePkiReply) ValidateAll() error {
+// ValidateAll checks the field values on GitRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GitRequestMultiError, or
+// nil if none found.
+func (m *GitRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreatePkiReply) validate(all bool) error {
+func (m *GitRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if m.GetMeta() == nil {
+		err := GitRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if all {
-		switch v := interface{}(m.GetSecret()).(type) {
+		switch v := interface{}(m.GetMeta()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreatePkiReplyValidationError{
-					field:  "Secret",
+				errors = append(errors, GitRequestValidationError{
+					field:  "Meta",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreatePkiReplyValidationError{
-					field:  "Secret",
+				errors = append(errors, GitRequestValidationError{
+					field:  "Meta",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreatePkiReplyValidationError{
-				field:  "Secret",
+			return GitRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetKvs() == nil {
+		err := GitRequestValidationError{
+			field:  "Kvs",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetKvs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GitRequestValidationError{
+					field:  "Kvs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GitRequestValidationError{
+					field:  "Kvs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKvs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GitRequestValidationError{
+				field:  "Kvs",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for GenerateDeployKey
+
+	if _, ok := _GitRequest_DeployKeyType_InLookup[m.GetDeployKeyType()]; !ok {
+		err := GitRequestValidationError{
+			field:  "DeployKeyType",
+			reason: "value must be in list [ ed25519 rsa]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Ttl
+
+	// no validation rules for ExpireTime
+
+	if m.ExpectedVersion != nil {
+
+		if m.GetExpectedVersion() < 0 {
+			err := GitRequestValidationError{
+				field:  "ExpectedVersion",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return CreatePkiReplyMultiError(errors)
+		return GitRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreatePkiReplyMultiError is an error wrapping multiple validation errors
-// returned by CreatePkiReply.ValidateAll() if the designated constraints
-// aren't met.
-type CreatePkiReplyMultiError []error
+// GitRequestMultiError is an error wrapping multiple validation errors
+// returned by GitRequest.ValidateAll() if the designated constraints aren't met.
+type GitRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreatePkiReplyMultiError) Error() string {
+func (m GitRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -976,11 +1015,11 @@ func (m CreatePkiReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreatePkiReplyMultiError) AllErrors() []error { return m }
+func (m GitRequestMultiError) AllErrors() []error { return m }
 
-// CreatePkiReplyValidationError is the validation error returned by
-// CreatePkiReply.Validate if the designated constraints aren't met.
-type CreatePkiReplyValidationError struct {
+// GitRequestValidationError is the validation error returned by
+// GitRequest.Validate if the designated constraints aren't met.
+type GitRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -988,22 +1027,22 @@ type CreatePkiReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreatePkiReplyValidationError) Field() string { return e.field }
+func (e GitRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreatePkiReplyValidationError) Reason() string { return e.reason }
+func (e GitRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreatePkiReplyValidationError) Cause() error { return e.cause }
+func (e GitRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreatePkiReplyValidationError) Key() bool { return e.key }
+func (e GitRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreatePkiReplyValidationError) ErrorName() string { return "CreatePkiReplyValidationError" }
+func (e GitRequestValidationError) ErrorName() string { return "GitRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreatePkiReplyValidationError) Error() string {
+func (e GitRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1015,14 +1054,14 @@ func (e CreatePkiReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreatePkiReply.%s: %s%s",
+		"invalid %sGitRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreatePkiReplyValidationError{}
+var _ error = GitRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1030,46 +1069,110 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreatePkiReplyValidationError{}
+} = GitRequestValidationError{}
 
-// Validate checks the field values on DeletePkiReply with the rules defined in
+var _GitRequest_DeployKeyType_InLookup = map[string]struct{}{
+	"":        {},
+	"ed25519": {},
+	"rsa":     {},
+}
+
+// Validate checks the field values on CreateGitReply with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *DeletePkiReply) Validate() error {
+func (m *CreateGitReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeletePkiReply with the rules defined
+// ValidateAll checks the field values on CreateGitReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DeletePkiReplyMultiError,
+// result is a list of violation errors wrapped in CreateGitReplyMultiError,
 // or nil if none found.
-func (m *DeletePkiReply) ValidateAll() error {
+func (m *CreateGitReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeletePkiReply) validate(all bool) error {
+func (m *CreateGitReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Msg
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateGitReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateGitReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateGitReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PublicKey
+
+	if all {
+		switch v := interface{}(m.GetDryRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateGitReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateGitReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDryRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateGitReplyValidationError{
+				field:  "DryRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeletePkiReplyMultiError(errors)
+		return CreateGitReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeletePkiReplyMultiError is an error wrapping multiple validation errors
-// returned by DeletePkiReply.ValidateAll() if the designated constraints
+// CreateGitReplyMultiError is an error wrapping multiple validation errors
+// returned by CreateGitReply.ValidateAll() if the designated constraints
 // aren't met.
-type DeletePkiReplyMultiError []error
+type CreateGitReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeletePkiReplyMultiError) Error() string {
+func (m CreateGitReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1078,11 +1181,11 @@ func (m DeletePkiReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeletePkiReplyMultiError) AllErrors() []error { return m }
+func (m CreateGitReplyMultiError) AllErrors() []error { return m }
 
-// DeletePkiReplyValidationError is the validation error returned by
-// DeletePkiReply.Validate if the designated constraints aren't met.
-type DeletePkiReplyValidationError struct {
+// CreateGitReplyValidationError is the validation error returned by
+// CreateGitReply.Validate if the designated constraints aren't met.
+type CreateGitReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1090,22 +1193,22 @@ type DeletePkiReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeletePkiReplyValidationError) Field() string { return e.field }
+func (e CreateGitReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeletePkiReplyValidationError) Reason() string { return e.reason }
+func (e CreateGitReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeletePkiReplyValidationError) Cause() error { return e.cause }
+func (e CreateGitReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeletePkiReplyValidationError) Key() bool { return e.key }
+func (e CreateGitReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeletePkiReplyValidationError) ErrorName() string { return "DeletePkiReplyValidationError" }
+func (e CreateGitReplyValidationError) ErrorName() string { return "CreateGitReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeletePkiReplyValidationError) Error() string {
+func (e CreateGitReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1117,14 +1220,14 @@ func (e DeletePkiReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeletePkiReply.%s: %s%s",
+		"invalid %sCreateGitReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeletePkiReplyValidationError{}
+var _ error = CreateGitReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1132,105 +1235,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeletePkiReplyValidationError{}
+} = CreateGitReplyValidationError{}
 
-// Validate checks the field values on RepoAccount with the rules defined in
+// Validate checks the field values on DeleteGitReply with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *RepoAccount) Validate() error {
+func (m *DeleteGitReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RepoAccount with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in RepoAccountMultiError, or
-// nil if none found.
-func (m *RepoAccount) ValidateAll() error {
-	return m.validate(true)
+// ValidateAll checks the field values on DeleteGitReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeleteGitReplyMultiError,
+// or nil if none found.
+func (m *DeleteGitReply) ValidateAll() error {
+	return m.validate(true)
 }
 
-func (m *RepoAccount) validate(all bool) error {
+func (m *DeleteGitReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AuthType
-
-	if all {
-		switch v := interface{}(m.GetToken()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RepoAccountValidationError{
-					field:  "Token",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, RepoAccountValidationError{
-					field:  "Token",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetToken()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RepoAccountValidationError{
-				field:  "Token",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Msg
 
 	if all {
-		switch v := interface{}(m.GetAccount()).(type) {
+		switch v := interface{}(m.GetDryRun()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RepoAccountValidationError{
-					field:  "Account",
+				errors = append(errors, DeleteGitReplyValidationError{
+					field:  "DryRun",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RepoAccountValidationError{
-					field:  "Account",
+				errors = append(errors, DeleteGitReplyValidationError{
+					field:  "DryRun",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDryRun()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RepoAccountValidationError{
-				field:  "Account",
+			return DeleteGitReplyValidationError{
+				field:  "DryRun",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for Additionals
-
 	if len(errors) > 0 {
-		return RepoAccountMultiError(errors)
+		return DeleteGitReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// RepoAccountMultiError is an error wrapping multiple validation errors
-// returned by RepoAccount.ValidateAll() if the designated constraints aren't met.
-type RepoAccountMultiError []error
+// DeleteGitReplyMultiError is an error wrapping multiple validation errors
+// returned by DeleteGitReply.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteGitReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RepoAccountMultiError) Error() string {
+func (m DeleteGitReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1239,11 +1312,11 @@ func (m RepoAccountMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RepoAccountMultiError) AllErrors() []error { return m }
+func (m DeleteGitReplyMultiError) AllErrors() []error { return m }
 
-// RepoAccountValidationError is the validation error returned by
-// RepoAccount.Validate if the designated constraints aren't met.
-type RepoAccountValidationError struct {
+// DeleteGitReplyValidationError is the validation error returned by
+// DeleteGitReply.Validate if the designated constraints aren't met.
+type DeleteGitReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1251,22 +1324,22 @@ type RepoAccountValidationError struct {
 }
 
 // Field function returns field value.
-func (e RepoAccountValidationError) Field() string { return e.field }
+func (e DeleteGitReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RepoAccountValidationError) Reason() string { return e.reason }
+func (e DeleteGitReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RepoAccountValidationError) Cause() error { return e.cause }
+func (e DeleteGitReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RepoAccountValidationError) Key() bool { return e.key }
+func (e DeleteGitReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RepoAccountValidationError) ErrorName() string { return "RepoAccountValidationError" }
+func (e DeleteGitReplyValidationError) ErrorName() string { return "DeleteGitReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RepoAccountValidationError) Error() string {
+func (e DeleteGitReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1278,14 +1351,14 @@ func (e RepoAccountValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRepoAccount.%s: %s%s",
+		"invalid %sDeleteGitReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RepoAccountValidationError{}
+var _ error = DeleteGitReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1293,33 +1366,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RepoAccountValidationError{}
+} = DeleteGitReplyValidationError{}
 
-// Validate checks the field values on Token with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on GetGitRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Token) Validate() error {
+func (m *GetGitRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Token with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in TokenMultiError, or nil if none found.
-func (m *Token) ValidateAll() error {
+// ValidateAll checks the field values on GetGitRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetGitRequestMultiError, or
+// nil if none found.
+func (m *GetGitRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Token) validate(all bool) error {
+func (m *GetGitRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetToken()) < 1 {
-		err := TokenValidationError{
-			field:  "Token",
-			reason: "value length must be at least 1 runes",
+	if m.GetMeta() == nil {
+		err := GetGitRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -1327,19 +1401,49 @@ func (m *Token) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetGitRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetGitRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetGitRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return TokenMultiError(errors)
+		return GetGitRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TokenMultiError is an error wrapping multiple validation errors returned by
-// Token.ValidateAll() if the designated constraints aren't met.
-type TokenMultiError []error
+// GetGitRequestMultiError is an error wrapping multiple validation errors
+// returned by GetGitRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetGitRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TokenMultiError) Error() string {
+func (m GetGitRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1348,11 +1452,11 @@ func (m TokenMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TokenMultiError) AllErrors() []error { return m }
+func (m GetGitRequestMultiError) AllErrors() []error { return m }
 
-// TokenValidationError is the validation error returned by Token.Validate if
-// the designated constraints aren't met.
-type TokenValidationError struct {
+// GetGitRequestValidationError is the validation error returned by
+// GetGitRequest.Validate if the designated constraints aren't met.
+type GetGitRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1360,22 +1464,22 @@ type TokenValidationError struct {
 }
 
 // Field function returns field value.
-func (e TokenValidationError) Field() string { return e.field }
+func (e GetGitRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TokenValidationError) Reason() string { return e.reason }
+func (e GetGitRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TokenValidationError) Cause() error { return e.cause }
+func (e GetGitRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TokenValidationError) Key() bool { return e.key }
+func (e GetGitRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TokenValidationError) ErrorName() string { return "TokenValidationError" }
+func (e GetGitRequestValidationError) ErrorName() string { return "GetGitRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TokenValidationError) Error() string {
+func (e GetGitRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1387,14 +1491,14 @@ func (e TokenValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sToken.%s: %s%s",
+		"invalid %sGetGitRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TokenValidationError{}
+var _ error = GetGitRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1402,64 +1506,101 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TokenValidationError{}
+} = GetGitRequestValidationError{}
 
-// Validate checks the field values on Account with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on GetGitReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Account) Validate() error {
+func (m *GetGitReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Account with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in AccountMultiError, or nil if none found.
-func (m *Account) ValidateAll() error {
+// ValidateAll checks the field values on GetGitReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetGitReplyMultiError, or
+// nil if none found.
+func (m *GetGitReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Account) validate(all bool) error {
+func (m *GetGitReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetUsername()) < 1 {
-		err := AccountValidationError{
-			field:  "Username",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetGitReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetGitReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetGitReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetPassword()) < 1 {
-		err := AccountValidationError{
-			field:  "Password",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetGitReplyValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetGitReplyValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetGitReplyValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return AccountMultiError(errors)
+		return GetGitReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AccountMultiError is an error wrapping multiple validation errors returned
-// by Account.ValidateAll() if the designated constraints aren't met.
-type AccountMultiError []error
+// GetGitReplyMultiError is an error wrapping multiple validation errors
+// returned by GetGitReply.ValidateAll() if the designated constraints aren't met.
+type GetGitReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AccountMultiError) Error() string {
+func (m GetGitReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1468,11 +1609,11 @@ func (m AccountMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AccountMultiError) AllErrors() []error { return m }
+func (m GetGitReplyMultiError) AllErrors() []error { return m }
 
-// AccountValidationError is the validation error returned by Account.Validate
-// if the designated constraints aren't met.
-type AccountValidationError struct {
+// GetGitReplyValidationError is the validation error returned by
+// GetGitReply.Validate if the designated constraints aren't met.
+type GetGitReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1480,22 +1621,22 @@ type AccountValidationError struct {
 }
 
 // Field function returns field value.
-func (e AccountValidationError) Field() string { return e.field }
+func (e GetGitReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AccountValidationError) Reason() string { return e.reason }
+func (e GetGitReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AccountValidationError) Cause() error { return e.cause }
+func (e GetGitReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AccountValidationError) Key() bool { return e.key }
+func (e GetGitReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AccountValidationError) ErrorName() string { return "AccountValidationError" }
+func (e GetGitReplyValidationError) ErrorName() string { return "GetGitReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AccountValidationError) Error() string {
+func (e GetGitReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1507,14 +1648,14 @@ func (e AccountValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAccount.%s: %s%s",
+		"invalid %sGetGitReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AccountValidationError{}
+var _ error = GetGitReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1522,34 +1663,42 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AccountValidationError{}
+} = GetGitReplyValidationError{}
 
-// Validate checks the field values on RepoMeta with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on ListGitRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *RepoMeta) Validate() error {
+func (m *ListGitRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RepoMeta with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in RepoMetaMultiError, or nil
-// if none found.
-func (m *RepoMeta) ValidateAll() error {
+// ValidateAll checks the field values on ListGitRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListGitRequestMultiError,
+// or nil if none found.
+func (m *ListGitRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RepoMeta) validate(all bool) error {
+func (m *ListGitRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetProviderId()) < 1 {
-		err := RepoMetaValidationError{
-			field:  "ProviderId",
-			reason: "value length must be at least 1 runes",
+	// no validation rules for ProviderType
+
+	// no validation rules for Id
+
+	// no validation rules for Username
+
+	// no validation rules for Permission
+
+	if val := m.GetPageSize(); val < 0 || val > 1000 {
+		err := ListGitRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 1000]",
 		}
 		if !all {
 			return err
@@ -1557,32 +1706,22 @@ func (m *RepoMeta) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetProduct()) < 1 {
-		err := RepoMetaValidationError{
-			field:  "Product",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Project
+	// no validation rules for PageToken
 
 	if len(errors) > 0 {
-		return RepoMetaMultiError(errors)
+		return ListGitRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RepoMetaMultiError is an error wrapping multiple validation errors returned
-// by RepoMeta.ValidateAll() if the designated constraints aren't met.
-type RepoMetaMultiError []error
+// ListGitRequestMultiError is an error wrapping multiple validation errors
+// returned by ListGitRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListGitRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RepoMetaMultiError) Error() string {
+func (m ListGitRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1591,11 +1730,11 @@ func (m RepoMetaMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RepoMetaMultiError) AllErrors() []error { return m }
+func (m ListGitRequestMultiError) AllErrors() []error { return m }
 
-// RepoMetaValidationError is the validation error returned by
-// RepoMeta.Validate if the designated constraints aren't met.
-type RepoMetaValidationError struct {
+// ListGitRequestValidationError is the validation error returned by
+// ListGitRequest.Validate if the designated constraints aren't met.
+type ListGitRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1603,22 +1742,22 @@ type RepoMetaValidationError struct {
 }
 
 // Field function returns field value.
-func (e RepoMetaValidationError) Field() string { return e.field }
+func (e ListGitRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RepoMetaValidationError) Reason() string { return e.reason }
+func (e ListGitRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RepoMetaValidationError) Cause() error { return e.cause }
+func (e ListGitRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RepoMetaValidationError) Key() bool { return e.key }
+func (e ListGitRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RepoMetaValidationError) ErrorName() string { return "RepoMetaValidationError" }
+func (e ListGitRequestValidationError) ErrorName() string { return "ListGitRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RepoMetaValidationError) Error() string {
+func (e ListGitRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1630,14 +1769,14 @@ func (e RepoMetaValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRepoMeta.%s: %s%s",
+		"invalid %sListGitRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RepoMetaValidationError{}
+var _ error = ListGitRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1645,112 +1784,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RepoMetaValidationError{}
+} = ListGitRequestValidationError{}
 
-// Validate checks the field values on RepoRequest with the rules defined in
+// Validate checks the field values on ListGitReply with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *RepoRequest) Validate() error {
+func (m *ListGitReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RepoRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in RepoRequestMultiError, or
+// ValidateAll checks the field values on ListGitReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListGitReplyMultiError, or
 // nil if none found.
-func (m *RepoRequest) ValidateAll() error {
+func (m *ListGitReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RepoRequest) validate(all bool) error {
+func (m *ListGitReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetMeta() == nil {
-		err := RepoRequestValidationError{
-			field:  "Meta",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetSecrets() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetMeta()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RepoRequestValidationError{
-					field:  "Meta",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListGitReplyValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListGitReplyValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RepoRequestValidationError{
-					field:  "Meta",
+				return ListGitReplyValidationError{
+					field:  fmt.Sprintf("Secrets[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RepoRequestValidationError{
-				field:  "Meta",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	if all {
-		switch v := interface{}(m.GetAccount()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RepoRequestValidationError{
-					field:  "Account",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, RepoRequestValidationError{
-					field:  "Account",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RepoRequestValidationError{
-				field:  "Account",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return RepoRequestMultiError(errors)
+		return ListGitReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// RepoRequestMultiError is an error wrapping multiple validation errors
-// returned by RepoRequest.ValidateAll() if the designated constraints aren't met.
-type RepoRequestMultiError []error
+// ListGitReplyMultiError is an error wrapping multiple validation errors
+// returned by ListGitReply.ValidateAll() if the designated constraints aren't met.
+type ListGitReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RepoRequestMultiError) Error() string {
+func (m ListGitReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1759,11 +1865,11 @@ func (m RepoRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RepoRequestMultiError) AllErrors() []error { return m }
+func (m ListGitReplyMultiError) AllErrors() []error { return m }
 
-// RepoRequestValidationError is the validation error returned by
-// RepoRequest.Validate if the designated constraints aren't met.
-type RepoRequestValidationError struct {
+// ListGitReplyValidationError is the validation error returned by
+// ListGitReply.Validate if the designated constraints aren't met.
+type ListGitReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1771,22 +1877,22 @@ type RepoRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RepoRequestValidationError) Field() string { return e.field }
+func (e ListGitReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RepoRequestValidationError) Reason() string { return e.reason }
+func (e ListGitReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RepoRequestValidationError) Cause() error { return e.cause }
+func (e ListGitReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RepoRequestValidationError) Key() bool { return e.key }
+func (e ListGitReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RepoRequestValidationError) ErrorName() string { return "RepoRequestValidationError" }
+func (e ListGitReplyValidationError) ErrorName() string { return "ListGitReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RepoRequestValidationError) Error() string {
+func (e ListGitReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1798,14 +1904,14 @@ func (e RepoRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRepoRequest.%s: %s%s",
+		"invalid %sListGitReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RepoRequestValidationError{}
+var _ error = ListGitReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1813,73 +1919,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RepoRequestValidationError{}
+} = ListGitReplyValidationError{}
 
-// Validate checks the field values on CreateRepoReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CreateRepoReply) Validate() error {
+// Validate checks the field values on ListGitVersionsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListGitVersionsReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateRepoReply with the rules
+// ValidateAll checks the field values on ListGitVersionsReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateRepoReplyMultiError, or nil if none found.
-func (m *CreateRepoReply) ValidateAll() error {
+// ListGitVersionsReplyMultiError, or nil if none found.
+func (m *ListGitVersionsReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateRepoReply) validate(all bool) error {
+func (m *ListGitVersionsReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSecret()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateRepoReplyValidationError{
-					field:  "Secret",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListGitVersionsReplyValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListGitVersionsReplyValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateRepoReplyValidationError{
-					field:  "Secret",
+				return ListGitVersionsReplyValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateRepoReplyValidationError{
-				field:  "Secret",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return CreateRepoReplyMultiError(errors)
+		return ListGitVersionsReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateRepoReplyMultiError is an error wrapping multiple validation errors
-// returned by CreateRepoReply.ValidateAll() if the designated constraints
-// aren't met.
-type CreateRepoReplyMultiError []error
+// ListGitVersionsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListGitVersionsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListGitVersionsReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateRepoReplyMultiError) Error() string {
+func (m ListGitVersionsReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1888,11 +1999,11 @@ func (m CreateRepoReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateRepoReplyMultiError) AllErrors() []error { return m }
+func (m ListGitVersionsReplyMultiError) AllErrors() []error { return m }
 
-// CreateRepoReplyValidationError is the validation error returned by
-// CreateRepoReply.Validate if the designated constraints aren't met.
-type CreateRepoReplyValidationError struct {
+// ListGitVersionsReplyValidationError is the validation error returned by
+// ListGitVersionsReply.Validate if the designated constraints aren't met.
+type ListGitVersionsReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1900,22 +2011,24 @@ type CreateRepoReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateRepoReplyValidationError) Field() string { return e.field }
+func (e ListGitVersionsReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateRepoReplyValidationError) Reason() string { return e.reason }
+func (e ListGitVersionsReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateRepoReplyValidationError) Cause() error { return e.cause }
+func (e ListGitVersionsReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateRepoReplyValidationError) Key() bool { return e.key }
+func (e ListGitVersionsReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateRepoReplyValidationError) ErrorName() string { return "CreateRepoReplyValidationError" }
+func (e ListGitVersionsReplyValidationError) ErrorName() string {
+	return "ListGitVersionsReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CreateRepoReplyValidationError) Error() string {
+func (e ListGitVersionsReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1927,14 +2040,14 @@ func (e CreateRepoReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateRepoReply.%s: %s%s",
+		"invalid %sListGitVersionsReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateRepoReplyValidationError{}
+var _ error = ListGitVersionsReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1942,46 +2055,95 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateRepoReplyValidationError{}
+} = ListGitVersionsReplyValidationError{}
 
-// Validate checks the field values on DeleteRepoReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DeleteRepoReply) Validate() error {
+// Validate checks the field values on RollbackGitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackGitRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteRepoReply with the rules
+// ValidateAll checks the field values on RollbackGitRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteRepoReplyMultiError, or nil if none found.
-func (m *DeleteRepoReply) ValidateAll() error {
+// RollbackGitRequestMultiError, or nil if none found.
+func (m *RollbackGitRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteRepoReply) validate(all bool) error {
+func (m *RollbackGitRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Msg
+	if m.GetMeta() == nil {
+		err := RollbackGitRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RollbackGitRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RollbackGitRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RollbackGitRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetVersion() <= 0 {
+		err := RollbackGitRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return DeleteRepoReplyMultiError(errors)
+		return RollbackGitRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteRepoReplyMultiError is an error wrapping multiple validation errors
-// returned by DeleteRepoReply.ValidateAll() if the designated constraints
+// RollbackGitRequestMultiError is an error wrapping multiple validation errors
+// returned by RollbackGitRequest.ValidateAll() if the designated constraints
 // aren't met.
-type DeleteRepoReplyMultiError []error
+type RollbackGitRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteRepoReplyMultiError) Error() string {
+func (m RollbackGitRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1990,11 +2152,11 @@ func (m DeleteRepoReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteRepoReplyMultiError) AllErrors() []error { return m }
+func (m RollbackGitRequestMultiError) AllErrors() []error { return m }
 
-// DeleteRepoReplyValidationError is the validation error returned by
-// DeleteRepoReply.Validate if the designated constraints aren't met.
-type DeleteRepoReplyValidationError struct {
+// RollbackGitRequestValidationError is the validation error returned by
+// RollbackGitRequest.Validate if the designated constraints aren't met.
+type RollbackGitRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2002,22 +2164,24 @@ type DeleteRepoReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteRepoReplyValidationError) Field() string { return e.field }
+func (e RollbackGitRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteRepoReplyValidationError) Reason() string { return e.reason }
+func (e RollbackGitRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteRepoReplyValidationError) Cause() error { return e.cause }
+func (e RollbackGitRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteRepoReplyValidationError) Key() bool { return e.key }
+func (e RollbackGitRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteRepoReplyValidationError) ErrorName() string { return "DeleteRepoReplyValidationError" }
+func (e RollbackGitRequestValidationError) ErrorName() string {
+	return "RollbackGitRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DeleteRepoReplyValidationError) Error() string {
+func (e RollbackGitRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2029,14 +2193,14 @@ func (e DeleteRepoReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteRepoReply.%s: %s%s",
+		"invalid %sRollbackGitRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteRepoReplyValidationError{}
+var _ error = RollbackGitRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2044,66 +2208,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteRepoReplyValidationError{}
+} = RollbackGitRequestValidationError{}
 
-// Validate checks the field values on TenantGitMeta with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *TenantGitMeta) Validate() error {
+// Validate checks the field values on RollbackGitReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RollbackGitReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantGitMeta with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TenantGitMetaMultiError, or
-// nil if none found.
-func (m *TenantGitMeta) ValidateAll() error {
+// ValidateAll checks the field values on RollbackGitReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackGitReplyMultiError, or nil if none found.
+func (m *RollbackGitReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantGitMeta) validate(all bool) error {
+func (m *RollbackGitReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetId()) < 1 {
-		err := TenantGitMetaValidationError{
-			field:  "Id",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetPermission()) < 1 {
-		err := TenantGitMetaValidationError{
-			field:  "Permission",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RollbackGitReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RollbackGitReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RollbackGitReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return TenantGitMetaMultiError(errors)
+		return RollbackGitReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantGitMetaMultiError is an error wrapping multiple validation errors
-// returned by TenantGitMeta.ValidateAll() if the designated constraints
+// RollbackGitReplyMultiError is an error wrapping multiple validation errors
+// returned by RollbackGitReply.ValidateAll() if the designated constraints
 // aren't met.
-type TenantGitMetaMultiError []error
+type RollbackGitReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantGitMetaMultiError) Error() string {
+func (m RollbackGitReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2112,11 +2283,11 @@ func (m TenantGitMetaMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantGitMetaMultiError) AllErrors() []error { return m }
+func (m RollbackGitReplyMultiError) AllErrors() []error { return m }
 
-// TenantGitMetaValidationError is the validation error returned by
-// TenantGitMeta.Validate if the designated constraints aren't met.
-type TenantGitMetaValidationError struct {
+// RollbackGitReplyValidationError is the validation error returned by
+// RollbackGitReply.Validate if the designated constraints aren't met.
+type RollbackGitReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2124,22 +2295,22 @@ type TenantGitMetaValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantGitMetaValidationError) Field() string { return e.field }
+func (e RollbackGitReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantGitMetaValidationError) Reason() string { return e.reason }
+func (e RollbackGitReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantGitMetaValidationError) Cause() error { return e.cause }
+func (e RollbackGitReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantGitMetaValidationError) Key() bool { return e.key }
+func (e RollbackGitReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantGitMetaValidationError) ErrorName() string { return "TenantGitMetaValidationError" }
+func (e RollbackGitReplyValidationError) ErrorName() string { return "RollbackGitReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TenantGitMetaValidationError) Error() string {
+func (e RollbackGitReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2151,14 +2322,14 @@ func (e TenantGitMetaValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantGitMeta.%s: %s%s",
+		"invalid %sRollbackGitReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantGitMetaValidationError{}
+var _ error = RollbackGitReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2166,24 +2337,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantGitMetaValidationError{}
+} = RollbackGitReplyValidationError{}
 
-// Validate checks the field values on TenantGitRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TenantGitRequest) Validate() error {
+// Validate checks the field values on UndeleteGitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UndeleteGitRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantGitRequest with the rules
+// ValidateAll checks the field values on UndeleteGitRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantGitRequestMultiError, or nil if none found.
-func (m *TenantGitRequest) ValidateAll() error {
+// UndeleteGitRequestMultiError, or nil if none found.
+func (m *UndeleteGitRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantGitRequest) validate(all bool) error {
+func (m *UndeleteGitRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2191,7 +2362,7 @@ func (m *TenantGitRequest) validate(all bool) error {
 	var errors []error
 
 	if m.GetMeta() == nil {
-		err := TenantGitRequestValidationError{
+		err := UndeleteGitRequestValidationError{
 			field:  "Meta",
 			reason: "value is required",
 		}
@@ -2205,7 +2376,7 @@ func (m *TenantGitRequest) validate(all bool) error {
 		switch v := interface{}(m.GetMeta()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TenantGitRequestValidationError{
+				errors = append(errors, UndeleteGitRequestValidationError{
 					field:  "Meta",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2213,7 +2384,7 @@ func (m *TenantGitRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TenantGitRequestValidationError{
+				errors = append(errors, UndeleteGitRequestValidationError{
 					field:  "Meta",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2222,7 +2393,7 @@ func (m *TenantGitRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return TenantGitRequestValidationError{
+			return UndeleteGitRequestValidationError{
 				field:  "Meta",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2230,60 +2401,20 @@ func (m *TenantGitRequest) validate(all bool) error {
 		}
 	}
 
-	if m.GetKvs() == nil {
-		err := TenantGitRequestValidationError{
-			field:  "Kvs",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetKvs()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TenantGitRequestValidationError{
-					field:  "Kvs",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TenantGitRequestValidationError{
-					field:  "Kvs",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetKvs()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TenantGitRequestValidationError{
-				field:  "Kvs",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return TenantGitRequestMultiError(errors)
+		return UndeleteGitRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantGitRequestMultiError is an error wrapping multiple validation errors
-// returned by TenantGitRequest.ValidateAll() if the designated constraints
+// UndeleteGitRequestMultiError is an error wrapping multiple validation errors
+// returned by UndeleteGitRequest.ValidateAll() if the designated constraints
 // aren't met.
-type TenantGitRequestMultiError []error
+type UndeleteGitRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantGitRequestMultiError) Error() string {
+func (m UndeleteGitRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2292,11 +2423,11 @@ func (m TenantGitRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantGitRequestMultiError) AllErrors() []error { return m }
+func (m UndeleteGitRequestMultiError) AllErrors() []error { return m }
 
-// TenantGitRequestValidationError is the validation error returned by
-// TenantGitRequest.Validate if the designated constraints aren't met.
-type TenantGitRequestValidationError struct {
+// UndeleteGitRequestValidationError is the validation error returned by
+// UndeleteGitRequest.Validate if the designated constraints aren't met.
+type UndeleteGitRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2304,22 +2435,24 @@ type TenantGitRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantGitRequestValidationError) Field() string { return e.field }
+func (e UndeleteGitRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantGitRequestValidationError) Reason() string { return e.reason }
+func (e UndeleteGitRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantGitRequestValidationError) Cause() error { return e.cause }
+func (e UndeleteGitRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantGitRequestValidationError) Key() bool { return e.key }
+func (e UndeleteGitRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantGitRequestValidationError) ErrorName() string { return "TenantGitRequestValidationError" }
+func (e UndeleteGitRequestValidationError) ErrorName() string {
+	return "UndeleteGitRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TenantGitRequestValidationError) Error() string {
+func (e UndeleteGitRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2331,14 +2464,14 @@ func (e TenantGitRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantGitRequest.%s: %s%s",
+		"invalid %sUndeleteGitRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantGitRequestValidationError{}
+var _ error = UndeleteGitRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2346,24 +2479,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantGitRequestValidationError{}
+} = UndeleteGitRequestValidationError{}
 
-// Validate checks the field values on CreateTenantGitReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateTenantGitReply) Validate() error {
+// Validate checks the field values on UndeleteGitReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UndeleteGitReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateTenantGitReply with the rules
+// ValidateAll checks the field values on UndeleteGitReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateTenantGitReplyMultiError, or nil if none found.
-func (m *CreateTenantGitReply) ValidateAll() error {
+// UndeleteGitReplyMultiError, or nil if none found.
+func (m *UndeleteGitReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateTenantGitReply) validate(all bool) error {
+func (m *UndeleteGitReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2374,7 +2507,7 @@ func (m *CreateTenantGitReply) validate(all bool) error {
 		switch v := interface{}(m.GetSecret()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateTenantGitReplyValidationError{
+				errors = append(errors, UndeleteGitReplyValidationError{
 					field:  "Secret",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2382,7 +2515,7 @@ func (m *CreateTenantGitReply) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateTenantGitReplyValidationError{
+				errors = append(errors, UndeleteGitReplyValidationError{
 					field:  "Secret",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2391,7 +2524,7 @@ func (m *CreateTenantGitReply) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateTenantGitReplyValidationError{
+			return UndeleteGitReplyValidationError{
 				field:  "Secret",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2400,19 +2533,19 @@ func (m *CreateTenantGitReply) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateTenantGitReplyMultiError(errors)
+		return UndeleteGitReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateTenantGitReplyMultiError is an error wrapping multiple validation
-// errors returned by CreateTenantGitReply.ValidateAll() if the designated
-// constraints aren't met.
-type CreateTenantGitReplyMultiError []error
+// UndeleteGitReplyMultiError is an error wrapping multiple validation errors
+// returned by UndeleteGitReply.ValidateAll() if the designated constraints
+// aren't met.
+type UndeleteGitReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateTenantGitReplyMultiError) Error() string {
+func (m UndeleteGitReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2421,11 +2554,11 @@ func (m CreateTenantGitReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateTenantGitReplyMultiError) AllErrors() []error { return m }
+func (m UndeleteGitReplyMultiError) AllErrors() []error { return m }
 
-// CreateTenantGitReplyValidationError is the validation error returned by
-// CreateTenantGitReply.Validate if the designated constraints aren't met.
-type CreateTenantGitReplyValidationError struct {
+// UndeleteGitReplyValidationError is the validation error returned by
+// UndeleteGitReply.Validate if the designated constraints aren't met.
+type UndeleteGitReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2433,24 +2566,22 @@ type CreateTenantGitReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateTenantGitReplyValidationError) Field() string { return e.field }
+func (e UndeleteGitReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateTenantGitReplyValidationError) Reason() string { return e.reason }
+func (e UndeleteGitReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateTenantGitReplyValidationError) Cause() error { return e.cause }
+func (e UndeleteGitReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateTenantGitReplyValidationError) Key() bool { return e.key }
+func (e UndeleteGitReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateTenantGitReplyValidationError) ErrorName() string {
-	return "CreateTenantGitReplyValidationError"
-}
+func (e UndeleteGitReplyValidationError) ErrorName() string { return "UndeleteGitReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateTenantGitReplyValidationError) Error() string {
+func (e UndeleteGitReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2462,14 +2593,14 @@ func (e CreateTenantGitReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateTenantGitReply.%s: %s%s",
+		"invalid %sUndeleteGitReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateTenantGitReplyValidationError{}
+var _ error = UndeleteGitReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2477,46 +2608,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateTenantGitReplyValidationError{}
+} = UndeleteGitReplyValidationError{}
 
-// Validate checks the field values on DeleteTenantGitReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteTenantGitReply) Validate() error {
+// Validate checks the field values on PurgeGitRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PurgeGitRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteTenantGitReply with the rules
+// ValidateAll checks the field values on PurgeGitRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteTenantGitReplyMultiError, or nil if none found.
-func (m *DeleteTenantGitReply) ValidateAll() error {
+// PurgeGitRequestMultiError, or nil if none found.
+func (m *PurgeGitRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteTenantGitReply) validate(all bool) error {
+func (m *PurgeGitRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Msg
+	if m.GetMeta() == nil {
+		err := PurgeGitRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PurgeGitRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PurgeGitRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PurgeGitRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteTenantGitReplyMultiError(errors)
+		return PurgeGitRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteTenantGitReplyMultiError is an error wrapping multiple validation
-// errors returned by DeleteTenantGitReply.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteTenantGitReplyMultiError []error
+// PurgeGitRequestMultiError is an error wrapping multiple validation errors
+// returned by PurgeGitRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PurgeGitRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteTenantGitReplyMultiError) Error() string {
+func (m PurgeGitRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2525,11 +2694,11 @@ func (m DeleteTenantGitReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteTenantGitReplyMultiError) AllErrors() []error { return m }
+func (m PurgeGitRequestMultiError) AllErrors() []error { return m }
 
-// DeleteTenantGitReplyValidationError is the validation error returned by
-// DeleteTenantGitReply.Validate if the designated constraints aren't met.
-type DeleteTenantGitReplyValidationError struct {
+// PurgeGitRequestValidationError is the validation error returned by
+// PurgeGitRequest.Validate if the designated constraints aren't met.
+type PurgeGitRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2537,24 +2706,22 @@ type DeleteTenantGitReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteTenantGitReplyValidationError) Field() string { return e.field }
+func (e PurgeGitRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteTenantGitReplyValidationError) Reason() string { return e.reason }
+func (e PurgeGitRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteTenantGitReplyValidationError) Cause() error { return e.cause }
+func (e PurgeGitRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteTenantGitReplyValidationError) Key() bool { return e.key }
+func (e PurgeGitRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteTenantGitReplyValidationError) ErrorName() string {
-	return "DeleteTenantGitReplyValidationError"
-}
+func (e PurgeGitRequestValidationError) ErrorName() string { return "PurgeGitRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteTenantGitReplyValidationError) Error() string {
+func (e PurgeGitRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2566,14 +2733,14 @@ func (e DeleteTenantGitReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteTenantGitReply.%s: %s%s",
+		"invalid %sPurgeGitRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteTenantGitReplyValidationError{}
+var _ error = PurgeGitRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2581,34 +2748,35 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteTenantGitReplyValidationError{}
+} = PurgeGitRequestValidationError{}
 
-// Validate checks the field values on TenantRepoMeta with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on PkiRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *TenantRepoMeta) Validate() error {
+func (m *PkiRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantRepoMeta with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TenantRepoMetaMultiError,
-// or nil if none found.
-func (m *TenantRepoMeta) ValidateAll() error {
+// ValidateAll checks the field values on PkiRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PkiRequestMultiError, or
+// nil if none found.
+func (m *PkiRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantRepoMeta) validate(all bool) error {
+func (m *PkiRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetId()) < 1 {
-		err := TenantRepoMetaValidationError{
-			field:  "Id",
-			reason: "value length must be at least 1 runes",
+	if err := m._validateHostname(m.GetDomain()); err != nil {
+		err = PkiRequestValidationError{
+			field:  "Domain",
+			reason: "value must be a valid hostname",
+			cause:  err,
 		}
 		if !all {
 			return err
@@ -2616,31 +2784,74 @@ func (m *TenantRepoMeta) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetPermission()) < 1 {
-		err := TenantRepoMetaValidationError{
-			field:  "Permission",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+	// no validation rules for Cacert
+
+	// no validation rules for Cert
+
+	// no validation rules for Key
+
+	// no validation rules for Ttl
+
+	// no validation rules for ExpireTime
+
+	if m.ExpectedVersion != nil {
+
+		if m.GetExpectedVersion() < 0 {
+			err := PkiRequestValidationError{
+				field:  "ExpectedVersion",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+
 	}
 
 	if len(errors) > 0 {
-		return TenantRepoMetaMultiError(errors)
+		return PkiRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantRepoMetaMultiError is an error wrapping multiple validation errors
-// returned by TenantRepoMeta.ValidateAll() if the designated constraints
-// aren't met.
-type TenantRepoMetaMultiError []error
+func (m *PkiRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+// PkiRequestMultiError is an error wrapping multiple validation errors
+// returned by PkiRequest.ValidateAll() if the designated constraints aren't met.
+type PkiRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantRepoMetaMultiError) Error() string {
+func (m PkiRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2649,11 +2860,11 @@ func (m TenantRepoMetaMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantRepoMetaMultiError) AllErrors() []error { return m }
+func (m PkiRequestMultiError) AllErrors() []error { return m }
 
-// TenantRepoMetaValidationError is the validation error returned by
-// TenantRepoMeta.Validate if the designated constraints aren't met.
-type TenantRepoMetaValidationError struct {
+// PkiRequestValidationError is the validation error returned by
+// PkiRequest.Validate if the designated constraints aren't met.
+type PkiRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2661,22 +2872,22 @@ type TenantRepoMetaValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantRepoMetaValidationError) Field() string { return e.field }
+func (e PkiRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantRepoMetaValidationError) Reason() string { return e.reason }
+func (e PkiRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantRepoMetaValidationError) Cause() error { return e.cause }
+func (e PkiRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantRepoMetaValidationError) Key() bool { return e.key }
+func (e PkiRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantRepoMetaValidationError) ErrorName() string { return "TenantRepoMetaValidationError" }
+func (e PkiRequestValidationError) ErrorName() string { return "PkiRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TenantRepoMetaValidationError) Error() string {
+func (e PkiRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2688,14 +2899,14 @@ func (e TenantRepoMetaValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantRepoMeta.%s: %s%s",
+		"invalid %sPkiRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantRepoMetaValidationError{}
+var _ error = PkiRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2703,104 +2914,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantRepoMetaValidationError{}
+} = PkiRequestValidationError{}
 
-// Validate checks the field values on TenantRepoRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TenantRepoRequest) Validate() error {
+// Validate checks the field values on CreatePkiReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CreatePkiReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantRepoRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TenantRepoRequestMultiError, or nil if none found.
-func (m *TenantRepoRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreatePkiReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CreatePkiReplyMultiError,
+// or nil if none found.
+func (m *CreatePkiReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantRepoRequest) validate(all bool) error {
+func (m *CreatePkiReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetMeta() == nil {
-		err := TenantRepoRequestValidationError{
-			field:  "Meta",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if all {
-		switch v := interface{}(m.GetMeta()).(type) {
+		switch v := interface{}(m.GetSecret()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TenantRepoRequestValidationError{
-					field:  "Meta",
+				errors = append(errors, CreatePkiReplyValidationError{
+					field:  "Secret",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TenantRepoRequestValidationError{
-					field:  "Meta",
+				errors = append(errors, CreatePkiReplyValidationError{
+					field:  "Secret",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return TenantRepoRequestValidationError{
-				field:  "Meta",
+			return CreatePkiReplyValidationError{
+				field:  "Secret",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if m.GetAccount() == nil {
-		err := TenantRepoRequestValidationError{
-			field:  "Account",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if all {
-		switch v := interface{}(m.GetAccount()).(type) {
+		switch v := interface{}(m.GetDryRun()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TenantRepoRequestValidationError{
-					field:  "Account",
+				errors = append(errors, CreatePkiReplyValidationError{
+					field:  "DryRun",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TenantRepoRequestValidationError{
-					field:  "Account",
+				errors = append(errors, CreatePkiReplyValidationError{
+					field:  "DryRun",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDryRun()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return TenantRepoRequestValidationError{
-				field:  "Account",
+			return CreatePkiReplyValidationError{
+				field:  "DryRun",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2808,19 +2997,19 @@ func (m *TenantRepoRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return TenantRepoRequestMultiError(errors)
+		return CreatePkiReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantRepoRequestMultiError is an error wrapping multiple validation errors
-// returned by TenantRepoRequest.ValidateAll() if the designated constraints
+// CreatePkiReplyMultiError is an error wrapping multiple validation errors
+// returned by CreatePkiReply.ValidateAll() if the designated constraints
 // aren't met.
-type TenantRepoRequestMultiError []error
+type CreatePkiReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantRepoRequestMultiError) Error() string {
+func (m CreatePkiReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2829,11 +3018,11 @@ func (m TenantRepoRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantRepoRequestMultiError) AllErrors() []error { return m }
+func (m CreatePkiReplyMultiError) AllErrors() []error { return m }
 
-// TenantRepoRequestValidationError is the validation error returned by
-// TenantRepoRequest.Validate if the designated constraints aren't met.
-type TenantRepoRequestValidationError struct {
+// CreatePkiReplyValidationError is the validation error returned by
+// CreatePkiReply.Validate if the designated constraints aren't met.
+type CreatePkiReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2841,24 +3030,22 @@ type TenantRepoRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantRepoRequestValidationError) Field() string { return e.field }
+func (e CreatePkiReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantRepoRequestValidationError) Reason() string { return e.reason }
+func (e CreatePkiReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantRepoRequestValidationError) Cause() error { return e.cause }
+func (e CreatePkiReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantRepoRequestValidationError) Key() bool { return e.key }
+func (e CreatePkiReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantRepoRequestValidationError) ErrorName() string {
-	return "TenantRepoRequestValidationError"
-}
+func (e CreatePkiReplyValidationError) ErrorName() string { return "CreatePkiReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TenantRepoRequestValidationError) Error() string {
+func (e CreatePkiReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2870,14 +3057,14 @@ func (e TenantRepoRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantRepoRequest.%s: %s%s",
+		"invalid %sCreatePkiReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantRepoRequestValidationError{}
+var _ error = CreatePkiReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2885,53 +3072,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantRepoRequestValidationError{}
+} = CreatePkiReplyValidationError{}
 
-// Validate checks the field values on CreateTenantRepoReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateTenantRepoReply) Validate() error {
+// Validate checks the field values on DeletePkiReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeletePkiReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateTenantRepoReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateTenantRepoReplyMultiError, or nil if none found.
-func (m *CreateTenantRepoReply) ValidateAll() error {
+// ValidateAll checks the field values on DeletePkiReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeletePkiReplyMultiError,
+// or nil if none found.
+func (m *DeletePkiReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateTenantRepoReply) validate(all bool) error {
+func (m *DeletePkiReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Msg
+
 	if all {
-		switch v := interface{}(m.GetSecret()).(type) {
+		switch v := interface{}(m.GetDryRun()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateTenantRepoReplyValidationError{
-					field:  "Secret",
+				errors = append(errors, DeletePkiReplyValidationError{
+					field:  "DryRun",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateTenantRepoReplyValidationError{
-					field:  "Secret",
+				errors = append(errors, DeletePkiReplyValidationError{
+					field:  "DryRun",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDryRun()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateTenantRepoReplyValidationError{
-				field:  "Secret",
+			return DeletePkiReplyValidationError{
+				field:  "DryRun",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2939,19 +3128,19 @@ func (m *CreateTenantRepoReply) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateTenantRepoReplyMultiError(errors)
+		return DeletePkiReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateTenantRepoReplyMultiError is an error wrapping multiple validation
-// errors returned by CreateTenantRepoReply.ValidateAll() if the designated
-// constraints aren't met.
-type CreateTenantRepoReplyMultiError []error
+// DeletePkiReplyMultiError is an error wrapping multiple validation errors
+// returned by DeletePkiReply.ValidateAll() if the designated constraints
+// aren't met.
+type DeletePkiReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateTenantRepoReplyMultiError) Error() string {
+func (m DeletePkiReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2960,36 +3149,191 @@ func (m CreateTenantRepoReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateTenantRepoReplyMultiError) AllErrors() []error { return m }
+func (m DeletePkiReplyMultiError) AllErrors() []error { return m }
 
-// CreateTenantRepoReplyValidationError is the validation error returned by
-// CreateTenantRepoReply.Validate if the designated constraints aren't met.
-type CreateTenantRepoReplyValidationError struct {
-	field  string
-	reason string
+// DeletePkiReplyValidationError is the validation error returned by
+// DeletePkiReply.Validate if the designated constraints aren't met.
+type DeletePkiReplyValidationError struct {
+	field  string
+	reason string
 	cause  error
 	key    bool
 }
 
 // Field function returns field value.
-func (e CreateTenantRepoReplyValidationError) Field() string { return e.field }
+func (e DeletePkiReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateTenantRepoReplyValidationError) Reason() string { return e.reason }
+func (e DeletePkiReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateTenantRepoReplyValidationError) Cause() error { return e.cause }
+func (e DeletePkiReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateTenantRepoReplyValidationError) Key() bool { return e.key }
+func (e DeletePkiReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateTenantRepoReplyValidationError) ErrorName() string {
-	return "CreateTenantRepoReplyValidationError"
+func (e DeletePkiReplyValidationError) ErrorName() string { return "DeletePkiReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeletePkiReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePkiReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePkiReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePkiReplyValidationError{}
+
+// Validate checks the field values on GetPkiReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetPkiReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPkiReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetPkiReplyMultiError, or
+// nil if none found.
+func (m *GetPkiReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPkiReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPkiReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPkiReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPkiReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPkiReplyValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPkiReplyValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPkiReplyValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetPkiReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPkiReplyMultiError is an error wrapping multiple validation errors
+// returned by GetPkiReply.ValidateAll() if the designated constraints aren't met.
+type GetPkiReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPkiReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPkiReplyMultiError) AllErrors() []error { return m }
+
+// GetPkiReplyValidationError is the validation error returned by
+// GetPkiReply.Validate if the designated constraints aren't met.
+type GetPkiReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
 }
 
+// Field function returns field value.
+func (e GetPkiReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPkiReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPkiReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPkiReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPkiReplyValidationError) ErrorName() string { return "GetPkiReplyValidationError" }
+
 // Error satisfies the builtin error interface
-func (e CreateTenantRepoReplyValidationError) Error() string {
+func (e GetPkiReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3001,14 +3345,14 @@ func (e CreateTenantRepoReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateTenantRepoReply.%s: %s%s",
+		"invalid %sGetPkiReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateTenantRepoReplyValidationError{}
+var _ error = GetPkiReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3016,46 +3360,12246 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateTenantRepoReplyValidationError{}
+} = GetPkiReplyValidationError{}
 
-// Validate checks the field values on DeleteTenantRepoReply with the rules
+// Validate checks the field values on UndeletePkiRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteTenantRepoReply) Validate() error {
+func (m *UndeletePkiRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteTenantRepoReply with the rules
+// ValidateAll checks the field values on UndeletePkiRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteTenantRepoReplyMultiError, or nil if none found.
-func (m *DeleteTenantRepoReply) ValidateAll() error {
+// UndeletePkiRequestMultiError, or nil if none found.
+func (m *UndeletePkiRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UndeletePkiRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateHostname(m.GetDomain()); err != nil {
+		err = UndeletePkiRequestValidationError{
+			field:  "Domain",
+			reason: "value must be a valid hostname",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UndeletePkiRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UndeletePkiRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+// UndeletePkiRequestMultiError is an error wrapping multiple validation errors
+// returned by UndeletePkiRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UndeletePkiRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UndeletePkiRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UndeletePkiRequestMultiError) AllErrors() []error { return m }
+
+// UndeletePkiRequestValidationError is the validation error returned by
+// UndeletePkiRequest.Validate if the designated constraints aren't met.
+type UndeletePkiRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UndeletePkiRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UndeletePkiRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UndeletePkiRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UndeletePkiRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UndeletePkiRequestValidationError) ErrorName() string {
+	return "UndeletePkiRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeletePkiRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeletePkiRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UndeletePkiRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UndeletePkiRequestValidationError{}
+
+// Validate checks the field values on UndeletePkiReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UndeletePkiReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UndeletePkiReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UndeletePkiReplyMultiError, or nil if none found.
+func (m *UndeletePkiReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteTenantRepoReply) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *UndeletePkiReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UndeletePkiReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UndeletePkiReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UndeletePkiReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UndeletePkiReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UndeletePkiReplyMultiError is an error wrapping multiple validation errors
+// returned by UndeletePkiReply.ValidateAll() if the designated constraints
+// aren't met.
+type UndeletePkiReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UndeletePkiReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UndeletePkiReplyMultiError) AllErrors() []error { return m }
+
+// UndeletePkiReplyValidationError is the validation error returned by
+// UndeletePkiReply.Validate if the designated constraints aren't met.
+type UndeletePkiReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UndeletePkiReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UndeletePkiReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UndeletePkiReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UndeletePkiReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UndeletePkiReplyValidationError) ErrorName() string { return "UndeletePkiReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UndeletePkiReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeletePkiReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UndeletePkiReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UndeletePkiReplyValidationError{}
+
+// Validate checks the field values on PurgePkiRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PurgePkiRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgePkiRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgePkiRequestMultiError, or nil if none found.
+func (m *PurgePkiRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgePkiRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateHostname(m.GetDomain()); err != nil {
+		err = PurgePkiRequestValidationError{
+			field:  "Domain",
+			reason: "value must be a valid hostname",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PurgePkiRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *PurgePkiRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+// PurgePkiRequestMultiError is an error wrapping multiple validation errors
+// returned by PurgePkiRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PurgePkiRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgePkiRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgePkiRequestMultiError) AllErrors() []error { return m }
+
+// PurgePkiRequestValidationError is the validation error returned by
+// PurgePkiRequest.Validate if the designated constraints aren't met.
+type PurgePkiRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgePkiRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgePkiRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgePkiRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgePkiRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgePkiRequestValidationError) ErrorName() string { return "PurgePkiRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PurgePkiRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgePkiRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgePkiRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgePkiRequestValidationError{}
+
+// Validate checks the field values on RepoAccount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RepoAccount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RepoAccount with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RepoAccountMultiError, or
+// nil if none found.
+func (m *RepoAccount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RepoAccount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AuthType
+
+	if all {
+		switch v := interface{}(m.GetToken()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RepoAccountValidationError{
+					field:  "Token",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RepoAccountValidationError{
+					field:  "Token",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetToken()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RepoAccountValidationError{
+				field:  "Token",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RepoAccountValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RepoAccountValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RepoAccountValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Additionals
+
+	// no validation rules for GeneratePassword
+
+	if len(errors) > 0 {
+		return RepoAccountMultiError(errors)
+	}
+
+	return nil
+}
+
+// RepoAccountMultiError is an error wrapping multiple validation errors
+// returned by RepoAccount.ValidateAll() if the designated constraints aren't met.
+type RepoAccountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RepoAccountMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RepoAccountMultiError) AllErrors() []error { return m }
+
+// RepoAccountValidationError is the validation error returned by
+// RepoAccount.Validate if the designated constraints aren't met.
+type RepoAccountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RepoAccountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RepoAccountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RepoAccountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RepoAccountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RepoAccountValidationError) ErrorName() string { return "RepoAccountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RepoAccountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRepoAccount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RepoAccountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RepoAccountValidationError{}
+
+// Validate checks the field values on Token with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Token) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Token with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TokenMultiError, or nil if none found.
+func (m *Token) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Token) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := TokenValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// TokenMultiError is an error wrapping multiple validation errors returned by
+// Token.ValidateAll() if the designated constraints aren't met.
+type TokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TokenMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TokenMultiError) AllErrors() []error { return m }
+
+// TokenValidationError is the validation error returned by Token.Validate if
+// the designated constraints aren't met.
+type TokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TokenValidationError) ErrorName() string { return "TokenValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TokenValidationError{}
+
+// Validate checks the field values on Account with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Account) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Account with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AccountMultiError, or nil if none found.
+func (m *Account) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Account) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUsername()) < 1 {
+		err := AccountValidationError{
+			field:  "Username",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Password
+
+	if len(errors) > 0 {
+		return AccountMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccountMultiError is an error wrapping multiple validation errors returned
+// by Account.ValidateAll() if the designated constraints aren't met.
+type AccountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccountMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccountMultiError) AllErrors() []error { return m }
+
+// AccountValidationError is the validation error returned by Account.Validate
+// if the designated constraints aren't met.
+type AccountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccountValidationError) ErrorName() string { return "AccountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccountValidationError{}
+
+// Validate checks the field values on RepoMeta with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RepoMeta) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RepoMeta with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RepoMetaMultiError, or nil
+// if none found.
+func (m *RepoMeta) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RepoMeta) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetProviderId()) < 1 {
+		err := RepoMetaValidationError{
+			field:  "ProviderId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProduct()) < 1 {
+		err := RepoMetaValidationError{
+			field:  "Product",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Project
+
+	if len(errors) > 0 {
+		return RepoMetaMultiError(errors)
+	}
+
+	return nil
+}
+
+// RepoMetaMultiError is an error wrapping multiple validation errors returned
+// by RepoMeta.ValidateAll() if the designated constraints aren't met.
+type RepoMetaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RepoMetaMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RepoMetaMultiError) AllErrors() []error { return m }
+
+// RepoMetaValidationError is the validation error returned by
+// RepoMeta.Validate if the designated constraints aren't met.
+type RepoMetaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RepoMetaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RepoMetaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RepoMetaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RepoMetaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RepoMetaValidationError) ErrorName() string { return "RepoMetaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RepoMetaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRepoMeta.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RepoMetaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RepoMetaValidationError{}
+
+// Validate checks the field values on RepoRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RepoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RepoRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RepoRequestMultiError, or
+// nil if none found.
+func (m *RepoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RepoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMeta() == nil {
+		err := RepoRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RepoRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RepoRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RepoRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RepoRequestValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RepoRequestValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RepoRequestValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Recursive
+
+	// no validation rules for Ttl
+
+	// no validation rules for ExpireTime
+
+	if m.ExpectedVersion != nil {
+
+		if m.GetExpectedVersion() < 0 {
+			err := RepoRequestValidationError{
+				field:  "ExpectedVersion",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RepoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RepoRequestMultiError is an error wrapping multiple validation errors
+// returned by RepoRequest.ValidateAll() if the designated constraints aren't met.
+type RepoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RepoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RepoRequestMultiError) AllErrors() []error { return m }
+
+// RepoRequestValidationError is the validation error returned by
+// RepoRequest.Validate if the designated constraints aren't met.
+type RepoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RepoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RepoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RepoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RepoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RepoRequestValidationError) ErrorName() string { return "RepoRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RepoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRepoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RepoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RepoRequestValidationError{}
+
+// Validate checks the field values on CreateRepoReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateRepoReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRepoReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRepoReplyMultiError, or nil if none found.
+func (m *CreateRepoReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRepoReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRepoReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRepoReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRepoReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Password
+
+	if all {
+		switch v := interface{}(m.GetDryRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRepoReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRepoReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDryRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRepoReplyValidationError{
+				field:  "DryRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateRepoReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRepoReplyMultiError is an error wrapping multiple validation errors
+// returned by CreateRepoReply.ValidateAll() if the designated constraints
+// aren't met.
+type CreateRepoReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRepoReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRepoReplyMultiError) AllErrors() []error { return m }
+
+// CreateRepoReplyValidationError is the validation error returned by
+// CreateRepoReply.Validate if the designated constraints aren't met.
+type CreateRepoReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRepoReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRepoReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRepoReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRepoReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRepoReplyValidationError) ErrorName() string { return "CreateRepoReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateRepoReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRepoReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRepoReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRepoReplyValidationError{}
+
+// Validate checks the field values on DeleteRepoReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRepoReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRepoReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRepoReplyMultiError, or nil if none found.
+func (m *DeleteRepoReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRepoReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Msg
+
+	if all {
+		switch v := interface{}(m.GetDryRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteRepoReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteRepoReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDryRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteRepoReplyValidationError{
+				field:  "DryRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteRepoReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRepoReplyMultiError is an error wrapping multiple validation errors
+// returned by DeleteRepoReply.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteRepoReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRepoReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRepoReplyMultiError) AllErrors() []error { return m }
+
+// DeleteRepoReplyValidationError is the validation error returned by
+// DeleteRepoReply.Validate if the designated constraints aren't met.
+type DeleteRepoReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRepoReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRepoReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRepoReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRepoReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRepoReplyValidationError) ErrorName() string { return "DeleteRepoReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteRepoReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRepoReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRepoReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRepoReplyValidationError{}
+
+// Validate checks the field values on GetRepoRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetRepoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRepoRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetRepoRequestMultiError,
+// or nil if none found.
+func (m *GetRepoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRepoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMeta() == nil {
+		err := GetRepoRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRepoRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRepoRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRepoRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetRepoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRepoRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRepoRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetRepoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRepoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRepoRequestMultiError) AllErrors() []error { return m }
+
+// GetRepoRequestValidationError is the validation error returned by
+// GetRepoRequest.Validate if the designated constraints aren't met.
+type GetRepoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRepoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRepoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRepoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRepoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRepoRequestValidationError) ErrorName() string { return "GetRepoRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRepoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRepoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRepoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRepoRequestValidationError{}
+
+// Validate checks the field values on GetRepoReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetRepoReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRepoReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetRepoReplyMultiError, or
+// nil if none found.
+func (m *GetRepoReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRepoReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRepoReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRepoReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRepoReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRepoReplyValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRepoReplyValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRepoReplyValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetRepoReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRepoReplyMultiError is an error wrapping multiple validation errors
+// returned by GetRepoReply.ValidateAll() if the designated constraints aren't met.
+type GetRepoReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRepoReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRepoReplyMultiError) AllErrors() []error { return m }
+
+// GetRepoReplyValidationError is the validation error returned by
+// GetRepoReply.Validate if the designated constraints aren't met.
+type GetRepoReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRepoReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRepoReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRepoReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRepoReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRepoReplyValidationError) ErrorName() string { return "GetRepoReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRepoReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRepoReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRepoReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRepoReplyValidationError{}
+
+// Validate checks the field values on ListRepoRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRepoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRepoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRepoRequestMultiError, or nil if none found.
+func (m *ListRepoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRepoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProviderId
+
+	// no validation rules for Product
+
+	// no validation rules for Project
+
+	if val := m.GetPageSize(); val < 0 || val > 1000 {
+		err := ListRepoRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListRepoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRepoRequestMultiError is an error wrapping multiple validation errors
+// returned by ListRepoRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListRepoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRepoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRepoRequestMultiError) AllErrors() []error { return m }
+
+// ListRepoRequestValidationError is the validation error returned by
+// ListRepoRequest.Validate if the designated constraints aren't met.
+type ListRepoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRepoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRepoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRepoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRepoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRepoRequestValidationError) ErrorName() string { return "ListRepoRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListRepoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRepoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRepoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRepoRequestValidationError{}
+
+// Validate checks the field values on ListRepoReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListRepoReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRepoReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListRepoReplyMultiError, or
+// nil if none found.
+func (m *ListRepoReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRepoReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSecrets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRepoReplyValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRepoReplyValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRepoReplyValidationError{
+					field:  fmt.Sprintf("Secrets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListRepoReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRepoReplyMultiError is an error wrapping multiple validation errors
+// returned by ListRepoReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListRepoReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRepoReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRepoReplyMultiError) AllErrors() []error { return m }
+
+// ListRepoReplyValidationError is the validation error returned by
+// ListRepoReply.Validate if the designated constraints aren't met.
+type ListRepoReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRepoReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRepoReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRepoReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRepoReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRepoReplyValidationError) ErrorName() string { return "ListRepoReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListRepoReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRepoReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRepoReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRepoReplyValidationError{}
+
+// Validate checks the field values on ListRepoVersionsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRepoVersionsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRepoVersionsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRepoVersionsReplyMultiError, or nil if none found.
+func (m *ListRepoVersionsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRepoVersionsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRepoVersionsReplyValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRepoVersionsReplyValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRepoVersionsReplyValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRepoVersionsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRepoVersionsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListRepoVersionsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListRepoVersionsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRepoVersionsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRepoVersionsReplyMultiError) AllErrors() []error { return m }
+
+// ListRepoVersionsReplyValidationError is the validation error returned by
+// ListRepoVersionsReply.Validate if the designated constraints aren't met.
+type ListRepoVersionsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRepoVersionsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRepoVersionsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRepoVersionsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRepoVersionsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRepoVersionsReplyValidationError) ErrorName() string {
+	return "ListRepoVersionsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRepoVersionsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRepoVersionsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRepoVersionsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRepoVersionsReplyValidationError{}
+
+// Validate checks the field values on RollbackRepoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackRepoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackRepoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackRepoRequestMultiError, or nil if none found.
+func (m *RollbackRepoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackRepoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMeta() == nil {
+		err := RollbackRepoRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RollbackRepoRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RollbackRepoRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RollbackRepoRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetVersion() <= 0 {
+		err := RollbackRepoRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RollbackRepoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RollbackRepoRequestMultiError is an error wrapping multiple validation
+// errors returned by RollbackRepoRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RollbackRepoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackRepoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackRepoRequestMultiError) AllErrors() []error { return m }
+
+// RollbackRepoRequestValidationError is the validation error returned by
+// RollbackRepoRequest.Validate if the designated constraints aren't met.
+type RollbackRepoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackRepoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackRepoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackRepoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackRepoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackRepoRequestValidationError) ErrorName() string {
+	return "RollbackRepoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackRepoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackRepoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackRepoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackRepoRequestValidationError{}
+
+// Validate checks the field values on RollbackRepoReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RollbackRepoReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackRepoReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackRepoReplyMultiError, or nil if none found.
+func (m *RollbackRepoReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackRepoReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RollbackRepoReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RollbackRepoReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RollbackRepoReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RollbackRepoReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RollbackRepoReplyMultiError is an error wrapping multiple validation errors
+// returned by RollbackRepoReply.ValidateAll() if the designated constraints
+// aren't met.
+type RollbackRepoReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackRepoReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackRepoReplyMultiError) AllErrors() []error { return m }
+
+// RollbackRepoReplyValidationError is the validation error returned by
+// RollbackRepoReply.Validate if the designated constraints aren't met.
+type RollbackRepoReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackRepoReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackRepoReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackRepoReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackRepoReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackRepoReplyValidationError) ErrorName() string {
+	return "RollbackRepoReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackRepoReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackRepoReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackRepoReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackRepoReplyValidationError{}
+
+// Validate checks the field values on UndeleteRepoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UndeleteRepoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UndeleteRepoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UndeleteRepoRequestMultiError, or nil if none found.
+func (m *UndeleteRepoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UndeleteRepoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMeta() == nil {
+		err := UndeleteRepoRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UndeleteRepoRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UndeleteRepoRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UndeleteRepoRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UndeleteRepoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UndeleteRepoRequestMultiError is an error wrapping multiple validation
+// errors returned by UndeleteRepoRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UndeleteRepoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UndeleteRepoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UndeleteRepoRequestMultiError) AllErrors() []error { return m }
+
+// UndeleteRepoRequestValidationError is the validation error returned by
+// UndeleteRepoRequest.Validate if the designated constraints aren't met.
+type UndeleteRepoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UndeleteRepoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UndeleteRepoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UndeleteRepoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UndeleteRepoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UndeleteRepoRequestValidationError) ErrorName() string {
+	return "UndeleteRepoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteRepoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteRepoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UndeleteRepoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UndeleteRepoRequestValidationError{}
+
+// Validate checks the field values on UndeleteRepoReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UndeleteRepoReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UndeleteRepoReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UndeleteRepoReplyMultiError, or nil if none found.
+func (m *UndeleteRepoReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UndeleteRepoReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UndeleteRepoReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UndeleteRepoReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UndeleteRepoReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UndeleteRepoReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UndeleteRepoReplyMultiError is an error wrapping multiple validation errors
+// returned by UndeleteRepoReply.ValidateAll() if the designated constraints
+// aren't met.
+type UndeleteRepoReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UndeleteRepoReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UndeleteRepoReplyMultiError) AllErrors() []error { return m }
+
+// UndeleteRepoReplyValidationError is the validation error returned by
+// UndeleteRepoReply.Validate if the designated constraints aren't met.
+type UndeleteRepoReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UndeleteRepoReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UndeleteRepoReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UndeleteRepoReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UndeleteRepoReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UndeleteRepoReplyValidationError) ErrorName() string {
+	return "UndeleteRepoReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteRepoReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteRepoReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UndeleteRepoReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UndeleteRepoReplyValidationError{}
+
+// Validate checks the field values on RenderRepoDockerConfigRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenderRepoDockerConfigRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenderRepoDockerConfigRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RenderRepoDockerConfigRequestMultiError, or nil if none found.
+func (m *RenderRepoDockerConfigRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenderRepoDockerConfigRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMeta() == nil {
+		err := RenderRepoDockerConfigRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RenderRepoDockerConfigRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RenderRepoDockerConfigRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RenderRepoDockerConfigRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetName()) > 253 {
+		err := RenderRepoDockerConfigRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 253 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RenderRepoDockerConfigRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := RenderRepoDockerConfigRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^([a-z0-9]([-a-z0-9.]*[a-z0-9])?)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := RenderRepoDockerConfigRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RenderRepoDockerConfigRequest_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := RenderRepoDockerConfigRequestValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^([a-z0-9]([-a-z0-9]*[a-z0-9])?)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RenderRepoDockerConfigRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenderRepoDockerConfigRequestMultiError is an error wrapping multiple
+// validation errors returned by RenderRepoDockerConfigRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RenderRepoDockerConfigRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenderRepoDockerConfigRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenderRepoDockerConfigRequestMultiError) AllErrors() []error { return m }
+
+// RenderRepoDockerConfigRequestValidationError is the validation error
+// returned by RenderRepoDockerConfigRequest.Validate if the designated
+// constraints aren't met.
+type RenderRepoDockerConfigRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenderRepoDockerConfigRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenderRepoDockerConfigRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenderRepoDockerConfigRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenderRepoDockerConfigRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenderRepoDockerConfigRequestValidationError) ErrorName() string {
+	return "RenderRepoDockerConfigRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenderRepoDockerConfigRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenderRepoDockerConfigRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenderRepoDockerConfigRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenderRepoDockerConfigRequestValidationError{}
+
+var _RenderRepoDockerConfigRequest_Name_Pattern = regexp.MustCompile("^([a-z0-9]([-a-z0-9.]*[a-z0-9])?)?$")
+
+var _RenderRepoDockerConfigRequest_Namespace_Pattern = regexp.MustCompile("^([a-z0-9]([-a-z0-9]*[a-z0-9])?)?$")
+
+// Validate checks the field values on PurgeRepoRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PurgeRepoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeRepoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeRepoRequestMultiError, or nil if none found.
+func (m *PurgeRepoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeRepoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMeta() == nil {
+		err := PurgeRepoRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PurgeRepoRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PurgeRepoRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PurgeRepoRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PurgeRepoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeRepoRequestMultiError is an error wrapping multiple validation errors
+// returned by PurgeRepoRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PurgeRepoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeRepoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeRepoRequestMultiError) AllErrors() []error { return m }
+
+// PurgeRepoRequestValidationError is the validation error returned by
+// PurgeRepoRequest.Validate if the designated constraints aren't met.
+type PurgeRepoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeRepoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeRepoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeRepoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeRepoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeRepoRequestValidationError) ErrorName() string { return "PurgeRepoRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PurgeRepoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeRepoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeRepoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeRepoRequestValidationError{}
+
+// Validate checks the field values on TenantGitMeta with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TenantGitMeta) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantGitMeta with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TenantGitMetaMultiError, or
+// nil if none found.
+func (m *TenantGitMeta) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantGitMeta) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := TenantGitMetaValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPermission()) < 1 {
+		err := TenantGitMetaValidationError{
+			field:  "Permission",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TenantGitMetaMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantGitMetaMultiError is an error wrapping multiple validation errors
+// returned by TenantGitMeta.ValidateAll() if the designated constraints
+// aren't met.
+type TenantGitMetaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantGitMetaMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantGitMetaMultiError) AllErrors() []error { return m }
+
+// TenantGitMetaValidationError is the validation error returned by
+// TenantGitMeta.Validate if the designated constraints aren't met.
+type TenantGitMetaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantGitMetaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantGitMetaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantGitMetaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantGitMetaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantGitMetaValidationError) ErrorName() string { return "TenantGitMetaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TenantGitMetaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantGitMeta.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantGitMetaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantGitMetaValidationError{}
+
+// Validate checks the field values on TenantGitRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TenantGitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantGitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantGitRequestMultiError, or nil if none found.
+func (m *TenantGitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantGitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMeta() == nil {
+		err := TenantGitRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantGitRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantGitRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantGitRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetKvs() == nil {
+		err := TenantGitRequestValidationError{
+			field:  "Kvs",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetKvs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantGitRequestValidationError{
+					field:  "Kvs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantGitRequestValidationError{
+					field:  "Kvs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKvs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantGitRequestValidationError{
+				field:  "Kvs",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Ttl
+
+	// no validation rules for ExpireTime
+
+	if m.ExpectedVersion != nil {
+
+		if m.GetExpectedVersion() < 0 {
+			err := TenantGitRequestValidationError{
+				field:  "ExpectedVersion",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TenantGitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantGitRequestMultiError is an error wrapping multiple validation errors
+// returned by TenantGitRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TenantGitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantGitRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantGitRequestMultiError) AllErrors() []error { return m }
+
+// TenantGitRequestValidationError is the validation error returned by
+// TenantGitRequest.Validate if the designated constraints aren't met.
+type TenantGitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantGitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantGitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantGitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantGitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantGitRequestValidationError) ErrorName() string { return "TenantGitRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TenantGitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantGitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantGitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantGitRequestValidationError{}
+
+// Validate checks the field values on CreateTenantGitReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTenantGitReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTenantGitReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTenantGitReplyMultiError, or nil if none found.
+func (m *CreateTenantGitReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTenantGitReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTenantGitReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTenantGitReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTenantGitReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDryRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTenantGitReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTenantGitReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDryRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTenantGitReplyValidationError{
+				field:  "DryRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateTenantGitReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTenantGitReplyMultiError is an error wrapping multiple validation
+// errors returned by CreateTenantGitReply.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTenantGitReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTenantGitReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTenantGitReplyMultiError) AllErrors() []error { return m }
+
+// CreateTenantGitReplyValidationError is the validation error returned by
+// CreateTenantGitReply.Validate if the designated constraints aren't met.
+type CreateTenantGitReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTenantGitReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTenantGitReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTenantGitReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTenantGitReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTenantGitReplyValidationError) ErrorName() string {
+	return "CreateTenantGitReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTenantGitReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTenantGitReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTenantGitReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTenantGitReplyValidationError{}
+
+// Validate checks the field values on DeleteTenantGitReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTenantGitReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTenantGitReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTenantGitReplyMultiError, or nil if none found.
+func (m *DeleteTenantGitReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTenantGitReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Msg
+
+	if all {
+		switch v := interface{}(m.GetDryRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteTenantGitReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteTenantGitReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDryRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteTenantGitReplyValidationError{
+				field:  "DryRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteTenantGitReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTenantGitReplyMultiError is an error wrapping multiple validation
+// errors returned by DeleteTenantGitReply.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTenantGitReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTenantGitReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTenantGitReplyMultiError) AllErrors() []error { return m }
+
+// DeleteTenantGitReplyValidationError is the validation error returned by
+// DeleteTenantGitReply.Validate if the designated constraints aren't met.
+type DeleteTenantGitReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTenantGitReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTenantGitReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTenantGitReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTenantGitReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTenantGitReplyValidationError) ErrorName() string {
+	return "DeleteTenantGitReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTenantGitReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTenantGitReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTenantGitReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTenantGitReplyValidationError{}
+
+// Validate checks the field values on GetTenantGitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTenantGitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTenantGitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTenantGitRequestMultiError, or nil if none found.
+func (m *GetTenantGitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTenantGitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMeta() == nil {
+		err := GetTenantGitRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTenantGitRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTenantGitRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTenantGitRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTenantGitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTenantGitRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTenantGitRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTenantGitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTenantGitRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTenantGitRequestMultiError) AllErrors() []error { return m }
+
+// GetTenantGitRequestValidationError is the validation error returned by
+// GetTenantGitRequest.Validate if the designated constraints aren't met.
+type GetTenantGitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTenantGitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTenantGitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTenantGitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTenantGitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTenantGitRequestValidationError) ErrorName() string {
+	return "GetTenantGitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTenantGitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTenantGitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTenantGitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTenantGitRequestValidationError{}
+
+// Validate checks the field values on GetTenantGitReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetTenantGitReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTenantGitReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTenantGitReplyMultiError, or nil if none found.
+func (m *GetTenantGitReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTenantGitReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTenantGitReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTenantGitReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTenantGitReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTenantGitReplyValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTenantGitReplyValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTenantGitReplyValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTenantGitReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTenantGitReplyMultiError is an error wrapping multiple validation errors
+// returned by GetTenantGitReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetTenantGitReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTenantGitReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTenantGitReplyMultiError) AllErrors() []error { return m }
+
+// GetTenantGitReplyValidationError is the validation error returned by
+// GetTenantGitReply.Validate if the designated constraints aren't met.
+type GetTenantGitReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTenantGitReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTenantGitReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTenantGitReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTenantGitReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTenantGitReplyValidationError) ErrorName() string {
+	return "GetTenantGitReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTenantGitReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTenantGitReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTenantGitReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTenantGitReplyValidationError{}
+
+// Validate checks the field values on ListTenantGitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTenantGitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTenantGitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTenantGitRequestMultiError, or nil if none found.
+func (m *ListTenantGitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTenantGitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Permission
+
+	if val := m.GetPageSize(); val < 0 || val > 1000 {
+		err := ListTenantGitRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListTenantGitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTenantGitRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTenantGitRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTenantGitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTenantGitRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTenantGitRequestMultiError) AllErrors() []error { return m }
+
+// ListTenantGitRequestValidationError is the validation error returned by
+// ListTenantGitRequest.Validate if the designated constraints aren't met.
+type ListTenantGitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTenantGitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTenantGitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTenantGitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTenantGitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTenantGitRequestValidationError) ErrorName() string {
+	return "ListTenantGitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTenantGitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTenantGitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTenantGitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTenantGitRequestValidationError{}
+
+// Validate checks the field values on ListTenantGitReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTenantGitReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTenantGitReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTenantGitReplyMultiError, or nil if none found.
+func (m *ListTenantGitReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTenantGitReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSecrets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTenantGitReplyValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTenantGitReplyValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTenantGitReplyValidationError{
+					field:  fmt.Sprintf("Secrets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListTenantGitReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTenantGitReplyMultiError is an error wrapping multiple validation errors
+// returned by ListTenantGitReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListTenantGitReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTenantGitReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTenantGitReplyMultiError) AllErrors() []error { return m }
+
+// ListTenantGitReplyValidationError is the validation error returned by
+// ListTenantGitReply.Validate if the designated constraints aren't met.
+type ListTenantGitReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTenantGitReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTenantGitReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTenantGitReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTenantGitReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTenantGitReplyValidationError) ErrorName() string {
+	return "ListTenantGitReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTenantGitReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTenantGitReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTenantGitReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTenantGitReplyValidationError{}
+
+// Validate checks the field values on ListTenantGitVersionsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTenantGitVersionsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTenantGitVersionsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTenantGitVersionsReplyMultiError, or nil if none found.
+func (m *ListTenantGitVersionsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTenantGitVersionsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTenantGitVersionsReplyValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTenantGitVersionsReplyValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTenantGitVersionsReplyValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTenantGitVersionsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTenantGitVersionsReplyMultiError is an error wrapping multiple
+// validation errors returned by ListTenantGitVersionsReply.ValidateAll() if
+// the designated constraints aren't met.
+type ListTenantGitVersionsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTenantGitVersionsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTenantGitVersionsReplyMultiError) AllErrors() []error { return m }
+
+// ListTenantGitVersionsReplyValidationError is the validation error returned
+// by ListTenantGitVersionsReply.Validate if the designated constraints aren't met.
+type ListTenantGitVersionsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTenantGitVersionsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTenantGitVersionsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTenantGitVersionsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTenantGitVersionsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTenantGitVersionsReplyValidationError) ErrorName() string {
+	return "ListTenantGitVersionsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTenantGitVersionsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTenantGitVersionsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTenantGitVersionsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTenantGitVersionsReplyValidationError{}
+
+// Validate checks the field values on RollbackTenantGitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackTenantGitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackTenantGitRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackTenantGitRequestMultiError, or nil if none found.
+func (m *RollbackTenantGitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackTenantGitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMeta() == nil {
+		err := RollbackTenantGitRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RollbackTenantGitRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RollbackTenantGitRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RollbackTenantGitRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetVersion() <= 0 {
+		err := RollbackTenantGitRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RollbackTenantGitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RollbackTenantGitRequestMultiError is an error wrapping multiple validation
+// errors returned by RollbackTenantGitRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RollbackTenantGitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackTenantGitRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackTenantGitRequestMultiError) AllErrors() []error { return m }
+
+// RollbackTenantGitRequestValidationError is the validation error returned by
+// RollbackTenantGitRequest.Validate if the designated constraints aren't met.
+type RollbackTenantGitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackTenantGitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackTenantGitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackTenantGitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackTenantGitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackTenantGitRequestValidationError) ErrorName() string {
+	return "RollbackTenantGitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackTenantGitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackTenantGitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackTenantGitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackTenantGitRequestValidationError{}
+
+// Validate checks the field values on RollbackTenantGitReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackTenantGitReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackTenantGitReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackTenantGitReplyMultiError, or nil if none found.
+func (m *RollbackTenantGitReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackTenantGitReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RollbackTenantGitReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RollbackTenantGitReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RollbackTenantGitReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RollbackTenantGitReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RollbackTenantGitReplyMultiError is an error wrapping multiple validation
+// errors returned by RollbackTenantGitReply.ValidateAll() if the designated
+// constraints aren't met.
+type RollbackTenantGitReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackTenantGitReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackTenantGitReplyMultiError) AllErrors() []error { return m }
+
+// RollbackTenantGitReplyValidationError is the validation error returned by
+// RollbackTenantGitReply.Validate if the designated constraints aren't met.
+type RollbackTenantGitReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackTenantGitReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackTenantGitReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackTenantGitReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackTenantGitReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackTenantGitReplyValidationError) ErrorName() string {
+	return "RollbackTenantGitReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackTenantGitReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackTenantGitReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackTenantGitReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackTenantGitReplyValidationError{}
+
+// Validate checks the field values on UndeleteTenantGitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UndeleteTenantGitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UndeleteTenantGitRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UndeleteTenantGitRequestMultiError, or nil if none found.
+func (m *UndeleteTenantGitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UndeleteTenantGitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMeta() == nil {
+		err := UndeleteTenantGitRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UndeleteTenantGitRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UndeleteTenantGitRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UndeleteTenantGitRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UndeleteTenantGitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UndeleteTenantGitRequestMultiError is an error wrapping multiple validation
+// errors returned by UndeleteTenantGitRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UndeleteTenantGitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UndeleteTenantGitRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UndeleteTenantGitRequestMultiError) AllErrors() []error { return m }
+
+// UndeleteTenantGitRequestValidationError is the validation error returned by
+// UndeleteTenantGitRequest.Validate if the designated constraints aren't met.
+type UndeleteTenantGitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UndeleteTenantGitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UndeleteTenantGitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UndeleteTenantGitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UndeleteTenantGitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UndeleteTenantGitRequestValidationError) ErrorName() string {
+	return "UndeleteTenantGitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteTenantGitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteTenantGitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UndeleteTenantGitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UndeleteTenantGitRequestValidationError{}
+
+// Validate checks the field values on UndeleteTenantGitReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UndeleteTenantGitReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UndeleteTenantGitReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UndeleteTenantGitReplyMultiError, or nil if none found.
+func (m *UndeleteTenantGitReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UndeleteTenantGitReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UndeleteTenantGitReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UndeleteTenantGitReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UndeleteTenantGitReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UndeleteTenantGitReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UndeleteTenantGitReplyMultiError is an error wrapping multiple validation
+// errors returned by UndeleteTenantGitReply.ValidateAll() if the designated
+// constraints aren't met.
+type UndeleteTenantGitReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UndeleteTenantGitReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UndeleteTenantGitReplyMultiError) AllErrors() []error { return m }
+
+// UndeleteTenantGitReplyValidationError is the validation error returned by
+// UndeleteTenantGitReply.Validate if the designated constraints aren't met.
+type UndeleteTenantGitReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UndeleteTenantGitReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UndeleteTenantGitReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UndeleteTenantGitReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UndeleteTenantGitReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UndeleteTenantGitReplyValidationError) ErrorName() string {
+	return "UndeleteTenantGitReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteTenantGitReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteTenantGitReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UndeleteTenantGitReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UndeleteTenantGitReplyValidationError{}
+
+// Validate checks the field values on PurgeTenantGitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PurgeTenantGitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeTenantGitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeTenantGitRequestMultiError, or nil if none found.
+func (m *PurgeTenantGitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeTenantGitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMeta() == nil {
+		err := PurgeTenantGitRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PurgeTenantGitRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PurgeTenantGitRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PurgeTenantGitRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PurgeTenantGitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeTenantGitRequestMultiError is an error wrapping multiple validation
+// errors returned by PurgeTenantGitRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PurgeTenantGitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeTenantGitRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeTenantGitRequestMultiError) AllErrors() []error { return m }
+
+// PurgeTenantGitRequestValidationError is the validation error returned by
+// PurgeTenantGitRequest.Validate if the designated constraints aren't met.
+type PurgeTenantGitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeTenantGitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeTenantGitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeTenantGitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeTenantGitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeTenantGitRequestValidationError) ErrorName() string {
+	return "PurgeTenantGitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PurgeTenantGitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeTenantGitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeTenantGitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeTenantGitRequestValidationError{}
+
+// Validate checks the field values on TenantRepoMeta with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TenantRepoMeta) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantRepoMeta with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TenantRepoMetaMultiError,
+// or nil if none found.
+func (m *TenantRepoMeta) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantRepoMeta) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := TenantRepoMetaValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPermission()) < 1 {
+		err := TenantRepoMetaValidationError{
+			field:  "Permission",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TenantRepoMetaMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantRepoMetaMultiError is an error wrapping multiple validation errors
+// returned by TenantRepoMeta.ValidateAll() if the designated constraints
+// aren't met.
+type TenantRepoMetaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantRepoMetaMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantRepoMetaMultiError) AllErrors() []error { return m }
+
+// TenantRepoMetaValidationError is the validation error returned by
+// TenantRepoMeta.Validate if the designated constraints aren't met.
+type TenantRepoMetaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantRepoMetaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantRepoMetaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantRepoMetaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantRepoMetaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantRepoMetaValidationError) ErrorName() string { return "TenantRepoMetaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TenantRepoMetaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantRepoMeta.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantRepoMetaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantRepoMetaValidationError{}
+
+// Validate checks the field values on TenantRepoRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TenantRepoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantRepoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantRepoRequestMultiError, or nil if none found.
+func (m *TenantRepoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantRepoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMeta() == nil {
+		err := TenantRepoRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantRepoRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantRepoRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantRepoRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetAccount() == nil {
+		err := TenantRepoRequestValidationError{
+			field:  "Account",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantRepoRequestValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantRepoRequestValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantRepoRequestValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Ttl
+
+	// no validation rules for ExpireTime
+
+	if m.ExpectedVersion != nil {
+
+		if m.GetExpectedVersion() < 0 {
+			err := TenantRepoRequestValidationError{
+				field:  "ExpectedVersion",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TenantRepoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantRepoRequestMultiError is an error wrapping multiple validation errors
+// returned by TenantRepoRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TenantRepoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantRepoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantRepoRequestMultiError) AllErrors() []error { return m }
+
+// TenantRepoRequestValidationError is the validation error returned by
+// TenantRepoRequest.Validate if the designated constraints aren't met.
+type TenantRepoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantRepoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantRepoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantRepoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantRepoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantRepoRequestValidationError) ErrorName() string {
+	return "TenantRepoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TenantRepoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantRepoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantRepoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantRepoRequestValidationError{}
+
+// Validate checks the field values on CreateTenantRepoReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTenantRepoReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTenantRepoReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTenantRepoReplyMultiError, or nil if none found.
+func (m *CreateTenantRepoReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTenantRepoReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTenantRepoReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTenantRepoReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTenantRepoReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Password
+
+	if all {
+		switch v := interface{}(m.GetDryRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTenantRepoReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTenantRepoReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDryRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTenantRepoReplyValidationError{
+				field:  "DryRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateTenantRepoReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTenantRepoReplyMultiError is an error wrapping multiple validation
+// errors returned by CreateTenantRepoReply.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTenantRepoReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTenantRepoReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTenantRepoReplyMultiError) AllErrors() []error { return m }
+
+// CreateTenantRepoReplyValidationError is the validation error returned by
+// CreateTenantRepoReply.Validate if the designated constraints aren't met.
+type CreateTenantRepoReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTenantRepoReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTenantRepoReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTenantRepoReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTenantRepoReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTenantRepoReplyValidationError) ErrorName() string {
+	return "CreateTenantRepoReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTenantRepoReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTenantRepoReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTenantRepoReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTenantRepoReplyValidationError{}
+
+// Validate checks the field values on DeleteTenantRepoReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTenantRepoReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTenantRepoReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTenantRepoReplyMultiError, or nil if none found.
+func (m *DeleteTenantRepoReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTenantRepoReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Msg
+
+	if all {
+		switch v := interface{}(m.GetDryRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteTenantRepoReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteTenantRepoReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDryRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteTenantRepoReplyValidationError{
+				field:  "DryRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteTenantRepoReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTenantRepoReplyMultiError is an error wrapping multiple validation
+// errors returned by DeleteTenantRepoReply.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTenantRepoReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTenantRepoReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTenantRepoReplyMultiError) AllErrors() []error { return m }
+
+// DeleteTenantRepoReplyValidationError is the validation error returned by
+// DeleteTenantRepoReply.Validate if the designated constraints aren't met.
+type DeleteTenantRepoReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTenantRepoReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTenantRepoReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTenantRepoReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTenantRepoReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTenantRepoReplyValidationError) ErrorName() string {
+	return "DeleteTenantRepoReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTenantRepoReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTenantRepoReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTenantRepoReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTenantRepoReplyValidationError{}
+
+// Validate checks the field values on GetTenantRepoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTenantRepoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTenantRepoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTenantRepoRequestMultiError, or nil if none found.
+func (m *GetTenantRepoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTenantRepoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMeta() == nil {
+		err := GetTenantRepoRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTenantRepoRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTenantRepoRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTenantRepoRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTenantRepoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTenantRepoRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTenantRepoRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTenantRepoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTenantRepoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTenantRepoRequestMultiError) AllErrors() []error { return m }
+
+// GetTenantRepoRequestValidationError is the validation error returned by
+// GetTenantRepoRequest.Validate if the designated constraints aren't met.
+type GetTenantRepoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTenantRepoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTenantRepoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTenantRepoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTenantRepoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTenantRepoRequestValidationError) ErrorName() string {
+	return "GetTenantRepoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTenantRepoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTenantRepoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTenantRepoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTenantRepoRequestValidationError{}
+
+// Validate checks the field values on GetTenantRepoReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTenantRepoReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTenantRepoReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTenantRepoReplyMultiError, or nil if none found.
+func (m *GetTenantRepoReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTenantRepoReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTenantRepoReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTenantRepoReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTenantRepoReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTenantRepoReplyValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTenantRepoReplyValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTenantRepoReplyValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTenantRepoReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTenantRepoReplyMultiError is an error wrapping multiple validation errors
+// returned by GetTenantRepoReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetTenantRepoReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTenantRepoReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTenantRepoReplyMultiError) AllErrors() []error { return m }
+
+// GetTenantRepoReplyValidationError is the validation error returned by
+// GetTenantRepoReply.Validate if the designated constraints aren't met.
+type GetTenantRepoReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTenantRepoReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTenantRepoReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTenantRepoReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTenantRepoReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTenantRepoReplyValidationError) ErrorName() string {
+	return "GetTenantRepoReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTenantRepoReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTenantRepoReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTenantRepoReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTenantRepoReplyValidationError{}
+
+// Validate checks the field values on ListTenantRepoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTenantRepoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTenantRepoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTenantRepoRequestMultiError, or nil if none found.
+func (m *ListTenantRepoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTenantRepoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Permission
+
+	if val := m.GetPageSize(); val < 0 || val > 1000 {
+		err := ListTenantRepoRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListTenantRepoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTenantRepoRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTenantRepoRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTenantRepoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTenantRepoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTenantRepoRequestMultiError) AllErrors() []error { return m }
+
+// ListTenantRepoRequestValidationError is the validation error returned by
+// ListTenantRepoRequest.Validate if the designated constraints aren't met.
+type ListTenantRepoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTenantRepoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTenantRepoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTenantRepoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTenantRepoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTenantRepoRequestValidationError) ErrorName() string {
+	return "ListTenantRepoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTenantRepoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTenantRepoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTenantRepoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTenantRepoRequestValidationError{}
+
+// Validate checks the field values on ListTenantRepoReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTenantRepoReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTenantRepoReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTenantRepoReplyMultiError, or nil if none found.
+func (m *ListTenantRepoReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTenantRepoReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSecrets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTenantRepoReplyValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTenantRepoReplyValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTenantRepoReplyValidationError{
+					field:  fmt.Sprintf("Secrets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListTenantRepoReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTenantRepoReplyMultiError is an error wrapping multiple validation
+// errors returned by ListTenantRepoReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListTenantRepoReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTenantRepoReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTenantRepoReplyMultiError) AllErrors() []error { return m }
+
+// ListTenantRepoReplyValidationError is the validation error returned by
+// ListTenantRepoReply.Validate if the designated constraints aren't met.
+type ListTenantRepoReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTenantRepoReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTenantRepoReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTenantRepoReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTenantRepoReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTenantRepoReplyValidationError) ErrorName() string {
+	return "ListTenantRepoReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTenantRepoReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTenantRepoReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTenantRepoReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTenantRepoReplyValidationError{}
+
+// Validate checks the field values on ListTenantRepoVersionsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTenantRepoVersionsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTenantRepoVersionsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTenantRepoVersionsReplyMultiError, or nil if none found.
+func (m *ListTenantRepoVersionsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTenantRepoVersionsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTenantRepoVersionsReplyValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTenantRepoVersionsReplyValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTenantRepoVersionsReplyValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTenantRepoVersionsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTenantRepoVersionsReplyMultiError is an error wrapping multiple
+// validation errors returned by ListTenantRepoVersionsReply.ValidateAll() if
+// the designated constraints aren't met.
+type ListTenantRepoVersionsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTenantRepoVersionsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTenantRepoVersionsReplyMultiError) AllErrors() []error { return m }
+
+// ListTenantRepoVersionsReplyValidationError is the validation error returned
+// by ListTenantRepoVersionsReply.Validate if the designated constraints
+// aren't met.
+type ListTenantRepoVersionsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTenantRepoVersionsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTenantRepoVersionsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTenantRepoVersionsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTenantRepoVersionsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTenantRepoVersionsReplyValidationError) ErrorName() string {
+	return "ListTenantRepoVersionsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTenantRepoVersionsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTenantRepoVersionsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTenantRepoVersionsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTenantRepoVersionsReplyValidationError{}
+
+// Validate checks the field values on RollbackTenantRepoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackTenantRepoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackTenantRepoRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackTenantRepoRequestMultiError, or nil if none found.
+func (m *RollbackTenantRepoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackTenantRepoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMeta() == nil {
+		err := RollbackTenantRepoRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RollbackTenantRepoRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RollbackTenantRepoRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RollbackTenantRepoRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetVersion() <= 0 {
+		err := RollbackTenantRepoRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RollbackTenantRepoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RollbackTenantRepoRequestMultiError is an error wrapping multiple validation
+// errors returned by RollbackTenantRepoRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RollbackTenantRepoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackTenantRepoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackTenantRepoRequestMultiError) AllErrors() []error { return m }
+
+// RollbackTenantRepoRequestValidationError is the validation error returned by
+// RollbackTenantRepoRequest.Validate if the designated constraints aren't met.
+type RollbackTenantRepoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackTenantRepoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackTenantRepoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackTenantRepoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackTenantRepoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackTenantRepoRequestValidationError) ErrorName() string {
+	return "RollbackTenantRepoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackTenantRepoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackTenantRepoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackTenantRepoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackTenantRepoRequestValidationError{}
+
+// Validate checks the field values on RollbackTenantRepoReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackTenantRepoReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackTenantRepoReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackTenantRepoReplyMultiError, or nil if none found.
+func (m *RollbackTenantRepoReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackTenantRepoReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RollbackTenantRepoReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RollbackTenantRepoReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RollbackTenantRepoReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RollbackTenantRepoReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RollbackTenantRepoReplyMultiError is an error wrapping multiple validation
+// errors returned by RollbackTenantRepoReply.ValidateAll() if the designated
+// constraints aren't met.
+type RollbackTenantRepoReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackTenantRepoReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackTenantRepoReplyMultiError) AllErrors() []error { return m }
+
+// RollbackTenantRepoReplyValidationError is the validation error returned by
+// RollbackTenantRepoReply.Validate if the designated constraints aren't met.
+type RollbackTenantRepoReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackTenantRepoReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackTenantRepoReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackTenantRepoReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackTenantRepoReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackTenantRepoReplyValidationError) ErrorName() string {
+	return "RollbackTenantRepoReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackTenantRepoReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackTenantRepoReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackTenantRepoReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackTenantRepoReplyValidationError{}
+
+// Validate checks the field values on UndeleteTenantRepoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UndeleteTenantRepoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UndeleteTenantRepoRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UndeleteTenantRepoRequestMultiError, or nil if none found.
+func (m *UndeleteTenantRepoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UndeleteTenantRepoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMeta() == nil {
+		err := UndeleteTenantRepoRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UndeleteTenantRepoRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UndeleteTenantRepoRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UndeleteTenantRepoRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UndeleteTenantRepoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UndeleteTenantRepoRequestMultiError is an error wrapping multiple validation
+// errors returned by UndeleteTenantRepoRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UndeleteTenantRepoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UndeleteTenantRepoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UndeleteTenantRepoRequestMultiError) AllErrors() []error { return m }
+
+// UndeleteTenantRepoRequestValidationError is the validation error returned by
+// UndeleteTenantRepoRequest.Validate if the designated constraints aren't met.
+type UndeleteTenantRepoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UndeleteTenantRepoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UndeleteTenantRepoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UndeleteTenantRepoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UndeleteTenantRepoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UndeleteTenantRepoRequestValidationError) ErrorName() string {
+	return "UndeleteTenantRepoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteTenantRepoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteTenantRepoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UndeleteTenantRepoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UndeleteTenantRepoRequestValidationError{}
+
+// Validate checks the field values on UndeleteTenantRepoReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UndeleteTenantRepoReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UndeleteTenantRepoReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UndeleteTenantRepoReplyMultiError, or nil if none found.
+func (m *UndeleteTenantRepoReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UndeleteTenantRepoReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UndeleteTenantRepoReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UndeleteTenantRepoReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UndeleteTenantRepoReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UndeleteTenantRepoReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UndeleteTenantRepoReplyMultiError is an error wrapping multiple validation
+// errors returned by UndeleteTenantRepoReply.ValidateAll() if the designated
+// constraints aren't met.
+type UndeleteTenantRepoReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UndeleteTenantRepoReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UndeleteTenantRepoReplyMultiError) AllErrors() []error { return m }
+
+// UndeleteTenantRepoReplyValidationError is the validation error returned by
+// UndeleteTenantRepoReply.Validate if the designated constraints aren't met.
+type UndeleteTenantRepoReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UndeleteTenantRepoReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UndeleteTenantRepoReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UndeleteTenantRepoReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UndeleteTenantRepoReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UndeleteTenantRepoReplyValidationError) ErrorName() string {
+	return "UndeleteTenantRepoReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteTenantRepoReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteTenantRepoReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UndeleteTenantRepoReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UndeleteTenantRepoReplyValidationError{}
+
+// Validate checks the field values on PurgeTenantRepoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PurgeTenantRepoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeTenantRepoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeTenantRepoRequestMultiError, or nil if none found.
+func (m *PurgeTenantRepoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeTenantRepoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMeta() == nil {
+		err := PurgeTenantRepoRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PurgeTenantRepoRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PurgeTenantRepoRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PurgeTenantRepoRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PurgeTenantRepoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeTenantRepoRequestMultiError is an error wrapping multiple validation
+// errors returned by PurgeTenantRepoRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PurgeTenantRepoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeTenantRepoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeTenantRepoRequestMultiError) AllErrors() []error { return m }
+
+// PurgeTenantRepoRequestValidationError is the validation error returned by
+// PurgeTenantRepoRequest.Validate if the designated constraints aren't met.
+type PurgeTenantRepoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeTenantRepoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeTenantRepoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeTenantRepoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeTenantRepoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeTenantRepoRequestValidationError) ErrorName() string {
+	return "PurgeTenantRepoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PurgeTenantRepoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeTenantRepoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeTenantRepoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeTenantRepoRequestValidationError{}
+
+// Validate checks the field values on RenderTenantRepoDockerConfigRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RenderTenantRepoDockerConfigRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenderTenantRepoDockerConfigRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RenderTenantRepoDockerConfigRequestMultiError, or nil if none found.
+func (m *RenderTenantRepoDockerConfigRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenderTenantRepoDockerConfigRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMeta() == nil {
+		err := RenderTenantRepoDockerConfigRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RenderTenantRepoDockerConfigRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RenderTenantRepoDockerConfigRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RenderTenantRepoDockerConfigRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetName()) > 253 {
+		err := RenderTenantRepoDockerConfigRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 253 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RenderTenantRepoDockerConfigRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := RenderTenantRepoDockerConfigRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^([a-z0-9]([-a-z0-9.]*[a-z0-9])?)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := RenderTenantRepoDockerConfigRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RenderTenantRepoDockerConfigRequest_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := RenderTenantRepoDockerConfigRequestValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^([a-z0-9]([-a-z0-9]*[a-z0-9])?)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RenderTenantRepoDockerConfigRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenderTenantRepoDockerConfigRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// RenderTenantRepoDockerConfigRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RenderTenantRepoDockerConfigRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenderTenantRepoDockerConfigRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenderTenantRepoDockerConfigRequestMultiError) AllErrors() []error { return m }
+
+// RenderTenantRepoDockerConfigRequestValidationError is the validation error
+// returned by RenderTenantRepoDockerConfigRequest.Validate if the designated
+// constraints aren't met.
+type RenderTenantRepoDockerConfigRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenderTenantRepoDockerConfigRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenderTenantRepoDockerConfigRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenderTenantRepoDockerConfigRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenderTenantRepoDockerConfigRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenderTenantRepoDockerConfigRequestValidationError) ErrorName() string {
+	return "RenderTenantRepoDockerConfigRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenderTenantRepoDockerConfigRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenderTenantRepoDockerConfigRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenderTenantRepoDockerConfigRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenderTenantRepoDockerConfigRequestValidationError{}
+
+var _RenderTenantRepoDockerConfigRequest_Name_Pattern = regexp.MustCompile("^([a-z0-9]([-a-z0-9.]*[a-z0-9])?)?$")
+
+var _RenderTenantRepoDockerConfigRequest_Namespace_Pattern = regexp.MustCompile("^([a-z0-9]([-a-z0-9]*[a-z0-9])?)?$")
+
+// Validate checks the field values on DockerConfigReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DockerConfigReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DockerConfigReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DockerConfigReplyMultiError, or nil if none found.
+func (m *DockerConfigReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DockerConfigReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Manifest
+
+	if len(errors) > 0 {
+		return DockerConfigReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DockerConfigReplyMultiError is an error wrapping multiple validation errors
+// returned by DockerConfigReply.ValidateAll() if the designated constraints
+// aren't met.
+type DockerConfigReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DockerConfigReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DockerConfigReplyMultiError) AllErrors() []error { return m }
+
+// DockerConfigReplyValidationError is the validation error returned by
+// DockerConfigReply.Validate if the designated constraints aren't met.
+type DockerConfigReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DockerConfigReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DockerConfigReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DockerConfigReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DockerConfigReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DockerConfigReplyValidationError) ErrorName() string {
+	return "DockerConfigReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DockerConfigReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDockerConfigReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DockerConfigReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DockerConfigReplyValidationError{}
+
+// Validate checks the field values on ClusterAccount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClusterAccount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClusterAccount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClusterAccountMultiError,
+// or nil if none found.
+func (m *ClusterAccount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClusterAccount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kubeconfig
+
+	// no validation rules for StripContexts
+
+	if len(errors) > 0 {
+		return ClusterAccountMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClusterAccountMultiError is an error wrapping multiple validation errors
+// returned by ClusterAccount.ValidateAll() if the designated constraints
+// aren't met.
+type ClusterAccountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClusterAccountMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClusterAccountMultiError) AllErrors() []error { return m }
+
+// ClusterAccountValidationError is the validation error returned by
+// ClusterAccount.Validate if the designated constraints aren't met.
+type ClusterAccountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClusterAccountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClusterAccountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClusterAccountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClusterAccountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClusterAccountValidationError) ErrorName() string { return "ClusterAccountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClusterAccountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClusterAccount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClusterAccountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClusterAccountValidationError{}
+
+// Validate checks the field values on ClusterMeta with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClusterMeta) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClusterMeta with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClusterMetaMultiError, or
+// nil if none found.
+func (m *ClusterMeta) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClusterMeta) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetType()) < 1 {
+		err := ClusterMetaValidationError{
+			field:  "Type",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := ClusterMetaValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsername()) < 1 {
+		err := ClusterMetaValidationError{
+			field:  "Username",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPermission()) < 1 {
+		err := ClusterMetaValidationError{
+			field:  "Permission",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ClusterMetaMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClusterMetaMultiError is an error wrapping multiple validation errors
+// returned by ClusterMeta.ValidateAll() if the designated constraints aren't met.
+type ClusterMetaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClusterMetaMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClusterMetaMultiError) AllErrors() []error { return m }
+
+// ClusterMetaValidationError is the validation error returned by
+// ClusterMeta.Validate if the designated constraints aren't met.
+type ClusterMetaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClusterMetaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClusterMetaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClusterMetaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClusterMetaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClusterMetaValidationError) ErrorName() string { return "ClusterMetaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClusterMetaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClusterMeta.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClusterMetaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClusterMetaValidationError{}
+
+// Validate checks the field values on ClusterRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClusterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClusterRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClusterRequestMultiError,
+// or nil if none found.
+func (m *ClusterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClusterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMeta() == nil {
+		err := ClusterRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetAccount() == nil {
+		err := ClusterRequestValidationError{
+			field:  "Account",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterRequestValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterRequestValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterRequestValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Ttl
+
+	// no validation rules for ExpireTime
+
+	if m.ExpectedVersion != nil {
+
+		if m.GetExpectedVersion() < 0 {
+			err := ClusterRequestValidationError{
+				field:  "ExpectedVersion",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ClusterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClusterRequestMultiError is an error wrapping multiple validation errors
+// returned by ClusterRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ClusterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClusterRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClusterRequestMultiError) AllErrors() []error { return m }
+
+// ClusterRequestValidationError is the validation error returned by
+// ClusterRequest.Validate if the designated constraints aren't met.
+type ClusterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClusterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClusterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClusterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClusterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClusterRequestValidationError) ErrorName() string { return "ClusterRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClusterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClusterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClusterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClusterRequestValidationError{}
+
+// Validate checks the field values on CreateClusterReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateClusterReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateClusterReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateClusterReplyMultiError, or nil if none found.
+func (m *CreateClusterReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateClusterReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateClusterReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateClusterReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateClusterReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDryRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateClusterReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateClusterReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDryRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateClusterReplyValidationError{
+				field:  "DryRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateClusterReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateClusterReplyMultiError is an error wrapping multiple validation errors
+// returned by CreateClusterReply.ValidateAll() if the designated constraints
+// aren't met.
+type CreateClusterReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateClusterReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateClusterReplyMultiError) AllErrors() []error { return m }
+
+// CreateClusterReplyValidationError is the validation error returned by
+// CreateClusterReply.Validate if the designated constraints aren't met.
+type CreateClusterReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateClusterReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateClusterReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateClusterReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateClusterReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateClusterReplyValidationError) ErrorName() string {
+	return "CreateClusterReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateClusterReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateClusterReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateClusterReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateClusterReplyValidationError{}
+
+// Validate checks the field values on DeleteClusterReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteClusterReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteClusterReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteClusterReplyMultiError, or nil if none found.
+func (m *DeleteClusterReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteClusterReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Msg
+
+	if all {
+		switch v := interface{}(m.GetDryRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteClusterReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteClusterReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDryRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteClusterReplyValidationError{
+				field:  "DryRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteClusterReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteClusterReplyMultiError is an error wrapping multiple validation errors
+// returned by DeleteClusterReply.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteClusterReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteClusterReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteClusterReplyMultiError) AllErrors() []error { return m }
+
+// DeleteClusterReplyValidationError is the validation error returned by
+// DeleteClusterReply.Validate if the designated constraints aren't met.
+type DeleteClusterReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteClusterReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteClusterReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteClusterReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteClusterReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteClusterReplyValidationError) ErrorName() string {
+	return "DeleteClusterReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteClusterReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteClusterReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteClusterReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteClusterReplyValidationError{}
+
+// Validate checks the field values on GetClusterRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetClusterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetClusterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetClusterRequestMultiError, or nil if none found.
+func (m *GetClusterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetClusterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMeta() == nil {
+		err := GetClusterRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetClusterRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetClusterRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetClusterRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetClusterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetClusterRequestMultiError is an error wrapping multiple validation errors
+// returned by GetClusterRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetClusterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetClusterRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetClusterRequestMultiError) AllErrors() []error { return m }
+
+// GetClusterRequestValidationError is the validation error returned by
+// GetClusterRequest.Validate if the designated constraints aren't met.
+type GetClusterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetClusterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetClusterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetClusterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetClusterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetClusterRequestValidationError) ErrorName() string {
+	return "GetClusterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetClusterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetClusterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetClusterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetClusterRequestValidationError{}
+
+// Validate checks the field values on GetClusterReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetClusterReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetClusterReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetClusterReplyMultiError, or nil if none found.
+func (m *GetClusterReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetClusterReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetClusterReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetClusterReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetClusterReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetClusterReplyValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetClusterReplyValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetClusterReplyValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetClusterReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetClusterReplyMultiError is an error wrapping multiple validation errors
+// returned by GetClusterReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetClusterReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetClusterReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetClusterReplyMultiError) AllErrors() []error { return m }
+
+// GetClusterReplyValidationError is the validation error returned by
+// GetClusterReply.Validate if the designated constraints aren't met.
+type GetClusterReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetClusterReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetClusterReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetClusterReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetClusterReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetClusterReplyValidationError) ErrorName() string { return "GetClusterReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetClusterReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetClusterReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetClusterReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetClusterReplyValidationError{}
+
+// Validate checks the field values on ListClusterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListClusterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListClusterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListClusterRequestMultiError, or nil if none found.
+func (m *ListClusterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListClusterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Id
+
+	// no validation rules for Username
+
+	// no validation rules for Permission
+
+	if val := m.GetPageSize(); val < 0 || val > 1000 {
+		err := ListClusterRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListClusterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListClusterRequestMultiError is an error wrapping multiple validation errors
+// returned by ListClusterRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListClusterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListClusterRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListClusterRequestMultiError) AllErrors() []error { return m }
+
+// ListClusterRequestValidationError is the validation error returned by
+// ListClusterRequest.Validate if the designated constraints aren't met.
+type ListClusterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListClusterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListClusterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListClusterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListClusterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListClusterRequestValidationError) ErrorName() string {
+	return "ListClusterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListClusterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListClusterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListClusterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListClusterRequestValidationError{}
+
+// Validate checks the field values on ListClusterReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListClusterReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListClusterReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListClusterReplyMultiError, or nil if none found.
+func (m *ListClusterReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListClusterReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSecrets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListClusterReplyValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListClusterReplyValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListClusterReplyValidationError{
+					field:  fmt.Sprintf("Secrets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListClusterReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListClusterReplyMultiError is an error wrapping multiple validation errors
+// returned by ListClusterReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListClusterReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListClusterReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListClusterReplyMultiError) AllErrors() []error { return m }
+
+// ListClusterReplyValidationError is the validation error returned by
+// ListClusterReply.Validate if the designated constraints aren't met.
+type ListClusterReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListClusterReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListClusterReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListClusterReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListClusterReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListClusterReplyValidationError) ErrorName() string { return "ListClusterReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListClusterReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListClusterReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListClusterReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListClusterReplyValidationError{}
+
+// Validate checks the field values on ListClusterVersionsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListClusterVersionsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListClusterVersionsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListClusterVersionsReplyMultiError, or nil if none found.
+func (m *ListClusterVersionsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListClusterVersionsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListClusterVersionsReplyValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListClusterVersionsReplyValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListClusterVersionsReplyValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListClusterVersionsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListClusterVersionsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListClusterVersionsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListClusterVersionsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListClusterVersionsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListClusterVersionsReplyMultiError) AllErrors() []error { return m }
+
+// ListClusterVersionsReplyValidationError is the validation error returned by
+// ListClusterVersionsReply.Validate if the designated constraints aren't met.
+type ListClusterVersionsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListClusterVersionsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListClusterVersionsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListClusterVersionsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListClusterVersionsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListClusterVersionsReplyValidationError) ErrorName() string {
+	return "ListClusterVersionsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListClusterVersionsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListClusterVersionsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListClusterVersionsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListClusterVersionsReplyValidationError{}
+
+// Validate checks the field values on RollbackClusterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackClusterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackClusterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackClusterRequestMultiError, or nil if none found.
+func (m *RollbackClusterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackClusterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMeta() == nil {
+		err := RollbackClusterRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RollbackClusterRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RollbackClusterRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RollbackClusterRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetVersion() <= 0 {
+		err := RollbackClusterRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RollbackClusterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RollbackClusterRequestMultiError is an error wrapping multiple validation
+// errors returned by RollbackClusterRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RollbackClusterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackClusterRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackClusterRequestMultiError) AllErrors() []error { return m }
+
+// RollbackClusterRequestValidationError is the validation error returned by
+// RollbackClusterRequest.Validate if the designated constraints aren't met.
+type RollbackClusterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackClusterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackClusterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackClusterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackClusterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackClusterRequestValidationError) ErrorName() string {
+	return "RollbackClusterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackClusterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackClusterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackClusterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackClusterRequestValidationError{}
+
+// Validate checks the field values on RollbackClusterReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackClusterReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackClusterReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackClusterReplyMultiError, or nil if none found.
+func (m *RollbackClusterReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackClusterReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RollbackClusterReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RollbackClusterReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RollbackClusterReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RollbackClusterReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RollbackClusterReplyMultiError is an error wrapping multiple validation
+// errors returned by RollbackClusterReply.ValidateAll() if the designated
+// constraints aren't met.
+type RollbackClusterReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackClusterReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackClusterReplyMultiError) AllErrors() []error { return m }
+
+// RollbackClusterReplyValidationError is the validation error returned by
+// RollbackClusterReply.Validate if the designated constraints aren't met.
+type RollbackClusterReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackClusterReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackClusterReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackClusterReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackClusterReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackClusterReplyValidationError) ErrorName() string {
+	return "RollbackClusterReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackClusterReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackClusterReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackClusterReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackClusterReplyValidationError{}
+
+// Validate checks the field values on UndeleteClusterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UndeleteClusterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UndeleteClusterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UndeleteClusterRequestMultiError, or nil if none found.
+func (m *UndeleteClusterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UndeleteClusterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMeta() == nil {
+		err := UndeleteClusterRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UndeleteClusterRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UndeleteClusterRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UndeleteClusterRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UndeleteClusterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UndeleteClusterRequestMultiError is an error wrapping multiple validation
+// errors returned by UndeleteClusterRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UndeleteClusterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UndeleteClusterRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UndeleteClusterRequestMultiError) AllErrors() []error { return m }
+
+// UndeleteClusterRequestValidationError is the validation error returned by
+// UndeleteClusterRequest.Validate if the designated constraints aren't met.
+type UndeleteClusterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UndeleteClusterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UndeleteClusterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UndeleteClusterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UndeleteClusterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UndeleteClusterRequestValidationError) ErrorName() string {
+	return "UndeleteClusterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteClusterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteClusterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UndeleteClusterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UndeleteClusterRequestValidationError{}
+
+// Validate checks the field values on UndeleteClusterReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UndeleteClusterReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UndeleteClusterReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UndeleteClusterReplyMultiError, or nil if none found.
+func (m *UndeleteClusterReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UndeleteClusterReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UndeleteClusterReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UndeleteClusterReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UndeleteClusterReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UndeleteClusterReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UndeleteClusterReplyMultiError is an error wrapping multiple validation
+// errors returned by UndeleteClusterReply.ValidateAll() if the designated
+// constraints aren't met.
+type UndeleteClusterReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UndeleteClusterReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UndeleteClusterReplyMultiError) AllErrors() []error { return m }
+
+// UndeleteClusterReplyValidationError is the validation error returned by
+// UndeleteClusterReply.Validate if the designated constraints aren't met.
+type UndeleteClusterReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UndeleteClusterReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UndeleteClusterReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UndeleteClusterReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UndeleteClusterReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UndeleteClusterReplyValidationError) ErrorName() string {
+	return "UndeleteClusterReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteClusterReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteClusterReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UndeleteClusterReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UndeleteClusterReplyValidationError{}
+
+// Validate checks the field values on PurgeClusterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PurgeClusterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeClusterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeClusterRequestMultiError, or nil if none found.
+func (m *PurgeClusterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeClusterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMeta() == nil {
+		err := PurgeClusterRequestValidationError{
+			field:  "Meta",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PurgeClusterRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PurgeClusterRequestValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PurgeClusterRequestValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PurgeClusterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeClusterRequestMultiError is an error wrapping multiple validation
+// errors returned by PurgeClusterRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PurgeClusterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeClusterRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeClusterRequestMultiError) AllErrors() []error { return m }
+
+// PurgeClusterRequestValidationError is the validation error returned by
+// PurgeClusterRequest.Validate if the designated constraints aren't met.
+type PurgeClusterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeClusterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeClusterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeClusterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeClusterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeClusterRequestValidationError) ErrorName() string {
+	return "PurgeClusterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PurgeClusterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeClusterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeClusterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeClusterRequestValidationError{}
+
+// Validate checks the field values on CreateSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSecretRequestMultiError, or nil if none found.
+func (m *CreateSecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetType()) < 1 {
+		err := CreateSecretRequestValidationError{
+			field:  "Type",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Meta
+
+	// no validation rules for Data
+
+	// no validation rules for Ttl
+
+	// no validation rules for ExpireTime
+
+	if m.ExpectedVersion != nil {
+
+		if m.GetExpectedVersion() < 0 {
+			err := CreateSecretRequestValidationError{
+				field:  "ExpectedVersion",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateSecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSecretRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSecretRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSecretRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSecretRequestMultiError) AllErrors() []error { return m }
+
+// CreateSecretRequestValidationError is the validation error returned by
+// CreateSecretRequest.Validate if the designated constraints aren't met.
+type CreateSecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSecretRequestValidationError) ErrorName() string {
+	return "CreateSecretRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSecretRequestValidationError{}
+
+// Validate checks the field values on CreateSecretReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateSecretReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSecretReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSecretReplyMultiError, or nil if none found.
+func (m *CreateSecretReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSecretReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSecretReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSecretReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSecretReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDryRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSecretReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSecretReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDryRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSecretReplyValidationError{
+				field:  "DryRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateSecretReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSecretReplyMultiError is an error wrapping multiple validation errors
+// returned by CreateSecretReply.ValidateAll() if the designated constraints
+// aren't met.
+type CreateSecretReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSecretReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSecretReplyMultiError) AllErrors() []error { return m }
+
+// CreateSecretReplyValidationError is the validation error returned by
+// CreateSecretReply.Validate if the designated constraints aren't met.
+type CreateSecretReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSecretReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSecretReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSecretReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSecretReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSecretReplyValidationError) ErrorName() string {
+	return "CreateSecretReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSecretReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSecretReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSecretReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSecretReplyValidationError{}
+
+// Validate checks the field values on GetSecretRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetSecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSecretRequestMultiError, or nil if none found.
+func (m *GetSecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetType()) < 1 {
+		err := GetSecretRequestValidationError{
+			field:  "Type",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Meta
+
+	if len(errors) > 0 {
+		return GetSecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSecretRequestMultiError is an error wrapping multiple validation errors
+// returned by GetSecretRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetSecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSecretRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSecretRequestMultiError) AllErrors() []error { return m }
+
+// GetSecretRequestValidationError is the validation error returned by
+// GetSecretRequest.Validate if the designated constraints aren't met.
+type GetSecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSecretRequestValidationError) ErrorName() string { return "GetSecretRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetSecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSecretRequestValidationError{}
+
+// Validate checks the field values on GetSecretReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetSecretReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSecretReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetSecretReplyMultiError,
+// or nil if none found.
+func (m *GetSecretReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSecretReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSecretReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSecretReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSecretReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSecretReplyValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSecretReplyValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSecretReplyValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSecretReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSecretReplyMultiError is an error wrapping multiple validation errors
+// returned by GetSecretReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetSecretReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSecretReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSecretReplyMultiError) AllErrors() []error { return m }
+
+// GetSecretReplyValidationError is the validation error returned by
+// GetSecretReply.Validate if the designated constraints aren't met.
+type GetSecretReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSecretReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSecretReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSecretReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSecretReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSecretReplyValidationError) ErrorName() string { return "GetSecretReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetSecretReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSecretReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSecretReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSecretReplyValidationError{}
+
+// Validate checks the field values on DeleteSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSecretRequestMultiError, or nil if none found.
+func (m *DeleteSecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetType()) < 1 {
+		err := DeleteSecretRequestValidationError{
+			field:  "Type",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Meta
+
+	if len(errors) > 0 {
+		return DeleteSecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSecretRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSecretRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSecretRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSecretRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSecretRequestValidationError is the validation error returned by
+// DeleteSecretRequest.Validate if the designated constraints aren't met.
+type DeleteSecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSecretRequestValidationError) ErrorName() string {
+	return "DeleteSecretRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSecretRequestValidationError{}
+
+// Validate checks the field values on DeleteSecretReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSecretReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSecretReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSecretReplyMultiError, or nil if none found.
+func (m *DeleteSecretReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSecretReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Msg
+
+	if all {
+		switch v := interface{}(m.GetDryRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteSecretReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteSecretReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDryRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteSecretReplyValidationError{
+				field:  "DryRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteSecretReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSecretReplyMultiError is an error wrapping multiple validation errors
+// returned by DeleteSecretReply.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteSecretReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSecretReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSecretReplyMultiError) AllErrors() []error { return m }
+
+// DeleteSecretReplyValidationError is the validation error returned by
+// DeleteSecretReply.Validate if the designated constraints aren't met.
+type DeleteSecretReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSecretReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSecretReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSecretReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSecretReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSecretReplyValidationError) ErrorName() string {
+	return "DeleteSecretReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSecretReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSecretReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSecretReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSecretReplyValidationError{}
+
+// Validate checks the field values on UndeleteSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UndeleteSecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UndeleteSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UndeleteSecretRequestMultiError, or nil if none found.
+func (m *UndeleteSecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UndeleteSecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetType()) < 1 {
+		err := UndeleteSecretRequestValidationError{
+			field:  "Type",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Meta
+
+	if len(errors) > 0 {
+		return UndeleteSecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UndeleteSecretRequestMultiError is an error wrapping multiple validation
+// errors returned by UndeleteSecretRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UndeleteSecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UndeleteSecretRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UndeleteSecretRequestMultiError) AllErrors() []error { return m }
+
+// UndeleteSecretRequestValidationError is the validation error returned by
+// UndeleteSecretRequest.Validate if the designated constraints aren't met.
+type UndeleteSecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UndeleteSecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UndeleteSecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UndeleteSecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UndeleteSecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UndeleteSecretRequestValidationError) ErrorName() string {
+	return "UndeleteSecretRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteSecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteSecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UndeleteSecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UndeleteSecretRequestValidationError{}
+
+// Validate checks the field values on UndeleteSecretReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UndeleteSecretReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UndeleteSecretReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UndeleteSecretReplyMultiError, or nil if none found.
+func (m *UndeleteSecretReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UndeleteSecretReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UndeleteSecretReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UndeleteSecretReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UndeleteSecretReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UndeleteSecretReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UndeleteSecretReplyMultiError is an error wrapping multiple validation
+// errors returned by UndeleteSecretReply.ValidateAll() if the designated
+// constraints aren't met.
+type UndeleteSecretReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UndeleteSecretReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UndeleteSecretReplyMultiError) AllErrors() []error { return m }
+
+// UndeleteSecretReplyValidationError is the validation error returned by
+// UndeleteSecretReply.Validate if the designated constraints aren't met.
+type UndeleteSecretReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UndeleteSecretReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UndeleteSecretReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UndeleteSecretReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UndeleteSecretReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UndeleteSecretReplyValidationError) ErrorName() string {
+	return "UndeleteSecretReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteSecretReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteSecretReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UndeleteSecretReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UndeleteSecretReplyValidationError{}
+
+// Validate checks the field values on PurgeSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PurgeSecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeSecretRequestMultiError, or nil if none found.
+func (m *PurgeSecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeSecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetType()) < 1 {
+		err := PurgeSecretRequestValidationError{
+			field:  "Type",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Meta
+
+	if len(errors) > 0 {
+		return PurgeSecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeSecretRequestMultiError is an error wrapping multiple validation errors
+// returned by PurgeSecretRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PurgeSecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeSecretRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeSecretRequestMultiError) AllErrors() []error { return m }
+
+// PurgeSecretRequestValidationError is the validation error returned by
+// PurgeSecretRequest.Validate if the designated constraints aren't met.
+type PurgeSecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeSecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeSecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeSecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeSecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeSecretRequestValidationError) ErrorName() string {
+	return "PurgeSecretRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PurgeSecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeSecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeSecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeSecretRequestValidationError{}
+
+// Validate checks the field values on UpdateSecretOwnersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSecretOwnersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSecretOwnersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSecretOwnersRequestMultiError, or nil if none found.
+func (m *UpdateSecretOwnersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSecretOwnersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetType()) < 1 {
+		err := UpdateSecretOwnersRequestValidationError{
+			field:  "Type",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Meta
+
+	_UpdateSecretOwnersRequest_CoOwners_Unique := make(map[string]struct{}, len(m.GetCoOwners()))
+
+	for idx, item := range m.GetCoOwners() {
+		_, _ = idx, item
+
+		if _, exists := _UpdateSecretOwnersRequest_CoOwners_Unique[item]; exists {
+			err := UpdateSecretOwnersRequestValidationError{
+				field:  fmt.Sprintf("CoOwners[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_UpdateSecretOwnersRequest_CoOwners_Unique[item] = struct{}{}
+		}
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := UpdateSecretOwnersRequestValidationError{
+				field:  fmt.Sprintf("CoOwners[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_UpdateSecretOwnersRequest_CoOwners_Pattern.MatchString(item) {
+			err := UpdateSecretOwnersRequestValidationError{
+				field:  fmt.Sprintf("CoOwners[%v]", idx),
+				reason: "value does not match regex pattern \"^[^,]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateSecretOwnersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSecretOwnersRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateSecretOwnersRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateSecretOwnersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSecretOwnersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSecretOwnersRequestMultiError) AllErrors() []error { return m }
+
+// UpdateSecretOwnersRequestValidationError is the validation error returned by
+// UpdateSecretOwnersRequest.Validate if the designated constraints aren't met.
+type UpdateSecretOwnersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSecretOwnersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSecretOwnersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSecretOwnersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSecretOwnersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSecretOwnersRequestValidationError) ErrorName() string {
+	return "UpdateSecretOwnersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSecretOwnersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSecretOwnersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSecretOwnersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSecretOwnersRequestValidationError{}
+
+var _UpdateSecretOwnersRequest_CoOwners_Pattern = regexp.MustCompile("^[^,]+$")
+
+// Validate checks the field values on UpdateSecretOwnersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSecretOwnersReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSecretOwnersReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSecretOwnersReplyMultiError, or nil if none found.
+func (m *UpdateSecretOwnersReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSecretOwnersReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSecretOwnersReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSecretOwnersReplyValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSecretOwnersReplyValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSecretOwnersReplyValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSecretOwnersReplyValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSecretOwnersReplyValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateSecretOwnersReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSecretOwnersReplyMultiError is an error wrapping multiple validation
+// errors returned by UpdateSecretOwnersReply.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateSecretOwnersReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSecretOwnersReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSecretOwnersReplyMultiError) AllErrors() []error { return m }
+
+// UpdateSecretOwnersReplyValidationError is the validation error returned by
+// UpdateSecretOwnersReply.Validate if the designated constraints aren't met.
+type UpdateSecretOwnersReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSecretOwnersReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSecretOwnersReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSecretOwnersReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSecretOwnersReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSecretOwnersReplyValidationError) ErrorName() string {
+	return "UpdateSecretOwnersReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSecretOwnersReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSecretOwnersReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSecretOwnersReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSecretOwnersReplyValidationError{}
+
+// Validate checks the field values on BatchCreateSecretsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchCreateSecretsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchCreateSecretsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchCreateSecretsRequestMultiError, or nil if none found.
+func (m *BatchCreateSecretsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchCreateSecretsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetItems()); l < 1 || l > 100 {
+		err := BatchCreateSecretsRequestValidationError{
+			field:  "Items",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchCreateSecretsRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchCreateSecretsRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchCreateSecretsRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Atomic
+
+	if len(errors) > 0 {
+		return BatchCreateSecretsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchCreateSecretsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchCreateSecretsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type BatchCreateSecretsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchCreateSecretsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchCreateSecretsRequestMultiError) AllErrors() []error { return m }
+
+// BatchCreateSecretsRequestValidationError is the validation error returned by
+// BatchCreateSecretsRequest.Validate if the designated constraints aren't met.
+type BatchCreateSecretsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchCreateSecretsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchCreateSecretsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchCreateSecretsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchCreateSecretsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchCreateSecretsRequestValidationError) ErrorName() string {
+	return "BatchCreateSecretsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCreateSecretsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCreateSecretsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchCreateSecretsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchCreateSecretsRequestValidationError{}
+
+// Validate checks the field values on BatchDeleteSecretsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchDeleteSecretsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchDeleteSecretsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchDeleteSecretsRequestMultiError, or nil if none found.
+func (m *BatchDeleteSecretsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchDeleteSecretsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetItems()); l < 1 || l > 100 {
+		err := BatchDeleteSecretsRequestValidationError{
+			field:  "Items",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchDeleteSecretsRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchDeleteSecretsRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchDeleteSecretsRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Atomic
+
+	if len(errors) > 0 {
+		return BatchDeleteSecretsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchDeleteSecretsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchDeleteSecretsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type BatchDeleteSecretsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchDeleteSecretsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchDeleteSecretsRequestMultiError) AllErrors() []error { return m }
+
+// BatchDeleteSecretsRequestValidationError is the validation error returned by
+// BatchDeleteSecretsRequest.Validate if the designated constraints aren't met.
+type BatchDeleteSecretsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchDeleteSecretsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchDeleteSecretsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchDeleteSecretsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchDeleteSecretsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchDeleteSecretsRequestValidationError) ErrorName() string {
+	return "BatchDeleteSecretsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchDeleteSecretsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchDeleteSecretsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchDeleteSecretsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchDeleteSecretsRequestValidationError{}
+
+// Validate checks the field values on BatchSecretResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BatchSecretResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchSecretResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchSecretResultMultiError, or nil if none found.
+func (m *BatchSecretResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchSecretResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchSecretResultValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchSecretResultValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchSecretResultValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Reason
+
+	// no validation rules for Message
+
+	// no validation rules for RolledBack
+
+	if len(errors) > 0 {
+		return BatchSecretResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchSecretResultMultiError is an error wrapping multiple validation errors
+// returned by BatchSecretResult.ValidateAll() if the designated constraints
+// aren't met.
+type BatchSecretResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchSecretResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchSecretResultMultiError) AllErrors() []error { return m }
+
+// BatchSecretResultValidationError is the validation error returned by
+// BatchSecretResult.Validate if the designated constraints aren't met.
+type BatchSecretResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchSecretResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchSecretResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchSecretResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchSecretResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchSecretResultValidationError) ErrorName() string {
+	return "BatchSecretResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchSecretResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchSecretResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchSecretResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchSecretResultValidationError{}
+
+// Validate checks the field values on BatchSecretsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BatchSecretsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchSecretsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchSecretsReplyMultiError, or nil if none found.
+func (m *BatchSecretsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchSecretsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchSecretsReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchSecretsReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchSecretsReplyValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchSecretsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchSecretsReplyMultiError is an error wrapping multiple validation errors
+// returned by BatchSecretsReply.ValidateAll() if the designated constraints
+// aren't met.
+type BatchSecretsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchSecretsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchSecretsReplyMultiError) AllErrors() []error { return m }
+
+// BatchSecretsReplyValidationError is the validation error returned by
+// BatchSecretsReply.Validate if the designated constraints aren't met.
+type BatchSecretsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchSecretsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchSecretsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchSecretsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchSecretsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchSecretsReplyValidationError) ErrorName() string {
+	return "BatchSecretsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchSecretsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchSecretsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchSecretsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchSecretsReplyValidationError{}
+
+// Validate checks the field values on Kubernetes with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Kubernetes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Kubernetes with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in KubernetesMultiError, or
+// nil if none found.
+func (m *Kubernetes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Kubernetes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUrl()) < 1 {
+		err := KubernetesValidationError{
+			field:  "Url",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Cabundle
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := KubernetesValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return KubernetesMultiError(errors)
+	}
+
+	return nil
+}
+
+// KubernetesMultiError is an error wrapping multiple validation errors
+// returned by Kubernetes.ValidateAll() if the designated constraints aren't met.
+type KubernetesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KubernetesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KubernetesMultiError) AllErrors() []error { return m }
+
+// KubernetesValidationError is the validation error returned by
+// Kubernetes.Validate if the designated constraints aren't met.
+type KubernetesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KubernetesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KubernetesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KubernetesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KubernetesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KubernetesValidationError) ErrorName() string { return "KubernetesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KubernetesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKubernetes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KubernetesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KubernetesValidationError{}
+
+// Validate checks the field values on AuthRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuthRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuthRequestMultiError, or
+// nil if none found.
+func (m *AuthRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetClusterName()) < 1 {
+		err := AuthRequestValidationError{
+			field:  "ClusterName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAuthType()) < 1 {
+		err := AuthRequestValidationError{
+			field:  "AuthType",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetKubernetes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthRequestValidationError{
+					field:  "Kubernetes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthRequestValidationError{
+					field:  "Kubernetes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKubernetes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthRequestValidationError{
+				field:  "Kubernetes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuthRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthRequestMultiError is an error wrapping multiple validation errors
+// returned by AuthRequest.ValidateAll() if the designated constraints aren't met.
+type AuthRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthRequestMultiError) AllErrors() []error { return m }
+
+// AuthRequestValidationError is the validation error returned by
+// AuthRequest.Validate if the designated constraints aren't met.
+type AuthRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthRequestValidationError) ErrorName() string { return "AuthRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthRequestValidationError{}
+
+// Validate checks the field values on CreateAuthReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateAuthReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAuthReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAuthReplyMultiError, or nil if none found.
+func (m *CreateAuthReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAuthReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Msg
+
+	if all {
+		switch v := interface{}(m.GetDryRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAuthReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAuthReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDryRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAuthReplyValidationError{
+				field:  "DryRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateAuthReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAuthReplyMultiError is an error wrapping multiple validation errors
+// returned by CreateAuthReply.ValidateAll() if the designated constraints
+// aren't met.
+type CreateAuthReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAuthReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAuthReplyMultiError) AllErrors() []error { return m }
+
+// CreateAuthReplyValidationError is the validation error returned by
+// CreateAuthReply.Validate if the designated constraints aren't met.
+type CreateAuthReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAuthReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAuthReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAuthReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAuthReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAuthReplyValidationError) ErrorName() string { return "CreateAuthReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateAuthReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAuthReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAuthReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAuthReplyValidationError{}
+
+// Validate checks the field values on DeleteAuthReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAuthReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAuthReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAuthReplyMultiError, or nil if none found.
+func (m *DeleteAuthReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAuthReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Msg
+
+	if len(errors) > 0 {
+		return DeleteAuthReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAuthReplyMultiError is an error wrapping multiple validation errors
+// returned by DeleteAuthReply.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteAuthReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAuthReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAuthReplyMultiError) AllErrors() []error { return m }
+
+// DeleteAuthReplyValidationError is the validation error returned by
+// DeleteAuthReply.Validate if the designated constraints aren't met.
+type DeleteAuthReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAuthReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAuthReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAuthReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAuthReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAuthReplyValidationError) ErrorName() string { return "DeleteAuthReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteAuthReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAuthReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAuthReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAuthReplyValidationError{}
+
+// Validate checks the field values on KubernetesAuthRoleMeta with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *KubernetesAuthRoleMeta) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KubernetesAuthRoleMeta with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KubernetesAuthRoleMetaMultiError, or nil if none found.
+func (m *KubernetesAuthRoleMeta) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KubernetesAuthRoleMeta) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetNamespaces() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := KubernetesAuthRoleMetaValidationError{
+				field:  fmt.Sprintf("Namespaces[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	for idx, item := range m.GetServiceAccounts() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := KubernetesAuthRoleMetaValidationError{
+				field:  fmt.Sprintf("ServiceAccounts[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return KubernetesAuthRoleMetaMultiError(errors)
+	}
+
+	return nil
+}
+
+// KubernetesAuthRoleMetaMultiError is an error wrapping multiple validation
+// errors returned by KubernetesAuthRoleMeta.ValidateAll() if the designated
+// constraints aren't met.
+type KubernetesAuthRoleMetaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KubernetesAuthRoleMetaMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KubernetesAuthRoleMetaMultiError) AllErrors() []error { return m }
+
+// KubernetesAuthRoleMetaValidationError is the validation error returned by
+// KubernetesAuthRoleMeta.Validate if the designated constraints aren't met.
+type KubernetesAuthRoleMetaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KubernetesAuthRoleMetaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KubernetesAuthRoleMetaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KubernetesAuthRoleMetaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KubernetesAuthRoleMetaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KubernetesAuthRoleMetaValidationError) ErrorName() string {
+	return "KubernetesAuthRoleMetaValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e KubernetesAuthRoleMetaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKubernetesAuthRoleMeta.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KubernetesAuthRoleMetaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KubernetesAuthRoleMetaValidationError{}
+
+// Validate checks the field values on AuthroleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuthroleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthroleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthroleRequestMultiError, or nil if none found.
+func (m *AuthroleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthroleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetClusterName()) < 1 {
+		err := AuthroleRequestValidationError{
+			field:  "ClusterName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDestUser()) < 1 {
+		err := AuthroleRequestValidationError{
+			field:  "DestUser",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	switch v := m.Role.(type) {
+	case *AuthroleRequest_Kubernetes:
+		if v == nil {
+			err := AuthroleRequestValidationError{
+				field:  "Role",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetKubernetes()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuthroleRequestValidationError{
+						field:  "Kubernetes",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuthroleRequestValidationError{
+						field:  "Kubernetes",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetKubernetes()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuthroleRequestValidationError{
+					field:  "Kubernetes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
 	}
 
-	var errors []error
-
-	// no validation rules for Msg
-
 	if len(errors) > 0 {
-		return DeleteTenantRepoReplyMultiError(errors)
+		return AuthroleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteTenantRepoReplyMultiError is an error wrapping multiple validation
-// errors returned by DeleteTenantRepoReply.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteTenantRepoReplyMultiError []error
+// AuthroleRequestMultiError is an error wrapping multiple validation errors
+// returned by AuthroleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AuthroleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteTenantRepoReplyMultiError) Error() string {
+func (m AuthroleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3064,11 +15608,11 @@ func (m DeleteTenantRepoReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteTenantRepoReplyMultiError) AllErrors() []error { return m }
+func (m AuthroleRequestMultiError) AllErrors() []error { return m }
 
-// DeleteTenantRepoReplyValidationError is the validation error returned by
-// DeleteTenantRepoReply.Validate if the designated constraints aren't met.
-type DeleteTenantRepoReplyValidationError struct {
+// AuthroleRequestValidationError is the validation error returned by
+// AuthroleRequest.Validate if the designated constraints aren't met.
+type AuthroleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3076,24 +15620,22 @@ type DeleteTenantRepoReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteTenantRepoReplyValidationError) Field() string { return e.field }
+func (e AuthroleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteTenantRepoReplyValidationError) Reason() string { return e.reason }
+func (e AuthroleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteTenantRepoReplyValidationError) Cause() error { return e.cause }
+func (e AuthroleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteTenantRepoReplyValidationError) Key() bool { return e.key }
+func (e AuthroleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteTenantRepoReplyValidationError) ErrorName() string {
-	return "DeleteTenantRepoReplyValidationError"
-}
+func (e AuthroleRequestValidationError) ErrorName() string { return "AuthroleRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteTenantRepoReplyValidationError) Error() string {
+func (e AuthroleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3105,14 +15647,14 @@ func (e DeleteTenantRepoReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteTenantRepoReply.%s: %s%s",
+		"invalid %sAuthroleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteTenantRepoReplyValidationError{}
+var _ error = AuthroleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3120,46 +15662,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteTenantRepoReplyValidationError{}
+} = AuthroleRequestValidationError{}
 
-// Validate checks the field values on ClusterAccount with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ClusterAccount) Validate() error {
+// Validate checks the field values on CreateAuthroleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAuthroleReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ClusterAccount with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ClusterAccountMultiError,
-// or nil if none found.
-func (m *ClusterAccount) ValidateAll() error {
+// ValidateAll checks the field values on CreateAuthroleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAuthroleReplyMultiError, or nil if none found.
+func (m *CreateAuthroleReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ClusterAccount) validate(all bool) error {
+func (m *CreateAuthroleReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Kubeconfig
+	// no validation rules for Msg
+
+	if all {
+		switch v := interface{}(m.GetDryRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAuthroleReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAuthroleReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDryRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAuthroleReplyValidationError{
+				field:  "DryRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ClusterAccountMultiError(errors)
+		return CreateAuthroleReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// ClusterAccountMultiError is an error wrapping multiple validation errors
-// returned by ClusterAccount.ValidateAll() if the designated constraints
-// aren't met.
-type ClusterAccountMultiError []error
+// CreateAuthroleReplyMultiError is an error wrapping multiple validation
+// errors returned by CreateAuthroleReply.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAuthroleReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ClusterAccountMultiError) Error() string {
+func (m CreateAuthroleReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3168,11 +15739,11 @@ func (m ClusterAccountMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ClusterAccountMultiError) AllErrors() []error { return m }
+func (m CreateAuthroleReplyMultiError) AllErrors() []error { return m }
 
-// ClusterAccountValidationError is the validation error returned by
-// ClusterAccount.Validate if the designated constraints aren't met.
-type ClusterAccountValidationError struct {
+// CreateAuthroleReplyValidationError is the validation error returned by
+// CreateAuthroleReply.Validate if the designated constraints aren't met.
+type CreateAuthroleReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3180,22 +15751,24 @@ type ClusterAccountValidationError struct {
 }
 
 // Field function returns field value.
-func (e ClusterAccountValidationError) Field() string { return e.field }
+func (e CreateAuthroleReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ClusterAccountValidationError) Reason() string { return e.reason }
+func (e CreateAuthroleReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ClusterAccountValidationError) Cause() error { return e.cause }
+func (e CreateAuthroleReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ClusterAccountValidationError) Key() bool { return e.key }
+func (e CreateAuthroleReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ClusterAccountValidationError) ErrorName() string { return "ClusterAccountValidationError" }
+func (e CreateAuthroleReplyValidationError) ErrorName() string {
+	return "CreateAuthroleReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ClusterAccountValidationError) Error() string {
+func (e CreateAuthroleReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3207,14 +15780,14 @@ func (e ClusterAccountValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sClusterAccount.%s: %s%s",
+		"invalid %sCreateAuthroleReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ClusterAccountValidationError{}
+var _ error = CreateAuthroleReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3222,87 +15795,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ClusterAccountValidationError{}
+} = CreateAuthroleReplyValidationError{}
 
-// Validate checks the field values on ClusterMeta with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ClusterMeta) Validate() error {
+// Validate checks the field values on DeleteAuthroleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAuthroleReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ClusterMeta with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ClusterMetaMultiError, or
-// nil if none found.
-func (m *ClusterMeta) ValidateAll() error {
+// ValidateAll checks the field values on DeleteAuthroleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAuthroleReplyMultiError, or nil if none found.
+func (m *DeleteAuthroleReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ClusterMeta) validate(all bool) error {
+func (m *DeleteAuthroleReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetType()) < 1 {
-		err := ClusterMetaValidationError{
-			field:  "Type",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetId()) < 1 {
-		err := ClusterMetaValidationError{
-			field:  "Id",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetUsername()) < 1 {
-		err := ClusterMetaValidationError{
-			field:  "Username",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetPermission()) < 1 {
-		err := ClusterMetaValidationError{
-			field:  "Permission",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Msg
 
 	if len(errors) > 0 {
-		return ClusterMetaMultiError(errors)
+		return DeleteAuthroleReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// ClusterMetaMultiError is an error wrapping multiple validation errors
-// returned by ClusterMeta.ValidateAll() if the designated constraints aren't met.
-type ClusterMetaMultiError []error
+// DeleteAuthroleReplyMultiError is an error wrapping multiple validation
+// errors returned by DeleteAuthroleReply.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAuthroleReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ClusterMetaMultiError) Error() string {
+func (m DeleteAuthroleReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3311,11 +15843,11 @@ func (m ClusterMetaMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ClusterMetaMultiError) AllErrors() []error { return m }
+func (m DeleteAuthroleReplyMultiError) AllErrors() []error { return m }
 
-// ClusterMetaValidationError is the validation error returned by
-// ClusterMeta.Validate if the designated constraints aren't met.
-type ClusterMetaValidationError struct {
+// DeleteAuthroleReplyValidationError is the validation error returned by
+// DeleteAuthroleReply.Validate if the designated constraints aren't met.
+type DeleteAuthroleReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3323,22 +15855,24 @@ type ClusterMetaValidationError struct {
 }
 
 // Field function returns field value.
-func (e ClusterMetaValidationError) Field() string { return e.field }
+func (e DeleteAuthroleReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ClusterMetaValidationError) Reason() string { return e.reason }
+func (e DeleteAuthroleReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ClusterMetaValidationError) Cause() error { return e.cause }
+func (e DeleteAuthroleReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ClusterMetaValidationError) Key() bool { return e.key }
+func (e DeleteAuthroleReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ClusterMetaValidationError) ErrorName() string { return "ClusterMetaValidationError" }
+func (e DeleteAuthroleReplyValidationError) ErrorName() string {
+	return "DeleteAuthroleReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ClusterMetaValidationError) Error() string {
+func (e DeleteAuthroleReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3350,14 +15884,14 @@ func (e ClusterMetaValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sClusterMeta.%s: %s%s",
+		"invalid %sDeleteAuthroleReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ClusterMetaValidationError{}
+var _ error = DeleteAuthroleReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3365,34 +15899,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ClusterMetaValidationError{}
-
-// Validate checks the field values on ClusterRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ClusterRequest) Validate() error {
+} = DeleteAuthroleReplyValidationError{}
+
+// Validate checks the field values on AuthroleGitPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthroleGitPolicyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ClusterRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ClusterRequestMultiError,
-// or nil if none found.
-func (m *ClusterRequest) ValidateAll() error {
+// ValidateAll checks the field values on AuthroleGitPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthroleGitPolicyRequestMultiError, or nil if none found.
+func (m *AuthroleGitPolicyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ClusterRequest) validate(all bool) error {
+func (m *AuthroleGitPolicyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetMeta() == nil {
-		err := ClusterRequestValidationError{
-			field:  "Meta",
-			reason: "value is required",
+	if utf8.RuneCountInString(m.GetClusterName()) < 1 {
+		err := AuthroleGitPolicyRequestValidationError{
+			field:  "ClusterName",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -3400,38 +15934,20 @@ func (m *ClusterRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetMeta()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ClusterRequestValidationError{
-					field:  "Meta",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ClusterRequestValidationError{
-					field:  "Meta",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetDestUser()) < 1 {
+		err := AuthroleGitPolicyRequestValidationError{
+			field:  "DestUser",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ClusterRequestValidationError{
-				field:  "Meta",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	if m.GetAccount() == nil {
-		err := ClusterRequestValidationError{
-			field:  "Account",
+	if m.GetSecret() == nil {
+		err := AuthroleGitPolicyRequestValidationError{
+			field:  "Secret",
 			reason: "value is required",
 		}
 		if !all {
@@ -3441,28 +15957,28 @@ func (m *ClusterRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetAccount()).(type) {
+		switch v := interface{}(m.GetSecret()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ClusterRequestValidationError{
-					field:  "Account",
+				errors = append(errors, AuthroleGitPolicyRequestValidationError{
+					field:  "Secret",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ClusterRequestValidationError{
-					field:  "Account",
+				errors = append(errors, AuthroleGitPolicyRequestValidationError{
+					field:  "Secret",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ClusterRequestValidationError{
-				field:  "Account",
+			return AuthroleGitPolicyRequestValidationError{
+				field:  "Secret",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3470,19 +15986,19 @@ func (m *ClusterRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ClusterRequestMultiError(errors)
+		return AuthroleGitPolicyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ClusterRequestMultiError is an error wrapping multiple validation errors
-// returned by ClusterRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ClusterRequestMultiError []error
+// AuthroleGitPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by AuthroleGitPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AuthroleGitPolicyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ClusterRequestMultiError) Error() string {
+func (m AuthroleGitPolicyRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3491,11 +16007,11 @@ func (m ClusterRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ClusterRequestMultiError) AllErrors() []error { return m }
+func (m AuthroleGitPolicyRequestMultiError) AllErrors() []error { return m }
 
-// ClusterRequestValidationError is the validation error returned by
-// ClusterRequest.Validate if the designated constraints aren't met.
-type ClusterRequestValidationError struct {
+// AuthroleGitPolicyRequestValidationError is the validation error returned by
+// AuthroleGitPolicyRequest.Validate if the designated constraints aren't met.
+type AuthroleGitPolicyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3503,22 +16019,24 @@ type ClusterRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ClusterRequestValidationError) Field() string { return e.field }
+func (e AuthroleGitPolicyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ClusterRequestValidationError) Reason() string { return e.reason }
+func (e AuthroleGitPolicyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ClusterRequestValidationError) Cause() error { return e.cause }
+func (e AuthroleGitPolicyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ClusterRequestValidationError) Key() bool { return e.key }
+func (e AuthroleGitPolicyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ClusterRequestValidationError) ErrorName() string { return "ClusterRequestValidationError" }
+func (e AuthroleGitPolicyRequestValidationError) ErrorName() string {
+	return "AuthroleGitPolicyRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ClusterRequestValidationError) Error() string {
+func (e AuthroleGitPolicyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3530,14 +16048,14 @@ func (e ClusterRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sClusterRequest.%s: %s%s",
+		"invalid %sAuthroleGitPolicyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ClusterRequestValidationError{}
+var _ error = AuthroleGitPolicyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3545,35 +16063,68 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ClusterRequestValidationError{}
+} = AuthroleGitPolicyRequestValidationError{}
 
-// Validate checks the field values on CreateClusterReply with the rules
+// Validate checks the field values on AuthroleRepoPolicyRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateClusterReply) Validate() error {
+func (m *AuthroleRepoPolicyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateClusterReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AuthroleRepoPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateClusterReplyMultiError, or nil if none found.
-func (m *CreateClusterReply) ValidateAll() error {
+// AuthroleRepoPolicyRequestMultiError, or nil if none found.
+func (m *AuthroleRepoPolicyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateClusterReply) validate(all bool) error {
+func (m *AuthroleRepoPolicyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetClusterName()) < 1 {
+		err := AuthroleRepoPolicyRequestValidationError{
+			field:  "ClusterName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDestUser()) < 1 {
+		err := AuthroleRepoPolicyRequestValidationError{
+			field:  "DestUser",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSecret() == nil {
+		err := AuthroleRepoPolicyRequestValidationError{
+			field:  "Secret",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if all {
 		switch v := interface{}(m.GetSecret()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateClusterReplyValidationError{
+				errors = append(errors, AuthroleRepoPolicyRequestValidationError{
 					field:  "Secret",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3581,7 +16132,7 @@ func (m *CreateClusterReply) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateClusterReplyValidationError{
+				errors = append(errors, AuthroleRepoPolicyRequestValidationError{
 					field:  "Secret",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3590,7 +16141,7 @@ func (m *CreateClusterReply) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateClusterReplyValidationError{
+			return AuthroleRepoPolicyRequestValidationError{
 				field:  "Secret",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -3599,19 +16150,19 @@ func (m *CreateClusterReply) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateClusterReplyMultiError(errors)
+		return AuthroleRepoPolicyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateClusterReplyMultiError is an error wrapping multiple validation errors
-// returned by CreateClusterReply.ValidateAll() if the designated constraints
-// aren't met.
-type CreateClusterReplyMultiError []error
+// AuthroleRepoPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by AuthroleRepoPolicyRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AuthroleRepoPolicyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateClusterReplyMultiError) Error() string {
+func (m AuthroleRepoPolicyRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3620,11 +16171,11 @@ func (m CreateClusterReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateClusterReplyMultiError) AllErrors() []error { return m }
+func (m AuthroleRepoPolicyRequestMultiError) AllErrors() []error { return m }
 
-// CreateClusterReplyValidationError is the validation error returned by
-// CreateClusterReply.Validate if the designated constraints aren't met.
-type CreateClusterReplyValidationError struct {
+// AuthroleRepoPolicyRequestValidationError is the validation error returned by
+// AuthroleRepoPolicyRequest.Validate if the designated constraints aren't met.
+type AuthroleRepoPolicyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3632,24 +16183,24 @@ type CreateClusterReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateClusterReplyValidationError) Field() string { return e.field }
+func (e AuthroleRepoPolicyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateClusterReplyValidationError) Reason() string { return e.reason }
+func (e AuthroleRepoPolicyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateClusterReplyValidationError) Cause() error { return e.cause }
+func (e AuthroleRepoPolicyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateClusterReplyValidationError) Key() bool { return e.key }
+func (e AuthroleRepoPolicyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateClusterReplyValidationError) ErrorName() string {
-	return "CreateClusterReplyValidationError"
+func (e AuthroleRepoPolicyRequestValidationError) ErrorName() string {
+	return "AuthroleRepoPolicyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateClusterReplyValidationError) Error() string {
+func (e AuthroleRepoPolicyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3661,14 +16212,14 @@ func (e CreateClusterReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateClusterReply.%s: %s%s",
+		"invalid %sAuthroleRepoPolicyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateClusterReplyValidationError{}
+var _ error = AuthroleRepoPolicyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3676,46 +16227,106 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateClusterReplyValidationError{}
+} = AuthroleRepoPolicyRequestValidationError{}
 
-// Validate checks the field values on DeleteClusterReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AuthroleClusterPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteClusterReply) Validate() error {
+func (m *AuthroleClusterPolicyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteClusterReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AuthroleClusterPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteClusterReplyMultiError, or nil if none found.
-func (m *DeleteClusterReply) ValidateAll() error {
+// AuthroleClusterPolicyRequestMultiError, or nil if none found.
+func (m *AuthroleClusterPolicyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteClusterReply) validate(all bool) error {
+func (m *AuthroleClusterPolicyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	// no validation rules for Msg
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetClusterName()) < 1 {
+		err := AuthroleClusterPolicyRequestValidationError{
+			field:  "ClusterName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDestUser()) < 1 {
+		err := AuthroleClusterPolicyRequestValidationError{
+			field:  "DestUser",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSecret() == nil {
+		err := AuthroleClusterPolicyRequestValidationError{
+			field:  "Secret",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthroleClusterPolicyRequestValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthroleClusterPolicyRequestValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthroleClusterPolicyRequestValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteClusterReplyMultiError(errors)
+		return AuthroleClusterPolicyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteClusterReplyMultiError is an error wrapping multiple validation errors
-// returned by DeleteClusterReply.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteClusterReplyMultiError []error
+// AuthroleClusterPolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by AuthroleClusterPolicyRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AuthroleClusterPolicyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteClusterReplyMultiError) Error() string {
+func (m AuthroleClusterPolicyRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3724,11 +16335,12 @@ func (m DeleteClusterReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteClusterReplyMultiError) AllErrors() []error { return m }
+func (m AuthroleClusterPolicyRequestMultiError) AllErrors() []error { return m }
 
-// DeleteClusterReplyValidationError is the validation error returned by
-// DeleteClusterReply.Validate if the designated constraints aren't met.
-type DeleteClusterReplyValidationError struct {
+// AuthroleClusterPolicyRequestValidationError is the validation error returned
+// by AuthroleClusterPolicyRequest.Validate if the designated constraints
+// aren't met.
+type AuthroleClusterPolicyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3736,24 +16348,24 @@ type DeleteClusterReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteClusterReplyValidationError) Field() string { return e.field }
+func (e AuthroleClusterPolicyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteClusterReplyValidationError) Reason() string { return e.reason }
+func (e AuthroleClusterPolicyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteClusterReplyValidationError) Cause() error { return e.cause }
+func (e AuthroleClusterPolicyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteClusterReplyValidationError) Key() bool { return e.key }
+func (e AuthroleClusterPolicyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteClusterReplyValidationError) ErrorName() string {
-	return "DeleteClusterReplyValidationError"
+func (e AuthroleClusterPolicyRequestValidationError) ErrorName() string {
+	return "AuthroleClusterPolicyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteClusterReplyValidationError) Error() string {
+func (e AuthroleClusterPolicyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3765,14 +16377,14 @@ func (e DeleteClusterReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteClusterReply.%s: %s%s",
+		"invalid %sAuthroleClusterPolicyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteClusterReplyValidationError{}
+var _ error = AuthroleClusterPolicyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3780,33 +16392,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteClusterReplyValidationError{}
+} = AuthroleClusterPolicyRequestValidationError{}
 
-// Validate checks the field values on Kubernetes with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Kubernetes) Validate() error {
+// Validate checks the field values on AuthroleTenantGitPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthroleTenantGitPolicyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Kubernetes with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in KubernetesMultiError, or
-// nil if none found.
-func (m *Kubernetes) ValidateAll() error {
+// ValidateAll checks the field values on AuthroleTenantGitPolicyRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AuthroleTenantGitPolicyRequestMultiError, or nil if none found.
+func (m *AuthroleTenantGitPolicyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Kubernetes) validate(all bool) error {
+func (m *AuthroleTenantGitPolicyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetUrl()) < 1 {
-		err := KubernetesValidationError{
-			field:  "Url",
+	if utf8.RuneCountInString(m.GetClusterName()) < 1 {
+		err := AuthroleTenantGitPolicyRequestValidationError{
+			field:  "ClusterName",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -3815,11 +16427,9 @@ func (m *Kubernetes) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Cabundle
-
-	if utf8.RuneCountInString(m.GetToken()) < 1 {
-		err := KubernetesValidationError{
-			field:  "Token",
+	if utf8.RuneCountInString(m.GetDestUser()) < 1 {
+		err := AuthroleTenantGitPolicyRequestValidationError{
+			field:  "DestUser",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -3828,19 +16438,60 @@ func (m *Kubernetes) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetSecret() == nil {
+		err := AuthroleTenantGitPolicyRequestValidationError{
+			field:  "Secret",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthroleTenantGitPolicyRequestValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthroleTenantGitPolicyRequestValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthroleTenantGitPolicyRequestValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return KubernetesMultiError(errors)
+		return AuthroleTenantGitPolicyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// KubernetesMultiError is an error wrapping multiple validation errors
-// returned by Kubernetes.ValidateAll() if the designated constraints aren't met.
-type KubernetesMultiError []error
+// AuthroleTenantGitPolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by AuthroleTenantGitPolicyRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AuthroleTenantGitPolicyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m KubernetesMultiError) Error() string {
+func (m AuthroleTenantGitPolicyRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3849,11 +16500,12 @@ func (m KubernetesMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m KubernetesMultiError) AllErrors() []error { return m }
+func (m AuthroleTenantGitPolicyRequestMultiError) AllErrors() []error { return m }
 
-// KubernetesValidationError is the validation error returned by
-// Kubernetes.Validate if the designated constraints aren't met.
-type KubernetesValidationError struct {
+// AuthroleTenantGitPolicyRequestValidationError is the validation error
+// returned by AuthroleTenantGitPolicyRequest.Validate if the designated
+// constraints aren't met.
+type AuthroleTenantGitPolicyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3861,22 +16513,24 @@ type KubernetesValidationError struct {
 }
 
 // Field function returns field value.
-func (e KubernetesValidationError) Field() string { return e.field }
+func (e AuthroleTenantGitPolicyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e KubernetesValidationError) Reason() string { return e.reason }
+func (e AuthroleTenantGitPolicyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e KubernetesValidationError) Cause() error { return e.cause }
+func (e AuthroleTenantGitPolicyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e KubernetesValidationError) Key() bool { return e.key }
+func (e AuthroleTenantGitPolicyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e KubernetesValidationError) ErrorName() string { return "KubernetesValidationError" }
+func (e AuthroleTenantGitPolicyRequestValidationError) ErrorName() string {
+	return "AuthroleTenantGitPolicyRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e KubernetesValidationError) Error() string {
+func (e AuthroleTenantGitPolicyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3888,14 +16542,14 @@ func (e KubernetesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sKubernetes.%s: %s%s",
+		"invalid %sAuthroleTenantGitPolicyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = KubernetesValidationError{}
+var _ error = AuthroleTenantGitPolicyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3903,24 +16557,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = KubernetesValidationError{}
+} = AuthroleTenantGitPolicyRequestValidationError{}
 
-// Validate checks the field values on AuthRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AuthRequest) Validate() error {
+// Validate checks the field values on AuthroleTenantRepoPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthroleTenantRepoPolicyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AuthRequestMultiError, or
-// nil if none found.
-func (m *AuthRequest) ValidateAll() error {
+// ValidateAll checks the field values on AuthroleTenantRepoPolicyRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AuthroleTenantRepoPolicyRequestMultiError, or nil if none found.
+func (m *AuthroleTenantRepoPolicyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthRequest) validate(all bool) error {
+func (m *AuthroleTenantRepoPolicyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3928,7 +16582,7 @@ func (m *AuthRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetClusterName()) < 1 {
-		err := AuthRequestValidationError{
+		err := AuthroleTenantRepoPolicyRequestValidationError{
 			field:  "ClusterName",
 			reason: "value length must be at least 1 runes",
 		}
@@ -3938,9 +16592,9 @@ func (m *AuthRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetAuthType()) < 1 {
-		err := AuthRequestValidationError{
-			field:  "AuthType",
+	if utf8.RuneCountInString(m.GetDestUser()) < 1 {
+		err := AuthroleTenantRepoPolicyRequestValidationError{
+			field:  "DestUser",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -3949,29 +16603,40 @@ func (m *AuthRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetSecret() == nil {
+		err := AuthroleTenantRepoPolicyRequestValidationError{
+			field:  "Secret",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if all {
-		switch v := interface{}(m.GetKubernetes()).(type) {
+		switch v := interface{}(m.GetSecret()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AuthRequestValidationError{
-					field:  "Kubernetes",
+				errors = append(errors, AuthroleTenantRepoPolicyRequestValidationError{
+					field:  "Secret",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AuthRequestValidationError{
-					field:  "Kubernetes",
+				errors = append(errors, AuthroleTenantRepoPolicyRequestValidationError{
+					field:  "Secret",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetKubernetes()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AuthRequestValidationError{
-				field:  "Kubernetes",
+			return AuthroleTenantRepoPolicyRequestValidationError{
+				field:  "Secret",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3979,18 +16644,19 @@ func (m *AuthRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AuthRequestMultiError(errors)
+		return AuthroleTenantRepoPolicyRequestMultiError(errors)
 	}
 
 	return nil
-}
-
-// AuthRequestMultiError is an error wrapping multiple validation errors
-// returned by AuthRequest.ValidateAll() if the designated constraints aren't met.
-type AuthRequestMultiError []error
+}
+
+// AuthroleTenantRepoPolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by AuthroleTenantRepoPolicyRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AuthroleTenantRepoPolicyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthRequestMultiError) Error() string {
+func (m AuthroleTenantRepoPolicyRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3999,11 +16665,12 @@ func (m AuthRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthRequestMultiError) AllErrors() []error { return m }
+func (m AuthroleTenantRepoPolicyRequestMultiError) AllErrors() []error { return m }
 
-// AuthRequestValidationError is the validation error returned by
-// AuthRequest.Validate if the designated constraints aren't met.
-type AuthRequestValidationError struct {
+// AuthroleTenantRepoPolicyRequestValidationError is the validation error
+// returned by AuthroleTenantRepoPolicyRequest.Validate if the designated
+// constraints aren't met.
+type AuthroleTenantRepoPolicyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4011,22 +16678,24 @@ type AuthRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthRequestValidationError) Field() string { return e.field }
+func (e AuthroleTenantRepoPolicyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthRequestValidationError) Reason() string { return e.reason }
+func (e AuthroleTenantRepoPolicyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthRequestValidationError) Cause() error { return e.cause }
+func (e AuthroleTenantRepoPolicyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthRequestValidationError) Key() bool { return e.key }
+func (e AuthroleTenantRepoPolicyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthRequestValidationError) ErrorName() string { return "AuthRequestValidationError" }
+func (e AuthroleTenantRepoPolicyRequestValidationError) ErrorName() string {
+	return "AuthroleTenantRepoPolicyRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AuthRequestValidationError) Error() string {
+func (e AuthroleTenantRepoPolicyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4038,14 +16707,14 @@ func (e AuthRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthRequest.%s: %s%s",
+		"invalid %sAuthroleTenantRepoPolicyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthRequestValidationError{}
+var _ error = AuthroleTenantRepoPolicyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4053,24 +16722,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthRequestValidationError{}
+} = AuthroleTenantRepoPolicyRequestValidationError{}
 
-// Validate checks the field values on CreateAuthReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CreateAuthReply) Validate() error {
+// Validate checks the field values on GrantAuthrolePolicyReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GrantAuthrolePolicyReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateAuthReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GrantAuthrolePolicyReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateAuthReplyMultiError, or nil if none found.
-func (m *CreateAuthReply) ValidateAll() error {
+// GrantAuthrolePolicyReplyMultiError, or nil if none found.
+func (m *GrantAuthrolePolicyReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateAuthReply) validate(all bool) error {
+func (m *GrantAuthrolePolicyReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4079,20 +16748,49 @@ func (m *CreateAuthReply) validate(all bool) error {
 
 	// no validation rules for Msg
 
+	if all {
+		switch v := interface{}(m.GetDryRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GrantAuthrolePolicyReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GrantAuthrolePolicyReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDryRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GrantAuthrolePolicyReplyValidationError{
+				field:  "DryRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return CreateAuthReplyMultiError(errors)
+		return GrantAuthrolePolicyReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateAuthReplyMultiError is an error wrapping multiple validation errors
-// returned by CreateAuthReply.ValidateAll() if the designated constraints
-// aren't met.
-type CreateAuthReplyMultiError []error
+// GrantAuthrolePolicyReplyMultiError is an error wrapping multiple validation
+// errors returned by GrantAuthrolePolicyReply.ValidateAll() if the designated
+// constraints aren't met.
+type GrantAuthrolePolicyReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateAuthReplyMultiError) Error() string {
+func (m GrantAuthrolePolicyReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4101,11 +16799,11 @@ func (m CreateAuthReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateAuthReplyMultiError) AllErrors() []error { return m }
+func (m GrantAuthrolePolicyReplyMultiError) AllErrors() []error { return m }
 
-// CreateAuthReplyValidationError is the validation error returned by
-// CreateAuthReply.Validate if the designated constraints aren't met.
-type CreateAuthReplyValidationError struct {
+// GrantAuthrolePolicyReplyValidationError is the validation error returned by
+// GrantAuthrolePolicyReply.Validate if the designated constraints aren't met.
+type GrantAuthrolePolicyReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4113,22 +16811,24 @@ type CreateAuthReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateAuthReplyValidationError) Field() string { return e.field }
+func (e GrantAuthrolePolicyReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateAuthReplyValidationError) Reason() string { return e.reason }
+func (e GrantAuthrolePolicyReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateAuthReplyValidationError) Cause() error { return e.cause }
+func (e GrantAuthrolePolicyReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateAuthReplyValidationError) Key() bool { return e.key }
+func (e GrantAuthrolePolicyReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateAuthReplyValidationError) ErrorName() string { return "CreateAuthReplyValidationError" }
+func (e GrantAuthrolePolicyReplyValidationError) ErrorName() string {
+	return "GrantAuthrolePolicyReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CreateAuthReplyValidationError) Error() string {
+func (e GrantAuthrolePolicyReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4140,14 +16840,14 @@ func (e CreateAuthReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateAuthReply.%s: %s%s",
+		"invalid %sGrantAuthrolePolicyReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateAuthReplyValidationError{}
+var _ error = GrantAuthrolePolicyReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -4155,24 +16855,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateAuthReplyValidationError{}
+} = GrantAuthrolePolicyReplyValidationError{}
 
-// Validate checks the field values on DeleteAuthReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DeleteAuthReply) Validate() error {
+// Validate checks the field values on RevokeAuthrolePolicyReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAuthrolePolicyReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteAuthReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RevokeAuthrolePolicyReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteAuthReplyMultiError, or nil if none found.
-func (m *DeleteAuthReply) ValidateAll() error {
+// RevokeAuthrolePolicyReplyMultiError, or nil if none found.
+func (m *RevokeAuthrolePolicyReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteAuthReply) validate(all bool) error {
+func (m *RevokeAuthrolePolicyReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4181,20 +16881,49 @@ func (m *DeleteAuthReply) validate(all bool) error {
 
 	// no validation rules for Msg
 
+	if all {
+		switch v := interface{}(m.GetDryRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevokeAuthrolePolicyReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevokeAuthrolePolicyReplyValidationError{
+					field:  "DryRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDryRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevokeAuthrolePolicyReplyValidationError{
+				field:  "DryRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return DeleteAuthReplyMultiError(errors)
+		return RevokeAuthrolePolicyReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteAuthReplyMultiError is an error wrapping multiple validation errors
-// returned by DeleteAuthReply.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteAuthReplyMultiError []error
+// RevokeAuthrolePolicyReplyMultiError is an error wrapping multiple validation
+// errors returned by RevokeAuthrolePolicyReply.ValidateAll() if the
+// designated constraints aren't met.
+type RevokeAuthrolePolicyReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteAuthReplyMultiError) Error() string {
+func (m RevokeAuthrolePolicyReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4203,11 +16932,11 @@ func (m DeleteAuthReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteAuthReplyMultiError) AllErrors() []error { return m }
+func (m RevokeAuthrolePolicyReplyMultiError) AllErrors() []error { return m }
 
-// DeleteAuthReplyValidationError is the validation error returned by
-// DeleteAuthReply.Validate if the designated constraints aren't met.
-type DeleteAuthReplyValidationError struct {
+// RevokeAuthrolePolicyReplyValidationError is the validation error returned by
+// RevokeAuthrolePolicyReply.Validate if the designated constraints aren't met.
+type RevokeAuthrolePolicyReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4215,22 +16944,24 @@ type DeleteAuthReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteAuthReplyValidationError) Field() string { return e.field }
+func (e RevokeAuthrolePolicyReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteAuthReplyValidationError) Reason() string { return e.reason }
+func (e RevokeAuthrolePolicyReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteAuthReplyValidationError) Cause() error { return e.cause }
+func (e RevokeAuthrolePolicyReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteAuthReplyValidationError) Key() bool { return e.key }
+func (e RevokeAuthrolePolicyReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteAuthReplyValidationError) ErrorName() string { return "DeleteAuthReplyValidationError" }
+func (e RevokeAuthrolePolicyReplyValidationError) ErrorName() string {
+	return "RevokeAuthrolePolicyReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DeleteAuthReplyValidationError) Error() string {
+func (e RevokeAuthrolePolicyReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4242,14 +16973,14 @@ func (e DeleteAuthReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteAuthReply.%s: %s%s",
+		"invalid %sRevokeAuthrolePolicyReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteAuthReplyValidationError{}
+var _ error = RevokeAuthrolePolicyReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -4257,76 +16988,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteAuthReplyValidationError{}
+} = RevokeAuthrolePolicyReplyValidationError{}
 
-// Validate checks the field values on KubernetesAuthRoleMeta with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *KubernetesAuthRoleMeta) Validate() error {
+// Validate checks the field values on Drift with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Drift) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on KubernetesAuthRoleMeta with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// KubernetesAuthRoleMetaMultiError, or nil if none found.
-func (m *KubernetesAuthRoleMeta) ValidateAll() error {
+// ValidateAll checks the field values on Drift with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in DriftMultiError, or nil if none found.
+func (m *Drift) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *KubernetesAuthRoleMeta) validate(all bool) error {
+func (m *Drift) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetNamespaces() {
-		_, _ = idx, item
+	// no validation rules for Kind
 
-		if utf8.RuneCountInString(item) < 1 {
-			err := KubernetesAuthRoleMetaValidationError{
-				field:  fmt.Sprintf("Namespaces[%v]", idx),
-				reason: "value length must be at least 1 runes",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	// no validation rules for SecretName
 
-	}
+	// no validation rules for SecretPath
 
-	for idx, item := range m.GetServiceAccounts() {
-		_, _ = idx, item
+	// no validation rules for PolicyName
 
-		if utf8.RuneCountInString(item) < 1 {
-			err := KubernetesAuthRoleMetaValidationError{
-				field:  fmt.Sprintf("ServiceAccounts[%v]", idx),
-				reason: "value length must be at least 1 runes",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	// no validation rules for RolePath
 
-	}
+	// no validation rules for Repaired
 
 	if len(errors) > 0 {
-		return KubernetesAuthRoleMetaMultiError(errors)
+		return DriftMultiError(errors)
 	}
 
 	return nil
 }
 
-// KubernetesAuthRoleMetaMultiError is an error wrapping multiple validation
-// errors returned by KubernetesAuthRoleMeta.ValidateAll() if the designated
-// constraints aren't met.
-type KubernetesAuthRoleMetaMultiError []error
+// DriftMultiError is an error wrapping multiple validation errors returned by
+// Drift.ValidateAll() if the designated constraints aren't met.
+type DriftMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m KubernetesAuthRoleMetaMultiError) Error() string {
+func (m DriftMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4335,11 +17044,11 @@ func (m KubernetesAuthRoleMetaMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m KubernetesAuthRoleMetaMultiError) AllErrors() []error { return m }
+func (m DriftMultiError) AllErrors() []error { return m }
 
-// KubernetesAuthRoleMetaValidationError is the validation error returned by
-// KubernetesAuthRoleMeta.Validate if the designated constraints aren't met.
-type KubernetesAuthRoleMetaValidationError struct {
+// DriftValidationError is the validation error returned by Drift.Validate if
+// the designated constraints aren't met.
+type DriftValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4347,24 +17056,22 @@ type KubernetesAuthRoleMetaValidationError struct {
 }
 
 // Field function returns field value.
-func (e KubernetesAuthRoleMetaValidationError) Field() string { return e.field }
+func (e DriftValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e KubernetesAuthRoleMetaValidationError) Reason() string { return e.reason }
+func (e DriftValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e KubernetesAuthRoleMetaValidationError) Cause() error { return e.cause }
+func (e DriftValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e KubernetesAuthRoleMetaValidationError) Key() bool { return e.key }
+func (e DriftValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e KubernetesAuthRoleMetaValidationError) ErrorName() string {
-	return "KubernetesAuthRoleMetaValidationError"
-}
+func (e DriftValidationError) ErrorName() string { return "DriftValidationError" }
 
 // Error satisfies the builtin error interface
-func (e KubernetesAuthRoleMetaValidationError) Error() string {
+func (e DriftValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4376,14 +17083,14 @@ func (e KubernetesAuthRoleMetaValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sKubernetesAuthRoleMeta.%s: %s%s",
+		"invalid %sDrift.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = KubernetesAuthRoleMetaValidationError{}
+var _ error = DriftValidationError{}
 
 var _ interface {
 	Field() string
@@ -4391,112 +17098,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = KubernetesAuthRoleMetaValidationError{}
+} = DriftValidationError{}
 
-// Validate checks the field values on AuthroleRequest with the rules defined
+// Validate checks the field values on GetDriftRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *AuthroleRequest) Validate() error {
+func (m *GetDriftRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthroleRequest with the rules
+// ValidateAll checks the field values on GetDriftRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuthroleRequestMultiError, or nil if none found.
-func (m *AuthroleRequest) ValidateAll() error {
+// GetDriftRequestMultiError, or nil if none found.
+func (m *GetDriftRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthroleRequest) validate(all bool) error {
+func (m *GetDriftRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetClusterName()) < 1 {
-		err := AuthroleRequestValidationError{
-			field:  "ClusterName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetDestUser()) < 1 {
-		err := AuthroleRequestValidationError{
-			field:  "DestUser",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	switch v := m.Role.(type) {
-	case *AuthroleRequest_Kubernetes:
-		if v == nil {
-			err := AuthroleRequestValidationError{
-				field:  "Role",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetKubernetes()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AuthroleRequestValidationError{
-						field:  "Kubernetes",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AuthroleRequestValidationError{
-						field:  "Kubernetes",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetKubernetes()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AuthroleRequestValidationError{
-					field:  "Kubernetes",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	default:
-		_ = v // ensures v is used
-	}
-
 	if len(errors) > 0 {
-		return AuthroleRequestMultiError(errors)
+		return GetDriftRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthroleRequestMultiError is an error wrapping multiple validation errors
-// returned by AuthroleRequest.ValidateAll() if the designated constraints
+// GetDriftRequestMultiError is an error wrapping multiple validation errors
+// returned by GetDriftRequest.ValidateAll() if the designated constraints
 // aren't met.
-type AuthroleRequestMultiError []error
+type GetDriftRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthroleRequestMultiError) Error() string {
+func (m GetDriftRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4505,11 +17144,11 @@ func (m AuthroleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthroleRequestMultiError) AllErrors() []error { return m }
+func (m GetDriftRequestMultiError) AllErrors() []error { return m }
 
-// AuthroleRequestValidationError is the validation error returned by
-// AuthroleRequest.Validate if the designated constraints aren't met.
-type AuthroleRequestValidationError struct {
+// GetDriftRequestValidationError is the validation error returned by
+// GetDriftRequest.Validate if the designated constraints aren't met.
+type GetDriftRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4517,22 +17156,22 @@ type AuthroleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthroleRequestValidationError) Field() string { return e.field }
+func (e GetDriftRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthroleRequestValidationError) Reason() string { return e.reason }
+func (e GetDriftRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthroleRequestValidationError) Cause() error { return e.cause }
+func (e GetDriftRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthroleRequestValidationError) Key() bool { return e.key }
+func (e GetDriftRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthroleRequestValidationError) ErrorName() string { return "AuthroleRequestValidationError" }
+func (e GetDriftRequestValidationError) ErrorName() string { return "GetDriftRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AuthroleRequestValidationError) Error() string {
+func (e GetDriftRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4544,14 +17183,14 @@ func (e AuthroleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthroleRequest.%s: %s%s",
+		"invalid %sGetDriftRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthroleRequestValidationError{}
+var _ error = GetDriftRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4559,46 +17198,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthroleRequestValidationError{}
+} = GetDriftRequestValidationError{}
 
-// Validate checks the field values on CreateAuthroleReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateAuthroleReply) Validate() error {
+// Validate checks the field values on ReconcileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReconcileRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateAuthroleReply with the rules
+// ValidateAll checks the field values on ReconcileRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateAuthroleReplyMultiError, or nil if none found.
-func (m *CreateAuthroleReply) ValidateAll() error {
+// ReconcileRequestMultiError, or nil if none found.
+func (m *ReconcileRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateAuthroleReply) validate(all bool) error {
+func (m *ReconcileRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Msg
+	// no validation rules for Repair
 
 	if len(errors) > 0 {
-		return CreateAuthroleReplyMultiError(errors)
+		return ReconcileRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateAuthroleReplyMultiError is an error wrapping multiple validation
-// errors returned by CreateAuthroleReply.ValidateAll() if the designated
-// constraints aren't met.
-type CreateAuthroleReplyMultiError []error
+// ReconcileRequestMultiError is an error wrapping multiple validation errors
+// returned by ReconcileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReconcileRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateAuthroleReplyMultiError) Error() string {
+func (m ReconcileRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4607,11 +17246,11 @@ func (m CreateAuthroleReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateAuthroleReplyMultiError) AllErrors() []error { return m }
+func (m ReconcileRequestMultiError) AllErrors() []error { return m }
 
-// CreateAuthroleReplyValidationError is the validation error returned by
-// CreateAuthroleReply.Validate if the designated constraints aren't met.
-type CreateAuthroleReplyValidationError struct {
+// ReconcileRequestValidationError is the validation error returned by
+// ReconcileRequest.Validate if the designated constraints aren't met.
+type ReconcileRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4619,24 +17258,22 @@ type CreateAuthroleReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateAuthroleReplyValidationError) Field() string { return e.field }
+func (e ReconcileRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateAuthroleReplyValidationError) Reason() string { return e.reason }
+func (e ReconcileRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateAuthroleReplyValidationError) Cause() error { return e.cause }
+func (e ReconcileRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateAuthroleReplyValidationError) Key() bool { return e.key }
+func (e ReconcileRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateAuthroleReplyValidationError) ErrorName() string {
-	return "CreateAuthroleReplyValidationError"
-}
+func (e ReconcileRequestValidationError) ErrorName() string { return "ReconcileRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateAuthroleReplyValidationError) Error() string {
+func (e ReconcileRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4648,14 +17285,14 @@ func (e CreateAuthroleReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateAuthroleReply.%s: %s%s",
+		"invalid %sReconcileRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateAuthroleReplyValidationError{}
+var _ error = ReconcileRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4663,46 +17300,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateAuthroleReplyValidationError{}
+} = ReconcileRequestValidationError{}
 
-// Validate checks the field values on DeleteAuthroleReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteAuthroleReply) Validate() error {
+// Validate checks the field values on DriftReply with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DriftReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteAuthroleReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteAuthroleReplyMultiError, or nil if none found.
-func (m *DeleteAuthroleReply) ValidateAll() error {
+// ValidateAll checks the field values on DriftReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DriftReplyMultiError, or
+// nil if none found.
+func (m *DriftReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteAuthroleReply) validate(all bool) error {
+func (m *DriftReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Msg
+	for idx, item := range m.GetDrifts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DriftReplyValidationError{
+						field:  fmt.Sprintf("Drifts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DriftReplyValidationError{
+						field:  fmt.Sprintf("Drifts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DriftReplyValidationError{
+					field:  fmt.Sprintf("Drifts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for CheckedTime
 
 	if len(errors) > 0 {
-		return DeleteAuthroleReplyMultiError(errors)
+		return DriftReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteAuthroleReplyMultiError is an error wrapping multiple validation
-// errors returned by DeleteAuthroleReply.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteAuthroleReplyMultiError []error
+// DriftReplyMultiError is an error wrapping multiple validation errors
+// returned by DriftReply.ValidateAll() if the designated constraints aren't met.
+type DriftReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteAuthroleReplyMultiError) Error() string {
+func (m DriftReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4711,11 +17381,11 @@ func (m DeleteAuthroleReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteAuthroleReplyMultiError) AllErrors() []error { return m }
+func (m DriftReplyMultiError) AllErrors() []error { return m }
 
-// DeleteAuthroleReplyValidationError is the validation error returned by
-// DeleteAuthroleReply.Validate if the designated constraints aren't met.
-type DeleteAuthroleReplyValidationError struct {
+// DriftReplyValidationError is the validation error returned by
+// DriftReply.Validate if the designated constraints aren't met.
+type DriftReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4723,24 +17393,22 @@ type DeleteAuthroleReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteAuthroleReplyValidationError) Field() string { return e.field }
+func (e DriftReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteAuthroleReplyValidationError) Reason() string { return e.reason }
+func (e DriftReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteAuthroleReplyValidationError) Cause() error { return e.cause }
+func (e DriftReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteAuthroleReplyValidationError) Key() bool { return e.key }
+func (e DriftReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteAuthroleReplyValidationError) ErrorName() string {
-	return "DeleteAuthroleReplyValidationError"
-}
+func (e DriftReplyValidationError) ErrorName() string { return "DriftReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteAuthroleReplyValidationError) Error() string {
+func (e DriftReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4752,14 +17420,14 @@ func (e DeleteAuthroleReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteAuthroleReply.%s: %s%s",
+		"invalid %sDriftReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteAuthroleReplyValidationError{}
+var _ error = DriftReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -4767,106 +17435,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteAuthroleReplyValidationError{}
+} = DriftReplyValidationError{}
 
-// Validate checks the field values on AuthroleGitPolicyRequest with the rules
+// Validate checks the field values on ListExpirationsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthroleGitPolicyRequest) Validate() error {
+func (m *ListExpirationsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthroleGitPolicyRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListExpirationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuthroleGitPolicyRequestMultiError, or nil if none found.
-func (m *AuthroleGitPolicyRequest) ValidateAll() error {
+// ListExpirationsRequestMultiError, or nil if none found.
+func (m *ListExpirationsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthroleGitPolicyRequest) validate(all bool) error {
+func (m *ListExpirationsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetClusterName()) < 1 {
-		err := AuthroleGitPolicyRequestValidationError{
-			field:  "ClusterName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetDestUser()) < 1 {
-		err := AuthroleGitPolicyRequestValidationError{
-			field:  "DestUser",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetSecret() == nil {
-		err := AuthroleGitPolicyRequestValidationError{
-			field:  "Secret",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetSecret()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AuthroleGitPolicyRequestValidationError{
-					field:  "Secret",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AuthroleGitPolicyRequestValidationError{
-					field:  "Secret",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AuthroleGitPolicyRequestValidationError{
-				field:  "Secret",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
+	// no validation rules for Within
+
 	if len(errors) > 0 {
-		return AuthroleGitPolicyRequestMultiError(errors)
+		return ListExpirationsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthroleGitPolicyRequestMultiError is an error wrapping multiple validation
-// errors returned by AuthroleGitPolicyRequest.ValidateAll() if the designated
+// ListExpirationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListExpirationsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type AuthroleGitPolicyRequestMultiError []error
+type ListExpirationsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthroleGitPolicyRequestMultiError) Error() string {
+func (m ListExpirationsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4875,11 +17483,11 @@ func (m AuthroleGitPolicyRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthroleGitPolicyRequestMultiError) AllErrors() []error { return m }
+func (m ListExpirationsRequestMultiError) AllErrors() []error { return m }
 
-// AuthroleGitPolicyRequestValidationError is the validation error returned by
-// AuthroleGitPolicyRequest.Validate if the designated constraints aren't met.
-type AuthroleGitPolicyRequestValidationError struct {
+// ListExpirationsRequestValidationError is the validation error returned by
+// ListExpirationsRequest.Validate if the designated constraints aren't met.
+type ListExpirationsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4887,24 +17495,24 @@ type AuthroleGitPolicyRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthroleGitPolicyRequestValidationError) Field() string { return e.field }
+func (e ListExpirationsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthroleGitPolicyRequestValidationError) Reason() string { return e.reason }
+func (e ListExpirationsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthroleGitPolicyRequestValidationError) Cause() error { return e.cause }
+func (e ListExpirationsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthroleGitPolicyRequestValidationError) Key() bool { return e.key }
+func (e ListExpirationsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthroleGitPolicyRequestValidationError) ErrorName() string {
-	return "AuthroleGitPolicyRequestValidationError"
+func (e ListExpirationsRequestValidationError) ErrorName() string {
+	return "ListExpirationsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthroleGitPolicyRequestValidationError) Error() string {
+func (e ListExpirationsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4916,14 +17524,14 @@ func (e AuthroleGitPolicyRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthroleGitPolicyRequest.%s: %s%s",
+		"invalid %sListExpirationsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthroleGitPolicyRequestValidationError{}
+var _ error = ListExpirationsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4931,106 +17539,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthroleGitPolicyRequestValidationError{}
+} = ListExpirationsRequestValidationError{}
 
-// Validate checks the field values on AuthroleRepoPolicyRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthroleRepoPolicyRequest) Validate() error {
+// Validate checks the field values on SecretExpiration with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SecretExpiration) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthroleRepoPolicyRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SecretExpiration with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuthroleRepoPolicyRequestMultiError, or nil if none found.
-func (m *AuthroleRepoPolicyRequest) ValidateAll() error {
+// SecretExpirationMultiError, or nil if none found.
+func (m *SecretExpiration) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthroleRepoPolicyRequest) validate(all bool) error {
+func (m *SecretExpiration) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetClusterName()) < 1 {
-		err := AuthroleRepoPolicyRequestValidationError{
-			field:  "ClusterName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetDestUser()) < 1 {
-		err := AuthroleRepoPolicyRequestValidationError{
-			field:  "DestUser",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for SecretName
 
-	if m.GetSecret() == nil {
-		err := AuthroleRepoPolicyRequestValidationError{
-			field:  "Secret",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for SecretPath
 
-	if all {
-		switch v := interface{}(m.GetSecret()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AuthroleRepoPolicyRequestValidationError{
-					field:  "Secret",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AuthroleRepoPolicyRequestValidationError{
-					field:  "Secret",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AuthroleRepoPolicyRequestValidationError{
-				field:  "Secret",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for ExpireTime
 
 	if len(errors) > 0 {
-		return AuthroleRepoPolicyRequestMultiError(errors)
+		return SecretExpirationMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthroleRepoPolicyRequestMultiError is an error wrapping multiple validation
-// errors returned by AuthroleRepoPolicyRequest.ValidateAll() if the
-// designated constraints aren't met.
-type AuthroleRepoPolicyRequestMultiError []error
+// SecretExpirationMultiError is an error wrapping multiple validation errors
+// returned by SecretExpiration.ValidateAll() if the designated constraints
+// aren't met.
+type SecretExpirationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthroleRepoPolicyRequestMultiError) Error() string {
+func (m SecretExpirationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5039,11 +17591,11 @@ func (m AuthroleRepoPolicyRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthroleRepoPolicyRequestMultiError) AllErrors() []error { return m }
+func (m SecretExpirationMultiError) AllErrors() []error { return m }
 
-// AuthroleRepoPolicyRequestValidationError is the validation error returned by
-// AuthroleRepoPolicyRequest.Validate if the designated constraints aren't met.
-type AuthroleRepoPolicyRequestValidationError struct {
+// SecretExpirationValidationError is the validation error returned by
+// SecretExpiration.Validate if the designated constraints aren't met.
+type SecretExpirationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5051,24 +17603,22 @@ type AuthroleRepoPolicyRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthroleRepoPolicyRequestValidationError) Field() string { return e.field }
+func (e SecretExpirationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthroleRepoPolicyRequestValidationError) Reason() string { return e.reason }
+func (e SecretExpirationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthroleRepoPolicyRequestValidationError) Cause() error { return e.cause }
+func (e SecretExpirationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthroleRepoPolicyRequestValidationError) Key() bool { return e.key }
+func (e SecretExpirationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthroleRepoPolicyRequestValidationError) ErrorName() string {
-	return "AuthroleRepoPolicyRequestValidationError"
-}
+func (e SecretExpirationValidationError) ErrorName() string { return "SecretExpirationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AuthroleRepoPolicyRequestValidationError) Error() string {
+func (e SecretExpirationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5080,14 +17630,14 @@ func (e AuthroleRepoPolicyRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthroleRepoPolicyRequest.%s: %s%s",
+		"invalid %sSecretExpiration.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthroleRepoPolicyRequestValidationError{}
+var _ error = SecretExpirationValidationError{}
 
 var _ interface {
 	Field() string
@@ -5095,106 +17645,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthroleRepoPolicyRequestValidationError{}
+} = SecretExpirationValidationError{}
 
-// Validate checks the field values on AuthroleClusterPolicyRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListExpirationsReply with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthroleClusterPolicyRequest) Validate() error {
+func (m *ListExpirationsReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthroleClusterPolicyRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListExpirationsReply with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuthroleClusterPolicyRequestMultiError, or nil if none found.
-func (m *AuthroleClusterPolicyRequest) ValidateAll() error {
+// ListExpirationsReplyMultiError, or nil if none found.
+func (m *ListExpirationsReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthroleClusterPolicyRequest) validate(all bool) error {
+func (m *ListExpirationsReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetClusterName()) < 1 {
-		err := AuthroleClusterPolicyRequestValidationError{
-			field:  "ClusterName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetDestUser()) < 1 {
-		err := AuthroleClusterPolicyRequestValidationError{
-			field:  "DestUser",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetSecret() == nil {
-		err := AuthroleClusterPolicyRequestValidationError{
-			field:  "Secret",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetExpirations() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetSecret()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AuthroleClusterPolicyRequestValidationError{
-					field:  "Secret",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListExpirationsReplyValidationError{
+						field:  fmt.Sprintf("Expirations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListExpirationsReplyValidationError{
+						field:  fmt.Sprintf("Expirations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AuthroleClusterPolicyRequestValidationError{
-					field:  "Secret",
+				return ListExpirationsReplyValidationError{
+					field:  fmt.Sprintf("Expirations[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AuthroleClusterPolicyRequestValidationError{
-				field:  "Secret",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return AuthroleClusterPolicyRequestMultiError(errors)
+		return ListExpirationsReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthroleClusterPolicyRequestMultiError is an error wrapping multiple
-// validation errors returned by AuthroleClusterPolicyRequest.ValidateAll() if
-// the designated constraints aren't met.
-type AuthroleClusterPolicyRequestMultiError []error
+// ListExpirationsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListExpirationsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListExpirationsReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthroleClusterPolicyRequestMultiError) Error() string {
+func (m ListExpirationsReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5203,12 +17725,11 @@ func (m AuthroleClusterPolicyRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthroleClusterPolicyRequestMultiError) AllErrors() []error { return m }
+func (m ListExpirationsReplyMultiError) AllErrors() []error { return m }
 
-// AuthroleClusterPolicyRequestValidationError is the validation error returned
-// by AuthroleClusterPolicyRequest.Validate if the designated constraints
-// aren't met.
-type AuthroleClusterPolicyRequestValidationError struct {
+// ListExpirationsReplyValidationError is the validation error returned by
+// ListExpirationsReply.Validate if the designated constraints aren't met.
+type ListExpirationsReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5216,24 +17737,24 @@ type AuthroleClusterPolicyRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthroleClusterPolicyRequestValidationError) Field() string { return e.field }
+func (e ListExpirationsReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthroleClusterPolicyRequestValidationError) Reason() string { return e.reason }
+func (e ListExpirationsReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthroleClusterPolicyRequestValidationError) Cause() error { return e.cause }
+func (e ListExpirationsReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthroleClusterPolicyRequestValidationError) Key() bool { return e.key }
+func (e ListExpirationsReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthroleClusterPolicyRequestValidationError) ErrorName() string {
-	return "AuthroleClusterPolicyRequestValidationError"
+func (e ListExpirationsReplyValidationError) ErrorName() string {
+	return "ListExpirationsReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthroleClusterPolicyRequestValidationError) Error() string {
+func (e ListExpirationsReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5245,14 +17766,14 @@ func (e AuthroleClusterPolicyRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthroleClusterPolicyRequest.%s: %s%s",
+		"invalid %sListExpirationsReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthroleClusterPolicyRequestValidationError{}
+var _ error = ListExpirationsReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -5260,106 +17781,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthroleClusterPolicyRequestValidationError{}
+} = ListExpirationsReplyValidationError{}
 
-// Validate checks the field values on AuthroleTenantGitPolicyRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListRotationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthroleTenantGitPolicyRequest) Validate() error {
+func (m *ListRotationsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthroleTenantGitPolicyRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AuthroleTenantGitPolicyRequestMultiError, or nil if none found.
-func (m *AuthroleTenantGitPolicyRequest) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *AuthroleTenantGitPolicyRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if utf8.RuneCountInString(m.GetClusterName()) < 1 {
-		err := AuthroleTenantGitPolicyRequestValidationError{
-			field:  "ClusterName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetDestUser()) < 1 {
-		err := AuthroleTenantGitPolicyRequestValidationError{
-			field:  "DestUser",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+// ValidateAll checks the field values on ListRotationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRotationsRequestMultiError, or nil if none found.
+func (m *ListRotationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
 
-	if m.GetSecret() == nil {
-		err := AuthroleTenantGitPolicyRequestValidationError{
-			field:  "Secret",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+func (m *ListRotationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	if all {
-		switch v := interface{}(m.GetSecret()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AuthroleTenantGitPolicyRequestValidationError{
-					field:  "Secret",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AuthroleTenantGitPolicyRequestValidationError{
-					field:  "Secret",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AuthroleTenantGitPolicyRequestValidationError{
-				field:  "Secret",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	var errors []error
+
+	// no validation rules for StaleOnly
 
 	if len(errors) > 0 {
-		return AuthroleTenantGitPolicyRequestMultiError(errors)
+		return ListRotationsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthroleTenantGitPolicyRequestMultiError is an error wrapping multiple
-// validation errors returned by AuthroleTenantGitPolicyRequest.ValidateAll()
-// if the designated constraints aren't met.
-type AuthroleTenantGitPolicyRequestMultiError []error
+// ListRotationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRotationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListRotationsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthroleTenantGitPolicyRequestMultiError) Error() string {
+func (m ListRotationsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5368,12 +17829,11 @@ func (m AuthroleTenantGitPolicyRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthroleTenantGitPolicyRequestMultiError) AllErrors() []error { return m }
+func (m ListRotationsRequestMultiError) AllErrors() []error { return m }
 
-// AuthroleTenantGitPolicyRequestValidationError is the validation error
-// returned by AuthroleTenantGitPolicyRequest.Validate if the designated
-// constraints aren't met.
-type AuthroleTenantGitPolicyRequestValidationError struct {
+// ListRotationsRequestValidationError is the validation error returned by
+// ListRotationsRequest.Validate if the designated constraints aren't met.
+type ListRotationsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5381,24 +17841,24 @@ type AuthroleTenantGitPolicyRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthroleTenantGitPolicyRequestValidationError) Field() string { return e.field }
+func (e ListRotationsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthroleTenantGitPolicyRequestValidationError) Reason() string { return e.reason }
+func (e ListRotationsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthroleTenantGitPolicyRequestValidationError) Cause() error { return e.cause }
+func (e ListRotationsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthroleTenantGitPolicyRequestValidationError) Key() bool { return e.key }
+func (e ListRotationsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthroleTenantGitPolicyRequestValidationError) ErrorName() string {
-	return "AuthroleTenantGitPolicyRequestValidationError"
+func (e ListRotationsRequestValidationError) ErrorName() string {
+	return "ListRotationsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthroleTenantGitPolicyRequestValidationError) Error() string {
+func (e ListRotationsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5410,14 +17870,14 @@ func (e AuthroleTenantGitPolicyRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthroleTenantGitPolicyRequest.%s: %s%s",
+		"invalid %sListRotationsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthroleTenantGitPolicyRequestValidationError{}
+var _ error = ListRotationsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5425,56 +17885,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthroleTenantGitPolicyRequestValidationError{}
+} = ListRotationsRequestValidationError{}
 
-// Validate checks the field values on AuthroleTenantRepoPolicyRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ExportRotationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthroleTenantRepoPolicyRequest) Validate() error {
+func (m *ExportRotationsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthroleTenantRepoPolicyRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AuthroleTenantRepoPolicyRequestMultiError, or nil if none found.
-func (m *AuthroleTenantRepoPolicyRequest) ValidateAll() error {
+// ValidateAll checks the field values on ExportRotationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportRotationsRequestMultiError, or nil if none found.
+func (m *ExportRotationsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthroleTenantRepoPolicyRequest) validate(all bool) error {
+func (m *ExportRotationsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetClusterName()) < 1 {
-		err := AuthroleTenantRepoPolicyRequestValidationError{
-			field:  "ClusterName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetDestUser()) < 1 {
-		err := AuthroleTenantRepoPolicyRequestValidationError{
-			field:  "DestUser",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for StaleOnly
 
-	if m.GetSecret() == nil {
-		err := AuthroleTenantRepoPolicyRequestValidationError{
-			field:  "Secret",
-			reason: "value is required",
+	if _, ok := _ExportRotationsRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := ExportRotationsRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [ csv json]",
 		}
 		if !all {
 			return err
@@ -5482,49 +17922,20 @@ func (m *AuthroleTenantRepoPolicyRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetSecret()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AuthroleTenantRepoPolicyRequestValidationError{
-					field:  "Secret",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AuthroleTenantRepoPolicyRequestValidationError{
-					field:  "Secret",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AuthroleTenantRepoPolicyRequestValidationError{
-				field:  "Secret",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return AuthroleTenantRepoPolicyRequestMultiError(errors)
+		return ExportRotationsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthroleTenantRepoPolicyRequestMultiError is an error wrapping multiple
-// validation errors returned by AuthroleTenantRepoPolicyRequest.ValidateAll()
-// if the designated constraints aren't met.
-type AuthroleTenantRepoPolicyRequestMultiError []error
+// ExportRotationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportRotationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportRotationsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthroleTenantRepoPolicyRequestMultiError) Error() string {
+func (m ExportRotationsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5533,12 +17944,11 @@ func (m AuthroleTenantRepoPolicyRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthroleTenantRepoPolicyRequestMultiError) AllErrors() []error { return m }
+func (m ExportRotationsRequestMultiError) AllErrors() []error { return m }
 
-// AuthroleTenantRepoPolicyRequestValidationError is the validation error
-// returned by AuthroleTenantRepoPolicyRequest.Validate if the designated
-// constraints aren't met.
-type AuthroleTenantRepoPolicyRequestValidationError struct {
+// ExportRotationsRequestValidationError is the validation error returned by
+// ExportRotationsRequest.Validate if the designated constraints aren't met.
+type ExportRotationsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5546,24 +17956,24 @@ type AuthroleTenantRepoPolicyRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthroleTenantRepoPolicyRequestValidationError) Field() string { return e.field }
+func (e ExportRotationsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthroleTenantRepoPolicyRequestValidationError) Reason() string { return e.reason }
+func (e ExportRotationsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthroleTenantRepoPolicyRequestValidationError) Cause() error { return e.cause }
+func (e ExportRotationsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthroleTenantRepoPolicyRequestValidationError) Key() bool { return e.key }
+func (e ExportRotationsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthroleTenantRepoPolicyRequestValidationError) ErrorName() string {
-	return "AuthroleTenantRepoPolicyRequestValidationError"
+func (e ExportRotationsRequestValidationError) ErrorName() string {
+	return "ExportRotationsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthroleTenantRepoPolicyRequestValidationError) Error() string {
+func (e ExportRotationsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5575,14 +17985,14 @@ func (e AuthroleTenantRepoPolicyRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthroleTenantRepoPolicyRequest.%s: %s%s",
+		"invalid %sExportRotationsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthroleTenantRepoPolicyRequestValidationError{}
+var _ error = ExportRotationsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5590,46 +18000,68 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthroleTenantRepoPolicyRequestValidationError{}
+} = ExportRotationsRequestValidationError{}
 
-// Validate checks the field values on GrantAuthrolePolicyReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GrantAuthrolePolicyReply) Validate() error {
+var _ExportRotationsRequest_Format_InLookup = map[string]struct{}{
+	"":     {},
+	"csv":  {},
+	"json": {},
+}
+
+// Validate checks the field values on SecretRotation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SecretRotation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GrantAuthrolePolicyReply with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GrantAuthrolePolicyReplyMultiError, or nil if none found.
-func (m *GrantAuthrolePolicyReply) ValidateAll() error {
+// ValidateAll checks the field values on SecretRotation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SecretRotationMultiError,
+// or nil if none found.
+func (m *SecretRotation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GrantAuthrolePolicyReply) validate(all bool) error {
+func (m *SecretRotation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Msg
+	// no validation rules for SecretName
+
+	// no validation rules for SecretPath
+
+	// no validation rules for SecretType
+
+	// no validation rules for Version
+
+	// no validation rules for VersionTime
+
+	// no validation rules for AgeSeconds
+
+	// no validation rules for CreatedBy
+
+	// no validation rules for RotationPeriodSeconds
+
+	// no validation rules for Stale
 
 	if len(errors) > 0 {
-		return GrantAuthrolePolicyReplyMultiError(errors)
+		return SecretRotationMultiError(errors)
 	}
 
 	return nil
 }
 
-// GrantAuthrolePolicyReplyMultiError is an error wrapping multiple validation
-// errors returned by GrantAuthrolePolicyReply.ValidateAll() if the designated
-// constraints aren't met.
-type GrantAuthrolePolicyReplyMultiError []error
+// SecretRotationMultiError is an error wrapping multiple validation errors
+// returned by SecretRotation.ValidateAll() if the designated constraints
+// aren't met.
+type SecretRotationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GrantAuthrolePolicyReplyMultiError) Error() string {
+func (m SecretRotationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5638,11 +18070,11 @@ func (m GrantAuthrolePolicyReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GrantAuthrolePolicyReplyMultiError) AllErrors() []error { return m }
+func (m SecretRotationMultiError) AllErrors() []error { return m }
 
-// GrantAuthrolePolicyReplyValidationError is the validation error returned by
-// GrantAuthrolePolicyReply.Validate if the designated constraints aren't met.
-type GrantAuthrolePolicyReplyValidationError struct {
+// SecretRotationValidationError is the validation error returned by
+// SecretRotation.Validate if the designated constraints aren't met.
+type SecretRotationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5650,24 +18082,22 @@ type GrantAuthrolePolicyReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GrantAuthrolePolicyReplyValidationError) Field() string { return e.field }
+func (e SecretRotationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GrantAuthrolePolicyReplyValidationError) Reason() string { return e.reason }
+func (e SecretRotationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GrantAuthrolePolicyReplyValidationError) Cause() error { return e.cause }
+func (e SecretRotationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GrantAuthrolePolicyReplyValidationError) Key() bool { return e.key }
+func (e SecretRotationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GrantAuthrolePolicyReplyValidationError) ErrorName() string {
-	return "GrantAuthrolePolicyReplyValidationError"
-}
+func (e SecretRotationValidationError) ErrorName() string { return "SecretRotationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GrantAuthrolePolicyReplyValidationError) Error() string {
+func (e SecretRotationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5679,14 +18109,14 @@ func (e GrantAuthrolePolicyReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGrantAuthrolePolicyReply.%s: %s%s",
+		"invalid %sSecretRotation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GrantAuthrolePolicyReplyValidationError{}
+var _ error = SecretRotationValidationError{}
 
 var _ interface {
 	Field() string
@@ -5694,46 +18124,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GrantAuthrolePolicyReplyValidationError{}
+} = SecretRotationValidationError{}
 
-// Validate checks the field values on RevokeAuthrolePolicyReply with the rules
+// Validate checks the field values on ListRotationsReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeAuthrolePolicyReply) Validate() error {
+func (m *ListRotationsReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeAuthrolePolicyReply with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListRotationsReply with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RevokeAuthrolePolicyReplyMultiError, or nil if none found.
-func (m *RevokeAuthrolePolicyReply) ValidateAll() error {
+// ListRotationsReplyMultiError, or nil if none found.
+func (m *ListRotationsReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeAuthrolePolicyReply) validate(all bool) error {
+func (m *ListRotationsReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Msg
+	for idx, item := range m.GetRotations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRotationsReplyValidationError{
+						field:  fmt.Sprintf("Rotations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRotationsReplyValidationError{
+						field:  fmt.Sprintf("Rotations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRotationsReplyValidationError{
+					field:  fmt.Sprintf("Rotations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for CheckedTime
 
 	if len(errors) > 0 {
-		return RevokeAuthrolePolicyReplyMultiError(errors)
+		return ListRotationsReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeAuthrolePolicyReplyMultiError is an error wrapping multiple validation
-// errors returned by RevokeAuthrolePolicyReply.ValidateAll() if the
-// designated constraints aren't met.
-type RevokeAuthrolePolicyReplyMultiError []error
+// ListRotationsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListRotationsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListRotationsReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeAuthrolePolicyReplyMultiError) Error() string {
+func (m ListRotationsReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5742,11 +18206,11 @@ func (m RevokeAuthrolePolicyReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeAuthrolePolicyReplyMultiError) AllErrors() []error { return m }
+func (m ListRotationsReplyMultiError) AllErrors() []error { return m }
 
-// RevokeAuthrolePolicyReplyValidationError is the validation error returned by
-// RevokeAuthrolePolicyReply.Validate if the designated constraints aren't met.
-type RevokeAuthrolePolicyReplyValidationError struct {
+// ListRotationsReplyValidationError is the validation error returned by
+// ListRotationsReply.Validate if the designated constraints aren't met.
+type ListRotationsReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5754,24 +18218,24 @@ type RevokeAuthrolePolicyReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeAuthrolePolicyReplyValidationError) Field() string { return e.field }
+func (e ListRotationsReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeAuthrolePolicyReplyValidationError) Reason() string { return e.reason }
+func (e ListRotationsReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeAuthrolePolicyReplyValidationError) Cause() error { return e.cause }
+func (e ListRotationsReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeAuthrolePolicyReplyValidationError) Key() bool { return e.key }
+func (e ListRotationsReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeAuthrolePolicyReplyValidationError) ErrorName() string {
-	return "RevokeAuthrolePolicyReplyValidationError"
+func (e ListRotationsReplyValidationError) ErrorName() string {
+	return "ListRotationsReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeAuthrolePolicyReplyValidationError) Error() string {
+func (e ListRotationsReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5783,14 +18247,14 @@ func (e RevokeAuthrolePolicyReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeAuthrolePolicyReply.%s: %s%s",
+		"invalid %sListRotationsReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeAuthrolePolicyReplyValidationError{}
+var _ error = ListRotationsReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -5798,4 +18262,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeAuthrolePolicyReplyValidationError{}
+} = ListRotationsReplyValidationError{}
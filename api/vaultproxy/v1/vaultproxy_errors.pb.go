@@ -70,3 +70,27 @@ func IsInternalServiceError(err error) bool {
 func ErrorInternalServiceError(format string, args ...interface{}) *errors.Error {
 	return errors.New(500, ErrorReason_INTERNAL_SERVICE_ERROR.String(), fmt.Sprintf(format, args...))
 }
+
+func IsVersionConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_VERSION_CONFLICT.String() && e.Code == 409
+}
+
+func ErrorVersionConflict(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_VERSION_CONFLICT.String(), fmt.Sprintf(format, args...))
+}
+
+func IsIdempotencyConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_IDEMPOTENCY_CONFLICT.String() && e.Code == 409
+}
+
+func ErrorIdempotencyConflict(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_IDEMPOTENCY_CONFLICT.String(), fmt.Sprintf(format, args...))
+}
@@ -19,10 +19,13 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationSecretBatchCreateSecrets = "/api.vaultproxy.v1.Secret/BatchCreateSecrets"
+const OperationSecretBatchDeleteSecrets = "/api.vaultproxy.v1.Secret/BatchDeleteSecrets"
 const OperationSecretCreateCluster = "/api.vaultproxy.v1.Secret/CreateCluster"
 const OperationSecretCreateGit = "/api.vaultproxy.v1.Secret/CreateGit"
 const OperationSecretCreatePki = "/api.vaultproxy.v1.Secret/CreatePki"
 const OperationSecretCreateRepoAccount = "/api.vaultproxy.v1.Secret/CreateRepoAccount"
+const OperationSecretCreateSecret = "/api.vaultproxy.v1.Secret/CreateSecret"
 const OperationSecretCreateTenantRepo = "/api.vaultproxy.v1.Secret/CreateTenantRepo"
 const OperationSecretCreteTenantGit = "/api.vaultproxy.v1.Secret/CreteTenantGit"
 const OperationSecretDeleteCluster = "/api.vaultproxy.v1.Secret/DeleteCluster"
@@ -30,14 +33,65 @@ const OperationSecretDeleteGit = "/api.vaultproxy.v1.Secret/DeleteGit"
 const OperationSecretDeletePki = "/api.vaultproxy.v1.Secret/DeletePki"
 const OperationSecretDeleteRepoAccountProduct = "/api.vaultproxy.v1.Secret/DeleteRepoAccountProduct"
 const OperationSecretDeleteRepoAccountProject = "/api.vaultproxy.v1.Secret/DeleteRepoAccountProject"
+const OperationSecretDeleteSecret = "/api.vaultproxy.v1.Secret/DeleteSecret"
 const OperationSecretDeleteTenantGit = "/api.vaultproxy.v1.Secret/DeleteTenantGit"
 const OperationSecretDeleteTenantRepo = "/api.vaultproxy.v1.Secret/DeleteTenantRepo"
+const OperationSecretGetCluster = "/api.vaultproxy.v1.Secret/GetCluster"
+const OperationSecretGetGit = "/api.vaultproxy.v1.Secret/GetGit"
+const OperationSecretGetPki = "/api.vaultproxy.v1.Secret/GetPki"
+const OperationSecretGetRepoAccountProduct = "/api.vaultproxy.v1.Secret/GetRepoAccountProduct"
+const OperationSecretGetRepoAccountProject = "/api.vaultproxy.v1.Secret/GetRepoAccountProject"
+const OperationSecretGetSecret = "/api.vaultproxy.v1.Secret/GetSecret"
+const OperationSecretGetTenantGit = "/api.vaultproxy.v1.Secret/GetTenantGit"
+const OperationSecretGetTenantRepo = "/api.vaultproxy.v1.Secret/GetTenantRepo"
+const OperationSecretListCluster = "/api.vaultproxy.v1.Secret/ListCluster"
+const OperationSecretListClusterVersions = "/api.vaultproxy.v1.Secret/ListClusterVersions"
+const OperationSecretListGit = "/api.vaultproxy.v1.Secret/ListGit"
+const OperationSecretListGitVersions = "/api.vaultproxy.v1.Secret/ListGitVersions"
+const OperationSecretListRepoAccount = "/api.vaultproxy.v1.Secret/ListRepoAccount"
+const OperationSecretListRepoAccountProductVersions = "/api.vaultproxy.v1.Secret/ListRepoAccountProductVersions"
+const OperationSecretListRepoAccountProjectVersions = "/api.vaultproxy.v1.Secret/ListRepoAccountProjectVersions"
+const OperationSecretListTenantGit = "/api.vaultproxy.v1.Secret/ListTenantGit"
+const OperationSecretListTenantGitVersions = "/api.vaultproxy.v1.Secret/ListTenantGitVersions"
+const OperationSecretListTenantRepo = "/api.vaultproxy.v1.Secret/ListTenantRepo"
+const OperationSecretListTenantRepoVersions = "/api.vaultproxy.v1.Secret/ListTenantRepoVersions"
+const OperationSecretPurgeCluster = "/api.vaultproxy.v1.Secret/PurgeCluster"
+const OperationSecretPurgeGit = "/api.vaultproxy.v1.Secret/PurgeGit"
+const OperationSecretPurgePki = "/api.vaultproxy.v1.Secret/PurgePki"
+const OperationSecretPurgeSecret = "/api.vaultproxy.v1.Secret/PurgeSecret"
+const OperationSecretPurgeRepoAccountProduct = "/api.vaultproxy.v1.Secret/PurgeRepoAccountProduct"
+const OperationSecretPurgeRepoAccountProject = "/api.vaultproxy.v1.Secret/PurgeRepoAccountProject"
+const OperationSecretPurgeTenantGit = "/api.vaultproxy.v1.Secret/PurgeTenantGit"
+const OperationSecretPurgeTenantRepo = "/api.vaultproxy.v1.Secret/PurgeTenantRepo"
+const OperationSecretRenderRepoAccountProductDockerConfig = "/api.vaultproxy.v1.Secret/RenderRepoAccountProductDockerConfig"
+const OperationSecretRenderRepoAccountProjectDockerConfig = "/api.vaultproxy.v1.Secret/RenderRepoAccountProjectDockerConfig"
+const OperationSecretRenderTenantRepoDockerConfig = "/api.vaultproxy.v1.Secret/RenderTenantRepoDockerConfig"
+const OperationSecretRollbackCluster = "/api.vaultproxy.v1.Secret/RollbackCluster"
+const OperationSecretRollbackGit = "/api.vaultproxy.v1.Secret/RollbackGit"
+const OperationSecretRollbackRepoAccountProduct = "/api.vaultproxy.v1.Secret/RollbackRepoAccountProduct"
+const OperationSecretRollbackRepoAccountProject = "/api.vaultproxy.v1.Secret/RollbackRepoAccountProject"
+const OperationSecretRollbackTenantGit = "/api.vaultproxy.v1.Secret/RollbackTenantGit"
+const OperationSecretRollbackTenantRepo = "/api.vaultproxy.v1.Secret/RollbackTenantRepo"
+const OperationSecretUndeleteCluster = "/api.vaultproxy.v1.Secret/UndeleteCluster"
+const OperationSecretUndeleteGit = "/api.vaultproxy.v1.Secret/UndeleteGit"
+const OperationSecretUndeletePki = "/api.vaultproxy.v1.Secret/UndeletePki"
+const OperationSecretUndeleteSecret = "/api.vaultproxy.v1.Secret/UndeleteSecret"
+const OperationSecretUndeleteRepoAccountProduct = "/api.vaultproxy.v1.Secret/UndeleteRepoAccountProduct"
+const OperationSecretUndeleteRepoAccountProject = "/api.vaultproxy.v1.Secret/UndeleteRepoAccountProject"
+const OperationSecretUndeleteTenantGit = "/api.vaultproxy.v1.Secret/UndeleteTenantGit"
+const OperationSecretUndeleteTenantRepo = "/api.vaultproxy.v1.Secret/UndeleteTenantRepo"
+const OperationSecretUpdateSecretOwners = "/api.vaultproxy.v1.Secret/UpdateSecretOwners"
 
 type SecretHTTPServer interface {
+	// BatchCreateSecrets Create or delete several secrets of any registered types in one request, each secret is authorized before any of them is written
+	BatchCreateSecrets(context.Context, *BatchCreateSecretsRequest) (*BatchSecretsReply, error)
+	BatchDeleteSecrets(context.Context, *BatchDeleteSecretsRequest) (*BatchSecretsReply, error)
 	CreateCluster(context.Context, *ClusterRequest) (*CreateClusterReply, error)
 	CreateGit(context.Context, *GitRequest) (*CreateGitReply, error)
 	CreatePki(context.Context, *PkiRequest) (*CreatePkiReply, error)
 	CreateRepoAccount(context.Context, *RepoRequest) (*CreateRepoReply, error)
+	// CreateSecret Generic apis of all registered secret types, the meta is passed as "meta[key]=value" in the query of get and delete
+	CreateSecret(context.Context, *CreateSecretRequest) (*CreateSecretReply, error)
 	// CreateTenantRepo////////////////////////////////////////////////////////////////
 	CreateTenantRepo(context.Context, *TenantRepoRequest) (*CreateTenantRepoReply, error)
 	CreteTenantGit(context.Context, *TenantGitRequest) (*CreateTenantGitReply, error)
@@ -46,25 +100,121 @@ type SecretHTTPServer interface {
 	DeletePki(context.Context, *PkiRequest) (*DeletePkiReply, error)
 	DeleteRepoAccountProduct(context.Context, *RepoRequest) (*DeleteRepoReply, error)
 	DeleteRepoAccountProject(context.Context, *RepoRequest) (*DeleteRepoReply, error)
+	DeleteSecret(context.Context, *DeleteSecretRequest) (*DeleteSecretReply, error)
 	DeleteTenantGit(context.Context, *TenantGitRequest) (*DeleteTenantGitReply, error)
 	DeleteTenantRepo(context.Context, *TenantRepoRequest) (*DeleteTenantRepoReply, error)
+	GetCluster(context.Context, *GetClusterRequest) (*GetClusterReply, error)
+	GetGit(context.Context, *GetGitRequest) (*GetGitReply, error)
+	GetPki(context.Context, *PkiRequest) (*GetPkiReply, error)
+	GetRepoAccountProduct(context.Context, *GetRepoRequest) (*GetRepoReply, error)
+	GetRepoAccountProject(context.Context, *GetRepoRequest) (*GetRepoReply, error)
+	GetSecret(context.Context, *GetSecretRequest) (*GetSecretReply, error)
+	GetTenantGit(context.Context, *GetTenantGitRequest) (*GetTenantGitReply, error)
+	GetTenantRepo(context.Context, *GetTenantRepoRequest) (*GetTenantRepoReply, error)
+	ListCluster(context.Context, *ListClusterRequest) (*ListClusterReply, error)
+	ListClusterVersions(context.Context, *GetClusterRequest) (*ListClusterVersionsReply, error)
+	ListGit(context.Context, *ListGitRequest) (*ListGitReply, error)
+	ListGitVersions(context.Context, *GetGitRequest) (*ListGitVersionsReply, error)
+	ListRepoAccount(context.Context, *ListRepoRequest) (*ListRepoReply, error)
+	ListRepoAccountProductVersions(context.Context, *GetRepoRequest) (*ListRepoVersionsReply, error)
+	ListRepoAccountProjectVersions(context.Context, *GetRepoRequest) (*ListRepoVersionsReply, error)
+	ListTenantGit(context.Context, *ListTenantGitRequest) (*ListTenantGitReply, error)
+	ListTenantGitVersions(context.Context, *GetTenantGitRequest) (*ListTenantGitVersionsReply, error)
+	ListTenantRepo(context.Context, *ListTenantRepoRequest) (*ListTenantRepoReply, error)
+	ListTenantRepoVersions(context.Context, *GetTenantRepoRequest) (*ListTenantRepoVersionsReply, error)
+	PurgeCluster(context.Context, *PurgeClusterRequest) (*DeleteClusterReply, error)
+	PurgeGit(context.Context, *PurgeGitRequest) (*DeleteGitReply, error)
+	PurgePki(context.Context, *PurgePkiRequest) (*DeletePkiReply, error)
+	PurgeSecret(context.Context, *PurgeSecretRequest) (*DeleteSecretReply, error)
+	PurgeRepoAccountProduct(context.Context, *PurgeRepoRequest) (*DeleteRepoReply, error)
+	PurgeRepoAccountProject(context.Context, *PurgeRepoRequest) (*DeleteRepoReply, error)
+	PurgeTenantGit(context.Context, *PurgeTenantGitRequest) (*DeleteTenantGitReply, error)
+	PurgeTenantRepo(context.Context, *PurgeTenantRepoRequest) (*DeleteTenantRepoReply, error)
+	RenderRepoAccountProductDockerConfig(context.Context, *RenderRepoDockerConfigRequest) (*DockerConfigReply, error)
+	RenderRepoAccountProjectDockerConfig(context.Context, *RenderRepoDockerConfigRequest) (*DockerConfigReply, error)
+	RenderTenantRepoDockerConfig(context.Context, *RenderTenantRepoDockerConfigRequest) (*DockerConfigReply, error)
+	RollbackCluster(context.Context, *RollbackClusterRequest) (*RollbackClusterReply, error)
+	RollbackGit(context.Context, *RollbackGitRequest) (*RollbackGitReply, error)
+	RollbackRepoAccountProduct(context.Context, *RollbackRepoRequest) (*RollbackRepoReply, error)
+	RollbackRepoAccountProject(context.Context, *RollbackRepoRequest) (*RollbackRepoReply, error)
+	RollbackTenantGit(context.Context, *RollbackTenantGitRequest) (*RollbackTenantGitReply, error)
+	RollbackTenantRepo(context.Context, *RollbackTenantRepoRequest) (*RollbackTenantRepoReply, error)
+	UndeleteCluster(context.Context, *UndeleteClusterRequest) (*UndeleteClusterReply, error)
+	UndeleteGit(context.Context, *UndeleteGitRequest) (*UndeleteGitReply, error)
+	UndeletePki(context.Context, *UndeletePkiRequest) (*UndeletePkiReply, error)
+	UndeleteSecret(context.Context, *UndeleteSecretRequest) (*UndeleteSecretReply, error)
+	UndeleteRepoAccountProduct(context.Context, *UndeleteRepoRequest) (*UndeleteRepoReply, error)
+	UndeleteRepoAccountProject(context.Context, *UndeleteRepoRequest) (*UndeleteRepoReply, error)
+	UndeleteTenantGit(context.Context, *UndeleteTenantGitRequest) (*UndeleteTenantGitReply, error)
+	UndeleteTenantRepo(context.Context, *UndeleteTenantRepoRequest) (*UndeleteTenantRepoReply, error)
+	// UpdateSecretOwners Replace the co-owners of the secret, only the creator of the secret can change them
+	UpdateSecretOwners(context.Context, *UpdateSecretOwnersRequest) (*UpdateSecretOwnersReply, error)
 }
 
 func RegisterSecretHTTPServer(s *http.Server, srv SecretHTTPServer) {
 	r := s.Route("/")
 	r.POST("/v1/git", _Secret_CreateGit0_HTTP_Handler(srv))
 	r.DELETE("/v1/git/{meta.provider_type}/{meta.id}/{meta.username}/{meta.permission}", _Secret_DeleteGit0_HTTP_Handler(srv))
+	r.GET("/v1/git/{meta.provider_type}/{meta.id}/{meta.username}/{meta.permission}", _Secret_GetGit0_HTTP_Handler(srv))
+	r.GET("/v1/git", _Secret_ListGit0_HTTP_Handler(srv))
+	r.GET("/v1/git/{meta.provider_type}/{meta.id}/{meta.username}/{meta.permission}/versions", _Secret_ListGitVersions0_HTTP_Handler(srv))
+	r.POST("/v1/git/{meta.provider_type}/{meta.id}/{meta.username}/{meta.permission}/rollback", _Secret_RollbackGit0_HTTP_Handler(srv))
+	r.POST("/v1/git/{meta.provider_type}/{meta.id}/{meta.username}/{meta.permission}/undelete", _Secret_UndeleteGit0_HTTP_Handler(srv))
+	r.DELETE("/v1/git/{meta.provider_type}/{meta.id}/{meta.username}/{meta.permission}/purge", _Secret_PurgeGit0_HTTP_Handler(srv))
 	r.POST("/v1/pki", _Secret_CreatePki0_HTTP_Handler(srv))
 	r.DELETE("/v1/pki/{domain}", _Secret_DeletePki0_HTTP_Handler(srv))
+	r.GET("/v1/pki/{domain}", _Secret_GetPki0_HTTP_Handler(srv))
+	r.POST("/v1/pki/{domain}/undelete", _Secret_UndeletePki0_HTTP_Handler(srv))
+	r.DELETE("/v1/pki/{domain}/purge", _Secret_PurgePki0_HTTP_Handler(srv))
 	r.POST("/v1/repo", _Secret_CreateRepoAccount0_HTTP_Handler(srv))
 	r.DELETE("/v1/repo/{meta.provider_id}/product/{meta.product}", _Secret_DeleteRepoAccountProduct0_HTTP_Handler(srv))
 	r.DELETE("/v1/repo/{meta.provider_id}/product/{meta.product}/project/{meta.project}", _Secret_DeleteRepoAccountProject0_HTTP_Handler(srv))
+	r.GET("/v1/repo/{meta.provider_id}/product/{meta.product}", _Secret_GetRepoAccountProduct0_HTTP_Handler(srv))
+	r.GET("/v1/repo/{meta.provider_id}/product/{meta.product}/project/{meta.project}", _Secret_GetRepoAccountProject0_HTTP_Handler(srv))
+	r.GET("/v1/repo", _Secret_ListRepoAccount0_HTTP_Handler(srv))
+	r.GET("/v1/repo/{meta.provider_id}/product/{meta.product}/versions", _Secret_ListRepoAccountProductVersions0_HTTP_Handler(srv))
+	r.POST("/v1/repo/{meta.provider_id}/product/{meta.product}/rollback", _Secret_RollbackRepoAccountProduct0_HTTP_Handler(srv))
+	r.POST("/v1/repo/{meta.provider_id}/product/{meta.product}/undelete", _Secret_UndeleteRepoAccountProduct0_HTTP_Handler(srv))
+	r.DELETE("/v1/repo/{meta.provider_id}/product/{meta.product}/purge", _Secret_PurgeRepoAccountProduct0_HTTP_Handler(srv))
+	r.GET("/v1/repo/{meta.provider_id}/product/{meta.product}/project/{meta.project}/versions", _Secret_ListRepoAccountProjectVersions0_HTTP_Handler(srv))
+	r.POST("/v1/repo/{meta.provider_id}/product/{meta.product}/project/{meta.project}/rollback", _Secret_RollbackRepoAccountProject0_HTTP_Handler(srv))
+	r.POST("/v1/repo/{meta.provider_id}/product/{meta.product}/project/{meta.project}/undelete", _Secret_UndeleteRepoAccountProject0_HTTP_Handler(srv))
+	r.DELETE("/v1/repo/{meta.provider_id}/product/{meta.product}/project/{meta.project}/purge", _Secret_PurgeRepoAccountProject0_HTTP_Handler(srv))
+	r.GET("/v1/repo/{meta.provider_id}/product/{meta.product}/dockerconfigjson", _Secret_RenderRepoAccountProductDockerConfig0_HTTP_Handler(srv))
+	r.GET("/v1/repo/{meta.provider_id}/product/{meta.product}/project/{meta.project}/dockerconfigjson", _Secret_RenderRepoAccountProjectDockerConfig0_HTTP_Handler(srv))
 	r.POST("/v1/tenant/git", _Secret_CreteTenantGit0_HTTP_Handler(srv))
 	r.DELETE("/v1/tenant/git/{meta.id}", _Secret_DeleteTenantGit0_HTTP_Handler(srv))
+	r.GET("/v1/tenant/git/{meta.id}/{meta.permission}", _Secret_GetTenantGit0_HTTP_Handler(srv))
+	r.GET("/v1/tenant/git", _Secret_ListTenantGit0_HTTP_Handler(srv))
+	r.GET("/v1/tenant/git/{meta.id}/{meta.permission}/versions", _Secret_ListTenantGitVersions0_HTTP_Handler(srv))
+	r.POST("/v1/tenant/git/{meta.id}/{meta.permission}/rollback", _Secret_RollbackTenantGit0_HTTP_Handler(srv))
+	r.POST("/v1/tenant/git/{meta.id}/{meta.permission}/undelete", _Secret_UndeleteTenantGit0_HTTP_Handler(srv))
+	r.DELETE("/v1/tenant/git/{meta.id}/{meta.permission}/purge", _Secret_PurgeTenantGit0_HTTP_Handler(srv))
 	r.POST("/v1/tenant/repos", _Secret_CreateTenantRepo0_HTTP_Handler(srv))
 	r.DELETE("/v1/tenant/repos/{meta.id}", _Secret_DeleteTenantRepo0_HTTP_Handler(srv))
+	r.GET("/v1/tenant/repos/{meta.id}/{meta.permission}", _Secret_GetTenantRepo0_HTTP_Handler(srv))
+	r.GET("/v1/tenant/repos", _Secret_ListTenantRepo0_HTTP_Handler(srv))
+	r.GET("/v1/tenant/repos/{meta.id}/{meta.permission}/versions", _Secret_ListTenantRepoVersions0_HTTP_Handler(srv))
+	r.POST("/v1/tenant/repos/{meta.id}/{meta.permission}/rollback", _Secret_RollbackTenantRepo0_HTTP_Handler(srv))
+	r.POST("/v1/tenant/repos/{meta.id}/{meta.permission}/undelete", _Secret_UndeleteTenantRepo0_HTTP_Handler(srv))
+	r.DELETE("/v1/tenant/repos/{meta.id}/{meta.permission}/purge", _Secret_PurgeTenantRepo0_HTTP_Handler(srv))
+	r.GET("/v1/tenant/repos/{meta.id}/{meta.permission}/dockerconfigjson", _Secret_RenderTenantRepoDockerConfig0_HTTP_Handler(srv))
 	r.POST("/v1/cluster", _Secret_CreateCluster0_HTTP_Handler(srv))
 	r.DELETE("/v1/cluster/{meta.type}/{meta.id}/{meta.username}/{meta.permission}", _Secret_DeleteCluster0_HTTP_Handler(srv))
+	r.GET("/v1/cluster/{meta.type}/{meta.id}/{meta.username}/{meta.permission}", _Secret_GetCluster0_HTTP_Handler(srv))
+	r.GET("/v1/cluster", _Secret_ListCluster0_HTTP_Handler(srv))
+	r.GET("/v1/cluster/{meta.type}/{meta.id}/{meta.username}/{meta.permission}/versions", _Secret_ListClusterVersions0_HTTP_Handler(srv))
+	r.POST("/v1/cluster/{meta.type}/{meta.id}/{meta.username}/{meta.permission}/rollback", _Secret_RollbackCluster0_HTTP_Handler(srv))
+	r.POST("/v1/cluster/{meta.type}/{meta.id}/{meta.username}/{meta.permission}/undelete", _Secret_UndeleteCluster0_HTTP_Handler(srv))
+	r.DELETE("/v1/cluster/{meta.type}/{meta.id}/{meta.username}/{meta.permission}/purge", _Secret_PurgeCluster0_HTTP_Handler(srv))
+	r.POST("/v1/secrets/{type}", _Secret_CreateSecret0_HTTP_Handler(srv))
+	r.GET("/v1/secrets/{type}", _Secret_GetSecret0_HTTP_Handler(srv))
+	r.DELETE("/v1/secrets/{type}", _Secret_DeleteSecret0_HTTP_Handler(srv))
+	r.POST("/v1/secrets/{type}/undelete", _Secret_UndeleteSecret0_HTTP_Handler(srv))
+	r.DELETE("/v1/secrets/{type}/purge", _Secret_PurgeSecret0_HTTP_Handler(srv))
+	r.POST("/v1/batch/secrets", _Secret_BatchCreateSecrets0_HTTP_Handler(srv))
+	r.POST("/v1/batch/secrets/delete", _Secret_BatchDeleteSecrets0_HTTP_Handler(srv))
+	r.PUT("/v1/secrets/{type}/owners", _Secret_UpdateSecretOwners0_HTTP_Handler(srv))
 }
 
 func _Secret_CreateGit0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
@@ -111,6 +261,141 @@ func _Secret_DeleteGit0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context
 	}
 }
 
+func _Secret_GetGit0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetGitRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretGetGit)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetGit(ctx, req.(*GetGitRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetGitReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_ListGit0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListGitRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretListGit)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListGit(ctx, req.(*ListGitRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListGitReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_ListGitVersions0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetGitRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretListGitVersions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListGitVersions(ctx, req.(*GetGitRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListGitVersionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_RollbackGit0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RollbackGitRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretRollbackGit)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RollbackGit(ctx, req.(*RollbackGitRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RollbackGitReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_UndeleteGit0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UndeleteGitRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretUndeleteGit)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UndeleteGit(ctx, req.(*UndeleteGitRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UndeleteGitReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_PurgeGit0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PurgeGitRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretPurgeGit)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgeGit(ctx, req.(*PurgeGitRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteGitReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Secret_CreatePki0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in PkiRequest
@@ -155,6 +440,75 @@ func _Secret_DeletePki0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context
 	}
 }
 
+func _Secret_GetPki0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PkiRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretGetPki)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetPki(ctx, req.(*PkiRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetPkiReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_UndeletePki0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UndeletePkiRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretUndeletePki)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UndeletePki(ctx, req.(*UndeletePkiRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UndeletePkiReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_PurgePki0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PurgePkiRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretPurgePki)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgePki(ctx, req.(*PurgePkiRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeletePkiReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Secret_CreateRepoAccount0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in RepoRequest
@@ -221,167 +575,1760 @@ func _Secret_DeleteRepoAccountProject0_HTTP_Handler(srv SecretHTTPServer) func(c
 	}
 }
 
-func _Secret_CreteTenantGit0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+func _Secret_GetRepoAccountProduct0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in TenantGitRequest
-		if err := ctx.Bind(&in); err != nil {
+		var in GetRepoRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretGetRepoAccountProduct)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetRepoAccountProduct(ctx, req.(*GetRepoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
 			return err
 		}
+		reply := out.(*GetRepoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_GetRepoAccountProject0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetRepoRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationSecretCreteTenantGit)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretGetRepoAccountProject)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.CreteTenantGit(ctx, req.(*TenantGitRequest))
+			return srv.GetRepoAccountProject(ctx, req.(*GetRepoRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*CreateTenantGitReply)
+		reply := out.(*GetRepoReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Secret_DeleteTenantGit0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+func _Secret_ListRepoAccount0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in TenantGitRequest
+		var in ListRepoRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretListRepoAccount)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListRepoAccount(ctx, req.(*ListRepoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRepoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_ListRepoAccountProductVersions0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetRepoRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationSecretDeleteTenantGit)
+		http.SetOperation(ctx, OperationSecretListRepoAccountProductVersions)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.DeleteTenantGit(ctx, req.(*TenantGitRequest))
+			return srv.ListRepoAccountProductVersions(ctx, req.(*GetRepoRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*DeleteTenantGitReply)
+		reply := out.(*ListRepoVersionsReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Secret_CreateTenantRepo0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+func _Secret_RollbackRepoAccountProduct0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in TenantRepoRequest
+		var in RollbackRepoRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationSecretCreateTenantRepo)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretRollbackRepoAccountProduct)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.CreateTenantRepo(ctx, req.(*TenantRepoRequest))
+			return srv.RollbackRepoAccountProduct(ctx, req.(*RollbackRepoRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*CreateTenantRepoReply)
+		reply := out.(*RollbackRepoReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Secret_DeleteTenantRepo0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+func _Secret_UndeleteRepoAccountProduct0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in TenantRepoRequest
+		var in UndeleteRepoRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationSecretDeleteTenantRepo)
+		http.SetOperation(ctx, OperationSecretUndeleteRepoAccountProduct)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.DeleteTenantRepo(ctx, req.(*TenantRepoRequest))
+			return srv.UndeleteRepoAccountProduct(ctx, req.(*UndeleteRepoRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*DeleteTenantRepoReply)
+		reply := out.(*UndeleteRepoReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Secret_CreateCluster0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+func _Secret_PurgeRepoAccountProduct0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in ClusterRequest
-		if err := ctx.Bind(&in); err != nil {
+		var in PurgeRepoRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretPurgeRepoAccountProduct)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgeRepoAccountProduct(ctx, req.(*PurgeRepoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
 			return err
 		}
+		reply := out.(*DeleteRepoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_ListRepoAccountProjectVersions0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetRepoRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationSecretCreateCluster)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretListRepoAccountProjectVersions)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.CreateCluster(ctx, req.(*ClusterRequest))
+			return srv.ListRepoAccountProjectVersions(ctx, req.(*GetRepoRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*CreateClusterReply)
+		reply := out.(*ListRepoVersionsReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Secret_DeleteCluster0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+func _Secret_RollbackRepoAccountProject0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in ClusterRequest
+		var in RollbackRepoRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationSecretDeleteCluster)
+		http.SetOperation(ctx, OperationSecretRollbackRepoAccountProject)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.DeleteCluster(ctx, req.(*ClusterRequest))
+			return srv.RollbackRepoAccountProject(ctx, req.(*RollbackRepoRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*DeleteClusterReply)
+		reply := out.(*RollbackRepoReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-type SecretHTTPClient interface {
-	CreateCluster(ctx context.Context, req *ClusterRequest, opts ...http.CallOption) (rsp *CreateClusterReply, err error)
-	CreateGit(ctx context.Context, req *GitRequest, opts ...http.CallOption) (rsp *CreateGitReply, err error)
-	CreatePki(ctx context.Context, req *PkiRequest, opts ...http.CallOption) (rsp *CreatePkiReply, err error)
-	CreateRepoAccount(ctx context.Context, req *RepoRequest, opts ...http.CallOption) (rsp *CreateRepoReply, err error)
-	CreateTenantRepo(ctx context.Context, req *TenantRepoRequest, opts ...http.CallOption) (rsp *CreateTenantRepoReply, err error)
-	CreteTenantGit(ctx context.Context, req *TenantGitRequest, opts ...http.CallOption) (rsp *CreateTenantGitReply, err error)
-	DeleteCluster(ctx context.Context, req *ClusterRequest, opts ...http.CallOption) (rsp *DeleteClusterReply, err error)
-	DeleteGit(ctx context.Context, req *GitRequest, opts ...http.CallOption) (rsp *DeleteGitReply, err error)
-	DeletePki(ctx context.Context, req *PkiRequest, opts ...http.CallOption) (rsp *DeletePkiReply, err error)
-	DeleteRepoAccountProduct(ctx context.Context, req *RepoRequest, opts ...http.CallOption) (rsp *DeleteRepoReply, err error)
-	DeleteRepoAccountProject(ctx context.Context, req *RepoRequest, opts ...http.CallOption) (rsp *DeleteRepoReply, err error)
-	DeleteTenantGit(ctx context.Context, req *TenantGitRequest, opts ...http.CallOption) (rsp *DeleteTenantGitReply, err error)
-	DeleteTenantRepo(ctx context.Context, req *TenantRepoRequest, opts ...http.CallOption) (rsp *DeleteTenantRepoReply, err error)
+func _Secret_UndeleteRepoAccountProject0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UndeleteRepoRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretUndeleteRepoAccountProject)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UndeleteRepoAccountProject(ctx, req.(*UndeleteRepoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UndeleteRepoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_PurgeRepoAccountProject0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PurgeRepoRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretPurgeRepoAccountProject)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgeRepoAccountProject(ctx, req.(*PurgeRepoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteRepoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_RenderRepoAccountProductDockerConfig0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RenderRepoDockerConfigRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretRenderRepoAccountProductDockerConfig)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RenderRepoAccountProductDockerConfig(ctx, req.(*RenderRepoDockerConfigRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DockerConfigReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_RenderRepoAccountProjectDockerConfig0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RenderRepoDockerConfigRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretRenderRepoAccountProjectDockerConfig)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RenderRepoAccountProjectDockerConfig(ctx, req.(*RenderRepoDockerConfigRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DockerConfigReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_CreteTenantGit0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TenantGitRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretCreteTenantGit)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreteTenantGit(ctx, req.(*TenantGitRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateTenantGitReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_DeleteTenantGit0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TenantGitRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretDeleteTenantGit)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteTenantGit(ctx, req.(*TenantGitRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteTenantGitReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_GetTenantGit0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTenantGitRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretGetTenantGit)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTenantGit(ctx, req.(*GetTenantGitRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTenantGitReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_ListTenantGit0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTenantGitRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretListTenantGit)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTenantGit(ctx, req.(*ListTenantGitRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTenantGitReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_ListTenantGitVersions0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTenantGitRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretListTenantGitVersions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTenantGitVersions(ctx, req.(*GetTenantGitRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTenantGitVersionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_RollbackTenantGit0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RollbackTenantGitRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretRollbackTenantGit)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RollbackTenantGit(ctx, req.(*RollbackTenantGitRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RollbackTenantGitReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_UndeleteTenantGit0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UndeleteTenantGitRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretUndeleteTenantGit)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UndeleteTenantGit(ctx, req.(*UndeleteTenantGitRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UndeleteTenantGitReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_PurgeTenantGit0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PurgeTenantGitRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretPurgeTenantGit)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgeTenantGit(ctx, req.(*PurgeTenantGitRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteTenantGitReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_CreateTenantRepo0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TenantRepoRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretCreateTenantRepo)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateTenantRepo(ctx, req.(*TenantRepoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateTenantRepoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_DeleteTenantRepo0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TenantRepoRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretDeleteTenantRepo)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteTenantRepo(ctx, req.(*TenantRepoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteTenantRepoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_GetTenantRepo0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTenantRepoRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretGetTenantRepo)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTenantRepo(ctx, req.(*GetTenantRepoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTenantRepoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_ListTenantRepo0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTenantRepoRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretListTenantRepo)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTenantRepo(ctx, req.(*ListTenantRepoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTenantRepoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_ListTenantRepoVersions0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTenantRepoRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretListTenantRepoVersions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTenantRepoVersions(ctx, req.(*GetTenantRepoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTenantRepoVersionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_RollbackTenantRepo0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RollbackTenantRepoRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretRollbackTenantRepo)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RollbackTenantRepo(ctx, req.(*RollbackTenantRepoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RollbackTenantRepoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_UndeleteTenantRepo0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UndeleteTenantRepoRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretUndeleteTenantRepo)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UndeleteTenantRepo(ctx, req.(*UndeleteTenantRepoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UndeleteTenantRepoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_PurgeTenantRepo0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PurgeTenantRepoRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretPurgeTenantRepo)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgeTenantRepo(ctx, req.(*PurgeTenantRepoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteTenantRepoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_RenderTenantRepoDockerConfig0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RenderTenantRepoDockerConfigRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretRenderTenantRepoDockerConfig)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RenderTenantRepoDockerConfig(ctx, req.(*RenderTenantRepoDockerConfigRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DockerConfigReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_CreateCluster0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ClusterRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretCreateCluster)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateCluster(ctx, req.(*ClusterRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateClusterReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_DeleteCluster0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ClusterRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretDeleteCluster)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteCluster(ctx, req.(*ClusterRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteClusterReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_GetCluster0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetClusterRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretGetCluster)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCluster(ctx, req.(*GetClusterRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetClusterReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_ListCluster0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListClusterRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretListCluster)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListCluster(ctx, req.(*ListClusterRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListClusterReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_ListClusterVersions0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetClusterRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretListClusterVersions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListClusterVersions(ctx, req.(*GetClusterRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListClusterVersionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_RollbackCluster0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RollbackClusterRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretRollbackCluster)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RollbackCluster(ctx, req.(*RollbackClusterRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RollbackClusterReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_UndeleteCluster0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UndeleteClusterRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretUndeleteCluster)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UndeleteCluster(ctx, req.(*UndeleteClusterRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UndeleteClusterReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_PurgeCluster0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PurgeClusterRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretPurgeCluster)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgeCluster(ctx, req.(*PurgeClusterRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteClusterReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_CreateSecret0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateSecretRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretCreateSecret)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateSecret(ctx, req.(*CreateSecretRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateSecretReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_GetSecret0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSecretRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretGetSecret)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSecret(ctx, req.(*GetSecretRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetSecretReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_DeleteSecret0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteSecretRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretDeleteSecret)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteSecret(ctx, req.(*DeleteSecretRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteSecretReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_UndeleteSecret0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UndeleteSecretRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretUndeleteSecret)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UndeleteSecret(ctx, req.(*UndeleteSecretRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UndeleteSecretReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_PurgeSecret0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PurgeSecretRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretPurgeSecret)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgeSecret(ctx, req.(*PurgeSecretRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteSecretReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_BatchCreateSecrets0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchCreateSecretsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretBatchCreateSecrets)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchCreateSecrets(ctx, req.(*BatchCreateSecretsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchSecretsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_BatchDeleteSecrets0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchDeleteSecretsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretBatchDeleteSecrets)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchDeleteSecrets(ctx, req.(*BatchDeleteSecretsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchSecretsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Secret_UpdateSecretOwners0_HTTP_Handler(srv SecretHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateSecretOwnersRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSecretUpdateSecretOwners)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateSecretOwners(ctx, req.(*UpdateSecretOwnersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateSecretOwnersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type SecretHTTPClient interface {
+	BatchCreateSecrets(ctx context.Context, req *BatchCreateSecretsRequest, opts ...http.CallOption) (rsp *BatchSecretsReply, err error)
+	BatchDeleteSecrets(ctx context.Context, req *BatchDeleteSecretsRequest, opts ...http.CallOption) (rsp *BatchSecretsReply, err error)
+	CreateCluster(ctx context.Context, req *ClusterRequest, opts ...http.CallOption) (rsp *CreateClusterReply, err error)
+	CreateGit(ctx context.Context, req *GitRequest, opts ...http.CallOption) (rsp *CreateGitReply, err error)
+	CreatePki(ctx context.Context, req *PkiRequest, opts ...http.CallOption) (rsp *CreatePkiReply, err error)
+	CreateRepoAccount(ctx context.Context, req *RepoRequest, opts ...http.CallOption) (rsp *CreateRepoReply, err error)
+	CreateSecret(ctx context.Context, req *CreateSecretRequest, opts ...http.CallOption) (rsp *CreateSecretReply, err error)
+	CreateTenantRepo(ctx context.Context, req *TenantRepoRequest, opts ...http.CallOption) (rsp *CreateTenantRepoReply, err error)
+	CreteTenantGit(ctx context.Context, req *TenantGitRequest, opts ...http.CallOption) (rsp *CreateTenantGitReply, err error)
+	DeleteCluster(ctx context.Context, req *ClusterRequest, opts ...http.CallOption) (rsp *DeleteClusterReply, err error)
+	DeleteGit(ctx context.Context, req *GitRequest, opts ...http.CallOption) (rsp *DeleteGitReply, err error)
+	DeletePki(ctx context.Context, req *PkiRequest, opts ...http.CallOption) (rsp *DeletePkiReply, err error)
+	DeleteRepoAccountProduct(ctx context.Context, req *RepoRequest, opts ...http.CallOption) (rsp *DeleteRepoReply, err error)
+	DeleteRepoAccountProject(ctx context.Context, req *RepoRequest, opts ...http.CallOption) (rsp *DeleteRepoReply, err error)
+	DeleteSecret(ctx context.Context, req *DeleteSecretRequest, opts ...http.CallOption) (rsp *DeleteSecretReply, err error)
+	DeleteTenantGit(ctx context.Context, req *TenantGitRequest, opts ...http.CallOption) (rsp *DeleteTenantGitReply, err error)
+	DeleteTenantRepo(ctx context.Context, req *TenantRepoRequest, opts ...http.CallOption) (rsp *DeleteTenantRepoReply, err error)
+	GetCluster(ctx context.Context, req *GetClusterRequest, opts ...http.CallOption) (rsp *GetClusterReply, err error)
+	GetGit(ctx context.Context, req *GetGitRequest, opts ...http.CallOption) (rsp *GetGitReply, err error)
+	GetPki(ctx context.Context, req *PkiRequest, opts ...http.CallOption) (rsp *GetPkiReply, err error)
+	GetRepoAccountProduct(ctx context.Context, req *GetRepoRequest, opts ...http.CallOption) (rsp *GetRepoReply, err error)
+	GetRepoAccountProject(ctx context.Context, req *GetRepoRequest, opts ...http.CallOption) (rsp *GetRepoReply, err error)
+	GetSecret(ctx context.Context, req *GetSecretRequest, opts ...http.CallOption) (rsp *GetSecretReply, err error)
+	GetTenantGit(ctx context.Context, req *GetTenantGitRequest, opts ...http.CallOption) (rsp *GetTenantGitReply, err error)
+	GetTenantRepo(ctx context.Context, req *GetTenantRepoRequest, opts ...http.CallOption) (rsp *GetTenantRepoReply, err error)
+	ListCluster(ctx context.Context, req *ListClusterRequest, opts ...http.CallOption) (rsp *ListClusterReply, err error)
+	ListClusterVersions(ctx context.Context, req *GetClusterRequest, opts ...http.CallOption) (rsp *ListClusterVersionsReply, err error)
+	ListGit(ctx context.Context, req *ListGitRequest, opts ...http.CallOption) (rsp *ListGitReply, err error)
+	ListGitVersions(ctx context.Context, req *GetGitRequest, opts ...http.CallOption) (rsp *ListGitVersionsReply, err error)
+	ListRepoAccount(ctx context.Context, req *ListRepoRequest, opts ...http.CallOption) (rsp *ListRepoReply, err error)
+	ListRepoAccountProductVersions(ctx context.Context, req *GetRepoRequest, opts ...http.CallOption) (rsp *ListRepoVersionsReply, err error)
+	ListRepoAccountProjectVersions(ctx context.Context, req *GetRepoRequest, opts ...http.CallOption) (rsp *ListRepoVersionsReply, err error)
+	ListTenantGit(ctx context.Context, req *ListTenantGitRequest, opts ...http.CallOption) (rsp *ListTenantGitReply, err error)
+	ListTenantGitVersions(ctx context.Context, req *GetTenantGitRequest, opts ...http.CallOption) (rsp *ListTenantGitVersionsReply, err error)
+	ListTenantRepo(ctx context.Context, req *ListTenantRepoRequest, opts ...http.CallOption) (rsp *ListTenantRepoReply, err error)
+	ListTenantRepoVersions(ctx context.Context, req *GetTenantRepoRequest, opts ...http.CallOption) (rsp *ListTenantRepoVersionsReply, err error)
+	PurgeCluster(ctx context.Context, req *PurgeClusterRequest, opts ...http.CallOption) (rsp *DeleteClusterReply, err error)
+	PurgeGit(ctx context.Context, req *PurgeGitRequest, opts ...http.CallOption) (rsp *DeleteGitReply, err error)
+	PurgePki(ctx context.Context, req *PurgePkiRequest, opts ...http.CallOption) (rsp *DeletePkiReply, err error)
+	PurgeSecret(ctx context.Context, req *PurgeSecretRequest, opts ...http.CallOption) (rsp *DeleteSecretReply, err error)
+	PurgeRepoAccountProduct(ctx context.Context, req *PurgeRepoRequest, opts ...http.CallOption) (rsp *DeleteRepoReply, err error)
+	PurgeRepoAccountProject(ctx context.Context, req *PurgeRepoRequest, opts ...http.CallOption) (rsp *DeleteRepoReply, err error)
+	PurgeTenantGit(ctx context.Context, req *PurgeTenantGitRequest, opts ...http.CallOption) (rsp *DeleteTenantGitReply, err error)
+	PurgeTenantRepo(ctx context.Context, req *PurgeTenantRepoRequest, opts ...http.CallOption) (rsp *DeleteTenantRepoReply, err error)
+	RenderRepoAccountProductDockerConfig(ctx context.Context, req *RenderRepoDockerConfigRequest, opts ...http.CallOption) (rsp *DockerConfigReply, err error)
+	RenderRepoAccountProjectDockerConfig(ctx context.Context, req *RenderRepoDockerConfigRequest, opts ...http.CallOption) (rsp *DockerConfigReply, err error)
+	RenderTenantRepoDockerConfig(ctx context.Context, req *RenderTenantRepoDockerConfigRequest, opts ...http.CallOption) (rsp *DockerConfigReply, err error)
+	RollbackCluster(ctx context.Context, req *RollbackClusterRequest, opts ...http.CallOption) (rsp *RollbackClusterReply, err error)
+	RollbackGit(ctx context.Context, req *RollbackGitRequest, opts ...http.CallOption) (rsp *RollbackGitReply, err error)
+	RollbackRepoAccountProduct(ctx context.Context, req *RollbackRepoRequest, opts ...http.CallOption) (rsp *RollbackRepoReply, err error)
+	RollbackRepoAccountProject(ctx context.Context, req *RollbackRepoRequest, opts ...http.CallOption) (rsp *RollbackRepoReply, err error)
+	RollbackTenantGit(ctx context.Context, req *RollbackTenantGitRequest, opts ...http.CallOption) (rsp *RollbackTenantGitReply, err error)
+	RollbackTenantRepo(ctx context.Context, req *RollbackTenantRepoRequest, opts ...http.CallOption) (rsp *RollbackTenantRepoReply, err error)
+	UndeleteCluster(ctx context.Context, req *UndeleteClusterRequest, opts ...http.CallOption) (rsp *UndeleteClusterReply, err error)
+	UndeleteGit(ctx context.Context, req *UndeleteGitRequest, opts ...http.CallOption) (rsp *UndeleteGitReply, err error)
+	UndeletePki(ctx context.Context, req *UndeletePkiRequest, opts ...http.CallOption) (rsp *UndeletePkiReply, err error)
+	UndeleteSecret(ctx context.Context, req *UndeleteSecretRequest, opts ...http.CallOption) (rsp *UndeleteSecretReply, err error)
+	UndeleteRepoAccountProduct(ctx context.Context, req *UndeleteRepoRequest, opts ...http.CallOption) (rsp *UndeleteRepoReply, err error)
+	UndeleteRepoAccountProject(ctx context.Context, req *UndeleteRepoRequest, opts ...http.CallOption) (rsp *UndeleteRepoReply, err error)
+	UndeleteTenantGit(ctx context.Context, req *UndeleteTenantGitRequest, opts ...http.CallOption) (rsp *UndeleteTenantGitReply, err error)
+	UndeleteTenantRepo(ctx context.Context, req *UndeleteTenantRepoRequest, opts ...http.CallOption) (rsp *UndeleteTenantRepoReply, err error)
+	UpdateSecretOwners(ctx context.Context, req *UpdateSecretOwnersRequest, opts ...http.CallOption) (rsp *UpdateSecretOwnersReply, err error)
+}
+
+type SecretHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewSecretHTTPClient(client *http.Client) SecretHTTPClient {
+	return &SecretHTTPClientImpl{client}
+}
+
+func (c *SecretHTTPClientImpl) BatchCreateSecrets(ctx context.Context, in *BatchCreateSecretsRequest, opts ...http.CallOption) (*BatchSecretsReply, error) {
+	var out BatchSecretsReply
+	pattern := "/v1/batch/secrets"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSecretBatchCreateSecrets))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) BatchDeleteSecrets(ctx context.Context, in *BatchDeleteSecretsRequest, opts ...http.CallOption) (*BatchSecretsReply, error) {
+	var out BatchSecretsReply
+	pattern := "/v1/batch/secrets/delete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSecretBatchDeleteSecrets))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) CreateCluster(ctx context.Context, in *ClusterRequest, opts ...http.CallOption) (*CreateClusterReply, error) {
+	var out CreateClusterReply
+	pattern := "/v1/cluster"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSecretCreateCluster))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) CreateGit(ctx context.Context, in *GitRequest, opts ...http.CallOption) (*CreateGitReply, error) {
+	var out CreateGitReply
+	pattern := "/v1/git"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSecretCreateGit))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) CreatePki(ctx context.Context, in *PkiRequest, opts ...http.CallOption) (*CreatePkiReply, error) {
+	var out CreatePkiReply
+	pattern := "/v1/pki"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSecretCreatePki))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) CreateRepoAccount(ctx context.Context, in *RepoRequest, opts ...http.CallOption) (*CreateRepoReply, error) {
+	var out CreateRepoReply
+	pattern := "/v1/repo"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSecretCreateRepoAccount))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) CreateSecret(ctx context.Context, in *CreateSecretRequest, opts ...http.CallOption) (*CreateSecretReply, error) {
+	var out CreateSecretReply
+	pattern := "/v1/secrets/{type}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSecretCreateSecret))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) CreateTenantRepo(ctx context.Context, in *TenantRepoRequest, opts ...http.CallOption) (*CreateTenantRepoReply, error) {
+	var out CreateTenantRepoReply
+	pattern := "/v1/tenant/repos"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSecretCreateTenantRepo))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) CreteTenantGit(ctx context.Context, in *TenantGitRequest, opts ...http.CallOption) (*CreateTenantGitReply, error) {
+	var out CreateTenantGitReply
+	pattern := "/v1/tenant/git"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSecretCreteTenantGit))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) DeleteCluster(ctx context.Context, in *ClusterRequest, opts ...http.CallOption) (*DeleteClusterReply, error) {
+	var out DeleteClusterReply
+	pattern := "/v1/cluster/{meta.type}/{meta.id}/{meta.username}/{meta.permission}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretDeleteCluster))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) DeleteGit(ctx context.Context, in *GitRequest, opts ...http.CallOption) (*DeleteGitReply, error) {
+	var out DeleteGitReply
+	pattern := "/v1/git/{meta.provider_type}/{meta.id}/{meta.username}/{meta.permission}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretDeleteGit))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) DeletePki(ctx context.Context, in *PkiRequest, opts ...http.CallOption) (*DeletePkiReply, error) {
+	var out DeletePkiReply
+	pattern := "/v1/pki/{domain}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretDeletePki))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) DeleteRepoAccountProduct(ctx context.Context, in *RepoRequest, opts ...http.CallOption) (*DeleteRepoReply, error) {
+	var out DeleteRepoReply
+	pattern := "/v1/repo/{meta.provider_id}/product/{meta.product}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretDeleteRepoAccountProduct))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) DeleteRepoAccountProject(ctx context.Context, in *RepoRequest, opts ...http.CallOption) (*DeleteRepoReply, error) {
+	var out DeleteRepoReply
+	pattern := "/v1/repo/{meta.provider_id}/product/{meta.product}/project/{meta.project}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretDeleteRepoAccountProject))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...http.CallOption) (*DeleteSecretReply, error) {
+	var out DeleteSecretReply
+	pattern := "/v1/secrets/{type}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretDeleteSecret))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) DeleteTenantGit(ctx context.Context, in *TenantGitRequest, opts ...http.CallOption) (*DeleteTenantGitReply, error) {
+	var out DeleteTenantGitReply
+	pattern := "/v1/tenant/git/{meta.id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretDeleteTenantGit))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) DeleteTenantRepo(ctx context.Context, in *TenantRepoRequest, opts ...http.CallOption) (*DeleteTenantRepoReply, error) {
+	var out DeleteTenantRepoReply
+	pattern := "/v1/tenant/repos/{meta.id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretDeleteTenantRepo))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) GetCluster(ctx context.Context, in *GetClusterRequest, opts ...http.CallOption) (*GetClusterReply, error) {
+	var out GetClusterReply
+	pattern := "/v1/cluster/{meta.type}/{meta.id}/{meta.username}/{meta.permission}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretGetCluster))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) GetGit(ctx context.Context, in *GetGitRequest, opts ...http.CallOption) (*GetGitReply, error) {
+	var out GetGitReply
+	pattern := "/v1/git/{meta.provider_type}/{meta.id}/{meta.username}/{meta.permission}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretGetGit))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) GetPki(ctx context.Context, in *PkiRequest, opts ...http.CallOption) (*GetPkiReply, error) {
+	var out GetPkiReply
+	pattern := "/v1/pki/{domain}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretGetPki))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) GetRepoAccountProduct(ctx context.Context, in *GetRepoRequest, opts ...http.CallOption) (*GetRepoReply, error) {
+	var out GetRepoReply
+	pattern := "/v1/repo/{meta.provider_id}/product/{meta.product}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretGetRepoAccountProduct))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) GetRepoAccountProject(ctx context.Context, in *GetRepoRequest, opts ...http.CallOption) (*GetRepoReply, error) {
+	var out GetRepoReply
+	pattern := "/v1/repo/{meta.provider_id}/product/{meta.product}/project/{meta.project}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretGetRepoAccountProject))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) GetSecret(ctx context.Context, in *GetSecretRequest, opts ...http.CallOption) (*GetSecretReply, error) {
+	var out GetSecretReply
+	pattern := "/v1/secrets/{type}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretGetSecret))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) GetTenantGit(ctx context.Context, in *GetTenantGitRequest, opts ...http.CallOption) (*GetTenantGitReply, error) {
+	var out GetTenantGitReply
+	pattern := "/v1/tenant/git/{meta.id}/{meta.permission}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretGetTenantGit))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) GetTenantRepo(ctx context.Context, in *GetTenantRepoRequest, opts ...http.CallOption) (*GetTenantRepoReply, error) {
+	var out GetTenantRepoReply
+	pattern := "/v1/tenant/repos/{meta.id}/{meta.permission}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretGetTenantRepo))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) ListCluster(ctx context.Context, in *ListClusterRequest, opts ...http.CallOption) (*ListClusterReply, error) {
+	var out ListClusterReply
+	pattern := "/v1/cluster"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretListCluster))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) ListClusterVersions(ctx context.Context, in *GetClusterRequest, opts ...http.CallOption) (*ListClusterVersionsReply, error) {
+	var out ListClusterVersionsReply
+	pattern := "/v1/cluster/{meta.type}/{meta.id}/{meta.username}/{meta.permission}/versions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretListClusterVersions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) ListGit(ctx context.Context, in *ListGitRequest, opts ...http.CallOption) (*ListGitReply, error) {
+	var out ListGitReply
+	pattern := "/v1/git"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretListGit))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) ListGitVersions(ctx context.Context, in *GetGitRequest, opts ...http.CallOption) (*ListGitVersionsReply, error) {
+	var out ListGitVersionsReply
+	pattern := "/v1/git/{meta.provider_type}/{meta.id}/{meta.username}/{meta.permission}/versions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretListGitVersions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) ListRepoAccount(ctx context.Context, in *ListRepoRequest, opts ...http.CallOption) (*ListRepoReply, error) {
+	var out ListRepoReply
+	pattern := "/v1/repo"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretListRepoAccount))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) ListRepoAccountProductVersions(ctx context.Context, in *GetRepoRequest, opts ...http.CallOption) (*ListRepoVersionsReply, error) {
+	var out ListRepoVersionsReply
+	pattern := "/v1/repo/{meta.provider_id}/product/{meta.product}/versions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretListRepoAccountProductVersions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) ListRepoAccountProjectVersions(ctx context.Context, in *GetRepoRequest, opts ...http.CallOption) (*ListRepoVersionsReply, error) {
+	var out ListRepoVersionsReply
+	pattern := "/v1/repo/{meta.provider_id}/product/{meta.product}/project/{meta.project}/versions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretListRepoAccountProjectVersions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) ListTenantGit(ctx context.Context, in *ListTenantGitRequest, opts ...http.CallOption) (*ListTenantGitReply, error) {
+	var out ListTenantGitReply
+	pattern := "/v1/tenant/git"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretListTenantGit))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) ListTenantGitVersions(ctx context.Context, in *GetTenantGitRequest, opts ...http.CallOption) (*ListTenantGitVersionsReply, error) {
+	var out ListTenantGitVersionsReply
+	pattern := "/v1/tenant/git/{meta.id}/{meta.permission}/versions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretListTenantGitVersions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) ListTenantRepo(ctx context.Context, in *ListTenantRepoRequest, opts ...http.CallOption) (*ListTenantRepoReply, error) {
+	var out ListTenantRepoReply
+	pattern := "/v1/tenant/repos"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretListTenantRepo))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) ListTenantRepoVersions(ctx context.Context, in *GetTenantRepoRequest, opts ...http.CallOption) (*ListTenantRepoVersionsReply, error) {
+	var out ListTenantRepoVersionsReply
+	pattern := "/v1/tenant/repos/{meta.id}/{meta.permission}/versions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretListTenantRepoVersions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) PurgeCluster(ctx context.Context, in *PurgeClusterRequest, opts ...http.CallOption) (*DeleteClusterReply, error) {
+	var out DeleteClusterReply
+	pattern := "/v1/cluster/{meta.type}/{meta.id}/{meta.username}/{meta.permission}/purge"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretPurgeCluster))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) PurgeGit(ctx context.Context, in *PurgeGitRequest, opts ...http.CallOption) (*DeleteGitReply, error) {
+	var out DeleteGitReply
+	pattern := "/v1/git/{meta.provider_type}/{meta.id}/{meta.username}/{meta.permission}/purge"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretPurgeGit))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) PurgePki(ctx context.Context, in *PurgePkiRequest, opts ...http.CallOption) (*DeletePkiReply, error) {
+	var out DeletePkiReply
+	pattern := "/v1/pki/{domain}/purge"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretPurgePki))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) PurgeSecret(ctx context.Context, in *PurgeSecretRequest, opts ...http.CallOption) (*DeleteSecretReply, error) {
+	var out DeleteSecretReply
+	pattern := "/v1/secrets/{type}/purge"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretPurgeSecret))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) PurgeRepoAccountProduct(ctx context.Context, in *PurgeRepoRequest, opts ...http.CallOption) (*DeleteRepoReply, error) {
+	var out DeleteRepoReply
+	pattern := "/v1/repo/{meta.provider_id}/product/{meta.product}/purge"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretPurgeRepoAccountProduct))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) PurgeRepoAccountProject(ctx context.Context, in *PurgeRepoRequest, opts ...http.CallOption) (*DeleteRepoReply, error) {
+	var out DeleteRepoReply
+	pattern := "/v1/repo/{meta.provider_id}/product/{meta.product}/project/{meta.project}/purge"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretPurgeRepoAccountProject))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) PurgeTenantGit(ctx context.Context, in *PurgeTenantGitRequest, opts ...http.CallOption) (*DeleteTenantGitReply, error) {
+	var out DeleteTenantGitReply
+	pattern := "/v1/tenant/git/{meta.id}/{meta.permission}/purge"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretPurgeTenantGit))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) PurgeTenantRepo(ctx context.Context, in *PurgeTenantRepoRequest, opts ...http.CallOption) (*DeleteTenantRepoReply, error) {
+	var out DeleteTenantRepoReply
+	pattern := "/v1/tenant/repos/{meta.id}/{meta.permission}/purge"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretPurgeTenantRepo))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) RenderRepoAccountProductDockerConfig(ctx context.Context, in *RenderRepoDockerConfigRequest, opts ...http.CallOption) (*DockerConfigReply, error) {
+	var out DockerConfigReply
+	pattern := "/v1/repo/{meta.provider_id}/product/{meta.product}/dockerconfigjson"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretRenderRepoAccountProductDockerConfig))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) RenderRepoAccountProjectDockerConfig(ctx context.Context, in *RenderRepoDockerConfigRequest, opts ...http.CallOption) (*DockerConfigReply, error) {
+	var out DockerConfigReply
+	pattern := "/v1/repo/{meta.provider_id}/product/{meta.product}/project/{meta.project}/dockerconfigjson"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretRenderRepoAccountProjectDockerConfig))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *SecretHTTPClientImpl) RenderTenantRepoDockerConfig(ctx context.Context, in *RenderTenantRepoDockerConfigRequest, opts ...http.CallOption) (*DockerConfigReply, error) {
+	var out DockerConfigReply
+	pattern := "/v1/tenant/repos/{meta.id}/{meta.permission}/dockerconfigjson"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSecretRenderTenantRepoDockerConfig))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
 }
 
-type SecretHTTPClientImpl struct {
-	cc *http.Client
+func (c *SecretHTTPClientImpl) RollbackCluster(ctx context.Context, in *RollbackClusterRequest, opts ...http.CallOption) (*RollbackClusterReply, error) {
+	var out RollbackClusterReply
+	pattern := "/v1/cluster/{meta.type}/{meta.id}/{meta.username}/{meta.permission}/rollback"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSecretRollbackCluster))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
 }
 
-func NewSecretHTTPClient(client *http.Client) SecretHTTPClient {
-	return &SecretHTTPClientImpl{client}
+func (c *SecretHTTPClientImpl) RollbackGit(ctx context.Context, in *RollbackGitRequest, opts ...http.CallOption) (*RollbackGitReply, error) {
+	var out RollbackGitReply
+	pattern := "/v1/git/{meta.provider_type}/{meta.id}/{meta.username}/{meta.permission}/rollback"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSecretRollbackGit))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
 }
 
-func (c *SecretHTTPClientImpl) CreateCluster(ctx context.Context, in *ClusterRequest, opts ...http.CallOption) (*CreateClusterReply, error) {
-	var out CreateClusterReply
-	pattern := "/v1/cluster"
+func (c *SecretHTTPClientImpl) RollbackRepoAccountProduct(ctx context.Context, in *RollbackRepoRequest, opts ...http.CallOption) (*RollbackRepoReply, error) {
+	var out RollbackRepoReply
+	pattern := "/v1/repo/{meta.provider_id}/product/{meta.product}/rollback"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationSecretCreateCluster))
+	opts = append(opts, http.Operation(OperationSecretRollbackRepoAccountProduct))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -390,11 +2337,11 @@ func (c *SecretHTTPClientImpl) CreateCluster(ctx context.Context, in *ClusterReq
 	return &out, err
 }
 
-func (c *SecretHTTPClientImpl) CreateGit(ctx context.Context, in *GitRequest, opts ...http.CallOption) (*CreateGitReply, error) {
-	var out CreateGitReply
-	pattern := "/v1/git"
+func (c *SecretHTTPClientImpl) RollbackRepoAccountProject(ctx context.Context, in *RollbackRepoRequest, opts ...http.CallOption) (*RollbackRepoReply, error) {
+	var out RollbackRepoReply
+	pattern := "/v1/repo/{meta.provider_id}/product/{meta.product}/project/{meta.project}/rollback"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationSecretCreateGit))
+	opts = append(opts, http.Operation(OperationSecretRollbackRepoAccountProject))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -403,11 +2350,11 @@ func (c *SecretHTTPClientImpl) CreateGit(ctx context.Context, in *GitRequest, op
 	return &out, err
 }
 
-func (c *SecretHTTPClientImpl) CreatePki(ctx context.Context, in *PkiRequest, opts ...http.CallOption) (*CreatePkiReply, error) {
-	var out CreatePkiReply
-	pattern := "/v1/pki"
+func (c *SecretHTTPClientImpl) RollbackTenantGit(ctx context.Context, in *RollbackTenantGitRequest, opts ...http.CallOption) (*RollbackTenantGitReply, error) {
+	var out RollbackTenantGitReply
+	pattern := "/v1/tenant/git/{meta.id}/{meta.permission}/rollback"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationSecretCreatePki))
+	opts = append(opts, http.Operation(OperationSecretRollbackTenantGit))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -416,11 +2363,11 @@ func (c *SecretHTTPClientImpl) CreatePki(ctx context.Context, in *PkiRequest, op
 	return &out, err
 }
 
-func (c *SecretHTTPClientImpl) CreateRepoAccount(ctx context.Context, in *RepoRequest, opts ...http.CallOption) (*CreateRepoReply, error) {
-	var out CreateRepoReply
-	pattern := "/v1/repo"
+func (c *SecretHTTPClientImpl) RollbackTenantRepo(ctx context.Context, in *RollbackTenantRepoRequest, opts ...http.CallOption) (*RollbackTenantRepoReply, error) {
+	var out RollbackTenantRepoReply
+	pattern := "/v1/tenant/repos/{meta.id}/{meta.permission}/rollback"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationSecretCreateRepoAccount))
+	opts = append(opts, http.Operation(OperationSecretRollbackTenantRepo))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -429,11 +2376,11 @@ func (c *SecretHTTPClientImpl) CreateRepoAccount(ctx context.Context, in *RepoRe
 	return &out, err
 }
 
-func (c *SecretHTTPClientImpl) CreateTenantRepo(ctx context.Context, in *TenantRepoRequest, opts ...http.CallOption) (*CreateTenantRepoReply, error) {
-	var out CreateTenantRepoReply
-	pattern := "/v1/tenant/repos"
+func (c *SecretHTTPClientImpl) UndeleteCluster(ctx context.Context, in *UndeleteClusterRequest, opts ...http.CallOption) (*UndeleteClusterReply, error) {
+	var out UndeleteClusterReply
+	pattern := "/v1/cluster/{meta.type}/{meta.id}/{meta.username}/{meta.permission}/undelete"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationSecretCreateTenantRepo))
+	opts = append(opts, http.Operation(OperationSecretUndeleteCluster))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -442,11 +2389,11 @@ func (c *SecretHTTPClientImpl) CreateTenantRepo(ctx context.Context, in *TenantR
 	return &out, err
 }
 
-func (c *SecretHTTPClientImpl) CreteTenantGit(ctx context.Context, in *TenantGitRequest, opts ...http.CallOption) (*CreateTenantGitReply, error) {
-	var out CreateTenantGitReply
-	pattern := "/v1/tenant/git"
+func (c *SecretHTTPClientImpl) UndeleteGit(ctx context.Context, in *UndeleteGitRequest, opts ...http.CallOption) (*UndeleteGitReply, error) {
+	var out UndeleteGitReply
+	pattern := "/v1/git/{meta.provider_type}/{meta.id}/{meta.username}/{meta.permission}/undelete"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationSecretCreteTenantGit))
+	opts = append(opts, http.Operation(OperationSecretUndeleteGit))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -455,91 +2402,91 @@ func (c *SecretHTTPClientImpl) CreteTenantGit(ctx context.Context, in *TenantGit
 	return &out, err
 }
 
-func (c *SecretHTTPClientImpl) DeleteCluster(ctx context.Context, in *ClusterRequest, opts ...http.CallOption) (*DeleteClusterReply, error) {
-	var out DeleteClusterReply
-	pattern := "/v1/cluster/{meta.type}/{meta.id}/{meta.username}/{meta.permission}"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationSecretDeleteCluster))
+func (c *SecretHTTPClientImpl) UndeletePki(ctx context.Context, in *UndeletePkiRequest, opts ...http.CallOption) (*UndeletePkiReply, error) {
+	var out UndeletePkiReply
+	pattern := "/v1/pki/{domain}/undelete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSecretUndeletePki))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, err
 }
 
-func (c *SecretHTTPClientImpl) DeleteGit(ctx context.Context, in *GitRequest, opts ...http.CallOption) (*DeleteGitReply, error) {
-	var out DeleteGitReply
-	pattern := "/v1/git/{meta.provider_type}/{meta.id}/{meta.username}/{meta.permission}"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationSecretDeleteGit))
+func (c *SecretHTTPClientImpl) UndeleteSecret(ctx context.Context, in *UndeleteSecretRequest, opts ...http.CallOption) (*UndeleteSecretReply, error) {
+	var out UndeleteSecretReply
+	pattern := "/v1/secrets/{type}/undelete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSecretUndeleteSecret))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, err
 }
 
-func (c *SecretHTTPClientImpl) DeletePki(ctx context.Context, in *PkiRequest, opts ...http.CallOption) (*DeletePkiReply, error) {
-	var out DeletePkiReply
-	pattern := "/v1/pki/{domain}"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationSecretDeletePki))
+func (c *SecretHTTPClientImpl) UndeleteRepoAccountProduct(ctx context.Context, in *UndeleteRepoRequest, opts ...http.CallOption) (*UndeleteRepoReply, error) {
+	var out UndeleteRepoReply
+	pattern := "/v1/repo/{meta.provider_id}/product/{meta.product}/undelete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSecretUndeleteRepoAccountProduct))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, err
 }
 
-func (c *SecretHTTPClientImpl) DeleteRepoAccountProduct(ctx context.Context, in *RepoRequest, opts ...http.CallOption) (*DeleteRepoReply, error) {
-	var out DeleteRepoReply
-	pattern := "/v1/repo/{meta.provider_id}/product/{meta.product}"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationSecretDeleteRepoAccountProduct))
+func (c *SecretHTTPClientImpl) UndeleteRepoAccountProject(ctx context.Context, in *UndeleteRepoRequest, opts ...http.CallOption) (*UndeleteRepoReply, error) {
+	var out UndeleteRepoReply
+	pattern := "/v1/repo/{meta.provider_id}/product/{meta.product}/project/{meta.project}/undelete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSecretUndeleteRepoAccountProject))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, err
 }
 
-func (c *SecretHTTPClientImpl) DeleteRepoAccountProject(ctx context.Context, in *RepoRequest, opts ...http.CallOption) (*DeleteRepoReply, error) {
-	var out DeleteRepoReply
-	pattern := "/v1/repo/{meta.provider_id}/product/{meta.product}/project/{meta.project}"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationSecretDeleteRepoAccountProject))
+func (c *SecretHTTPClientImpl) UndeleteTenantGit(ctx context.Context, in *UndeleteTenantGitRequest, opts ...http.CallOption) (*UndeleteTenantGitReply, error) {
+	var out UndeleteTenantGitReply
+	pattern := "/v1/tenant/git/{meta.id}/{meta.permission}/undelete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSecretUndeleteTenantGit))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, err
 }
 
-func (c *SecretHTTPClientImpl) DeleteTenantGit(ctx context.Context, in *TenantGitRequest, opts ...http.CallOption) (*DeleteTenantGitReply, error) {
-	var out DeleteTenantGitReply
-	pattern := "/v1/tenant/git/{meta.id}"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationSecretDeleteTenantGit))
+func (c *SecretHTTPClientImpl) UndeleteTenantRepo(ctx context.Context, in *UndeleteTenantRepoRequest, opts ...http.CallOption) (*UndeleteTenantRepoReply, error) {
+	var out UndeleteTenantRepoReply
+	pattern := "/v1/tenant/repos/{meta.id}/{meta.permission}/undelete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSecretUndeleteTenantRepo))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, err
 }
 
-func (c *SecretHTTPClientImpl) DeleteTenantRepo(ctx context.Context, in *TenantRepoRequest, opts ...http.CallOption) (*DeleteTenantRepoReply, error) {
-	var out DeleteTenantRepoReply
-	pattern := "/v1/tenant/repos/{meta.id}"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationSecretDeleteTenantRepo))
+func (c *SecretHTTPClientImpl) UpdateSecretOwners(ctx context.Context, in *UpdateSecretOwnersRequest, opts ...http.CallOption) (*UpdateSecretOwnersReply, error) {
+	var out UpdateSecretOwnersReply
+	pattern := "/v1/secrets/{type}/owners"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSecretUpdateSecretOwners))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1147,3 +3094,207 @@ func (c *AuthGrantHTTPClientImpl) RevokeAuthroleTenantRepoPolicy(ctx context.Con
 	}
 	return &out, err
 }
+
+const OperationReconcileGetDrift = "/api.vaultproxy.v1.Reconcile/GetDrift"
+const OperationReconcileReconcile = "/api.vaultproxy.v1.Reconcile/Reconcile"
+
+type ReconcileHTTPServer interface {
+	GetDrift(context.Context, *GetDriftRequest) (*DriftReply, error)
+	Reconcile(context.Context, *ReconcileRequest) (*DriftReply, error)
+}
+
+func RegisterReconcileHTTPServer(s *http.Server, srv ReconcileHTTPServer) {
+	r := s.Route("/")
+	r.GET("/v1/drift", _Reconcile_GetDrift0_HTTP_Handler(srv))
+	r.POST("/v1/drift", _Reconcile_Reconcile0_HTTP_Handler(srv))
+}
+
+func _Reconcile_GetDrift0_HTTP_Handler(srv ReconcileHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetDriftRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReconcileGetDrift)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetDrift(ctx, req.(*GetDriftRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DriftReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Reconcile_Reconcile0_HTTP_Handler(srv ReconcileHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReconcileRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReconcileReconcile)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Reconcile(ctx, req.(*ReconcileRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DriftReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type ReconcileHTTPClient interface {
+	GetDrift(ctx context.Context, req *GetDriftRequest, opts ...http.CallOption) (rsp *DriftReply, err error)
+	Reconcile(ctx context.Context, req *ReconcileRequest, opts ...http.CallOption) (rsp *DriftReply, err error)
+}
+
+type ReconcileHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewReconcileHTTPClient(client *http.Client) ReconcileHTTPClient {
+	return &ReconcileHTTPClientImpl{client}
+}
+
+func (c *ReconcileHTTPClientImpl) GetDrift(ctx context.Context, in *GetDriftRequest, opts ...http.CallOption) (*DriftReply, error) {
+	var out DriftReply
+	pattern := "/v1/drift"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReconcileGetDrift))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ReconcileHTTPClientImpl) Reconcile(ctx context.Context, in *ReconcileRequest, opts ...http.CallOption) (*DriftReply, error) {
+	var out DriftReply
+	pattern := "/v1/drift"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReconcileReconcile))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+const OperationExpirationListExpirations = "/api.vaultproxy.v1.Expiration/ListExpirations"
+
+type ExpirationHTTPServer interface {
+	ListExpirations(context.Context, *ListExpirationsRequest) (*ListExpirationsReply, error)
+}
+
+func RegisterExpirationHTTPServer(s *http.Server, srv ExpirationHTTPServer) {
+	r := s.Route("/")
+	r.GET("/v1/expirations", _Expiration_ListExpirations0_HTTP_Handler(srv))
+}
+
+func _Expiration_ListExpirations0_HTTP_Handler(srv ExpirationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListExpirationsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExpirationListExpirations)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListExpirations(ctx, req.(*ListExpirationsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListExpirationsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type ExpirationHTTPClient interface {
+	ListExpirations(ctx context.Context, req *ListExpirationsRequest, opts ...http.CallOption) (rsp *ListExpirationsReply, err error)
+}
+
+type ExpirationHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewExpirationHTTPClient(client *http.Client) ExpirationHTTPClient {
+	return &ExpirationHTTPClientImpl{client}
+}
+
+func (c *ExpirationHTTPClientImpl) ListExpirations(ctx context.Context, in *ListExpirationsRequest, opts ...http.CallOption) (*ListExpirationsReply, error) {
+	var out ListExpirationsReply
+	pattern := "/v1/expirations"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationExpirationListExpirations))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+const OperationRotationListRotations = "/api.vaultproxy.v1.Rotation/ListRotations"
+
+type RotationHTTPServer interface {
+	ListRotations(context.Context, *ListRotationsRequest) (*ListRotationsReply, error)
+}
+
+func RegisterRotationHTTPServer(s *http.Server, srv RotationHTTPServer) {
+	r := s.Route("/")
+	r.GET("/v1/rotations", _Rotation_ListRotations0_HTTP_Handler(srv))
+}
+
+func _Rotation_ListRotations0_HTTP_Handler(srv RotationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListRotationsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRotationListRotations)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListRotations(ctx, req.(*ListRotationsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRotationsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type RotationHTTPClient interface {
+	ListRotations(ctx context.Context, req *ListRotationsRequest, opts ...http.CallOption) (rsp *ListRotationsReply, err error)
+}
+
+type RotationHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewRotationHTTPClient(client *http.Client) RotationHTTPClient {
+	return &RotationHTTPClientImpl{client}
+}
+
+func (c *RotationHTTPClientImpl) ListRotations(ctx context.Context, in *ListRotationsRequest, opts ...http.CallOption) (*ListRotationsReply, error) {
+	var out ListRotationsReply
+	pattern := "/v1/rotations"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRotationListRotations))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
@@ -18,7 +18,10 @@ import (
 	"flag"
 	"os"
 
+	v1 "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+	"github.com/nautes-labs/vault-proxy/internal/biz/vaultproxy"
 	"github.com/nautes-labs/vault-proxy/internal/conf"
+	"github.com/nautes-labs/vault-proxy/internal/pkg/idempotency"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -37,15 +40,22 @@ var (
 	Version string
 	// flagconf is the config flag.
 	flagconf string
+	// flagMigratePolicyNames runs the policy name migration instead of the server.
+	flagMigratePolicyNames bool
+	// flagDryRun prints the migration steps without running them.
+	flagDryRun bool
 
 	id, _ = os.Hostname()
 )
 
 func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs/config.yaml", "config path, eg: -conf config.yaml")
+	flag.BoolVar(&flagMigratePolicyNames, "migrate-policy-names", false, "rename the policies created before the policy names have the hash suffix, and exit")
+	flag.BoolVar(&flagDryRun, "dry-run", false, "print the migration steps without running them")
 }
 
-func newApp(logger log.Logger, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, hs *http.Server, uc *vaultproxy.VaultUsercase, reconciler *vaultproxy.Reconciler, reaper *vaultproxy.Reaper,
+	cleaner *idempotency.Cleaner) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -54,10 +64,70 @@ func newApp(logger log.Logger, hs *http.Server) *kratos.App {
 		kratos.Logger(logger),
 		kratos.Server(
 			hs,
+			reconciler,
+			reaper,
+			cleaner,
 		),
+		kratos.BeforeStart(uc.RecoverJournal),
 	)
 }
 
+// registerSecretTypes adds the secret types in config to the built-in types.
+func registerSecretTypes(secretTypes []*conf.SecretType) error {
+	for _, secretType := range secretTypes {
+		err := v1.RegisterSecretType(v1.SecretTypeDefinition{
+			Name:               secretType.Name,
+			SecretName:         secretType.SecretName,
+			PathTemplate:       secretType.PathTemplate,
+			PolicyNameTemplate: secretType.PolicyNameTemplate,
+			PolicyTemplate:     secretType.PolicyTemplate,
+			Schema:             newSecretSchema(secretType.Schema),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func configureSecretPolicies(secretPolicies []*conf.SecretPolicy) error {
+	for _, secretPolicy := range secretPolicies {
+		var permissions map[string][]string
+		if len(secretPolicy.Permissions) != 0 {
+			permissions = make(map[string][]string, len(secretPolicy.Permissions))
+			for permission, capabilities := range secretPolicy.Permissions {
+				permissions[permission] = capabilities.GetCapabilities()
+			}
+		}
+		err := v1.ConfigureSecretTypePolicy(secretPolicy.SecretType, secretPolicy.Template, permissions)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newSecretSchema(schema *conf.SecretSchema) *v1.SecretSchema {
+	if schema == nil {
+		return nil
+	}
+	s := &v1.SecretSchema{
+		AdditionalKeys:    schema.AdditionalKeys,
+		AdditionalMaxSize: int(schema.AdditionalMaxSize),
+		MaxSize:           int(schema.MaxSize),
+	}
+	for _, key := range schema.Keys {
+		s.Keys = append(s.Keys, v1.SecretKeySchema{
+			Name:     key.Name,
+			Required: key.Required,
+			Format:   key.Format,
+			Enum:     key.Enum,
+			MaxSize:  int(key.MaxSize),
+		})
+	}
+	return s
+}
+
 func main() {
 	flag.Parse()
 	logger := log.With(zap.NewLogger(),
@@ -85,6 +155,21 @@ func main() {
 		panic(err)
 	}
 
+	if err := registerSecretTypes(bc.SecretTypes); err != nil {
+		panic(err)
+	}
+
+	if err := configureSecretPolicies(bc.SecretPolicies); err != nil {
+		panic(err)
+	}
+
+	if flagMigratePolicyNames {
+		if err := migratePolicyNames(&bc, logger, flagDryRun, os.Stdout); err != nil {
+			panic(err)
+		}
+		return
+	}
+
 	app, cleanup, err := wireApp(bc.Server, bc.Data, logger)
 	if err != nil {
 		panic(err)
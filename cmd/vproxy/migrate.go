@@ -0,0 +1,58 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/nautes-labs/vault-proxy/internal/biz/vaultproxy"
+	"github.com/nautes-labs/vault-proxy/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// migratePolicyNames renames the policies created before the policy names have the hash suffix,
+// and prints the steps of the migration.
+func migratePolicyNames(bc *conf.Bootstrap, logger log.Logger, dryRun bool, out io.Writer) error {
+	uc, cleanup, err := wireUsercase(bc.Server, bc.Data, logger)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	report, migrateErr := uc.MigratePolicyNames(context.Background(), dryRun)
+	if report != nil {
+		printPolicyMigrationReport(report, out)
+	}
+	return migrateErr
+}
+
+func printPolicyMigrationReport(report *vaultproxy.PolicyMigrationReport, out io.Writer) {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "STEP\tOLD POLICY\tNEW POLICY\tSECRET\tROLE\tDONE")
+	for _, m := range report.Migrations {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%t\n", m.Step, m.OldPolicyName, m.NewPolicyName, m.FullPath, m.RolePath, m.Done)
+	}
+	w.Flush()
+
+	if report.DryRun {
+		fmt.Fprintf(out, "%d steps to run, nothing is changed in dry run mode\n", len(report.Migrations))
+	} else {
+		fmt.Fprintf(out, "%d steps are run\n", len(report.Migrations))
+	}
+}
@@ -34,3 +34,8 @@ import (
 func wireApp(*conf.Server, *conf.Data, log.Logger) (*kratos.App, func(), error) {
 	panic(wire.Build(server.ProviderSet, data.ProviderSet, vaultproxy.ProviderSet, service.ProviderSet, newApp))
 }
+
+// wireUsercase init the usecase for the commands which do not run the server.
+func wireUsercase(*conf.Server, *conf.Data, log.Logger) (*vaultproxy.VaultUsercase, func(), error) {
+	panic(wire.Build(data.ProviderSet, vaultproxy.ProviderSet))
+}
@@ -21,13 +21,28 @@ import (
 // wireApp init kratos application.
 func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
 	vaultClientInterface := data.NewVaultClient(confData, logger)
-	vaultUsercase := vaultproxy.NewVaultUsercase(vaultClientInterface, confServer, logger)
+	vaultUsercase := vaultproxy.NewVaultUsercase(vaultClientInterface, confServer, confData, logger)
 	secretService := service.NewSecretService(vaultUsercase)
 	authService := service.NewAuthService(vaultUsercase)
 	authGrantService := service.NewAuthGrantService(vaultUsercase)
 	healthService := service.NewHealthService(vaultUsercase)
-	httpServer := server.NewHTTPServer(confServer, secretService, authService, authGrantService, healthService, logger)
-	app := newApp(logger, httpServer)
+	reconciler := vaultproxy.NewReconciler(vaultUsercase, confServer)
+	reconcileService := service.NewReconcileService(reconciler)
+	expirationService := service.NewExpirationService(vaultUsercase)
+	rotationService := service.NewRotationService(vaultUsercase)
+	store := data.NewIdempotencyStore(vaultClientInterface, confData)
+	httpServer := server.NewHTTPServer(confServer, secretService, authService, authGrantService, healthService, reconcileService, expirationService, rotationService, confData, store, logger)
+	reaper := vaultproxy.NewReaper(vaultUsercase, confServer)
+	cleaner := server.NewIdempotencyCleaner(confData, store, logger)
+	app := newApp(logger, httpServer, vaultUsercase, reconciler, reaper, cleaner)
 	return app, func() {
 	}, nil
 }
+
+// wireUsercase init the usecase for the commands which do not run the server.
+func wireUsercase(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*vaultproxy.VaultUsercase, func(), error) {
+	vaultClientInterface := data.NewVaultClient(confData, logger)
+	vaultUsercase := vaultproxy.NewVaultUsercase(vaultClientInterface, confServer, confData, logger)
+	return vaultUsercase, func() {
+	}, nil
+}
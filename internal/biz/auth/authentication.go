@@ -34,6 +34,9 @@ func GetUsername(req *http.Request) (string, error) {
 func NewAuthContext(ctx context.Context, user string) context.Context {
 	return context.WithValue(ctx, AuthUser{}, user)
 }
+
+// FromAuthContext returns the user in the context, it is empty if the request is not authenticated.
 func FromAuthContext(ctx context.Context) string {
-	return ctx.Value(AuthUser{}).(string)
+	user, _ := ctx.Value(AuthUser{}).(string)
+	return user
 }
@@ -50,7 +50,7 @@ p = usr, sec, act
 e = some(where (p.eft == allow))
 
 [matchers]
-m = r.usr == p.usr && regexMatch(r.sec, p.sec) && regexMatch(r.act, p.act)
+m = r.usr == p.usr && regexMatch(r.sec, p.sec) && regexMatch(r.act, "^(" + p.act + ")$")
 `)
 	permissionModel, _ = model.NewModelFromString(`
 [request_definition]
@@ -142,6 +142,9 @@ func GetSecretData(ctx context.Context, req interface{}) (user, resource, action
 	}
 	resource = metadata.FullPath
 	action = httpTransport.Request().Method
+	if actionReq, ok := req.(v1.SecActionRequest); ok {
+		action = actionReq.Action()
+	}
 	return user, resource, action, nil
 }
 
@@ -202,6 +205,9 @@ func AuthProcess(ctx context.Context, auth *Authorizer, checkType int, req inter
 	// Do resource inspect, permission inspect, type inspect, black list inspect
 	switch checkType {
 	case BASIC:
+		if batch, ok := req.(v1.SecBatchRequest); ok {
+			return checkBatchPermission(ctx, auth, batch)
+		}
 		user, resource, action, err := GetSecretData(ctx, req)
 		if err != nil {
 			return v1.ErrorInputArgError("Can not conver request type: %s", err)
@@ -224,6 +230,53 @@ func AuthProcess(ctx context.Context, auth *Authorizer, checkType int, req inter
 	return nil
 }
 
+// checkBatchPermission authorizes every secret in the batch request by the action of the batch,
+// the whole batch is rejected if any of them is not allowed.
+func checkBatchPermission(ctx context.Context, auth *Authorizer, batch v1.SecBatchRequest) error {
+	user := FromAuthContext(ctx)
+	for i, req := range batch.SecRequests() {
+		secret, err := req.ConvertRequest()
+		if err != nil {
+			return v1.ErrorInputArgError("Can not convert item %d: %s", i, err)
+		}
+		err = auth.CheckSecretPermission(ctx, user, secret.FullPath, batch.Action())
+		if err != nil {
+			return v1.ErrorActionNotAllow("Item %d is not allowed by current user: %s", i, err)
+		}
+	}
+	return nil
+}
+
+type authorizerKey struct{}
+
+func NewAuthorizerContext(ctx context.Context, auth *Authorizer) context.Context {
+	return context.WithValue(ctx, authorizerKey{}, auth)
+}
+
+// ReadableFilter returns a function to check whether the user in ctx can read the resource.
+// It is used by the requests whose resources are only known after the request is handled, such as list.
+// If there is no authorizer in ctx, it returns nil.
+func ReadableFilter(ctx context.Context) func(resource string) bool {
+	return actionFilter(ctx, http.MethodGet)
+}
+
+// DeletableFilter returns a function to check whether the user in ctx can delete the resource,
+// such as the children of a recursive delete. If there is no authorizer in ctx, it returns nil.
+func DeletableFilter(ctx context.Context) func(resource string) bool {
+	return actionFilter(ctx, http.MethodDelete)
+}
+
+func actionFilter(ctx context.Context, action string) func(resource string) bool {
+	auth, ok := ctx.Value(authorizerKey{}).(*Authorizer)
+	if !ok {
+		return nil
+	}
+	user := FromAuthContext(ctx)
+	return func(resource string) bool {
+		return auth.CheckSecretPermission(ctx, user, resource, action) == nil
+	}
+}
+
 func createBlackListACL(regexs []string) string {
 	acl := ""
 	for _, regex := range regexs {
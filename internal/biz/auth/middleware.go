@@ -35,6 +35,7 @@ func Authorize(c *conf.Server, checkType int) middleware.Middleware {
 			if err != nil {
 				return nil, err
 			}
+			ctx = NewAuthorizerContext(ctx, authorizer)
 			return handler(ctx, req)
 		}
 	}
@@ -48,16 +48,27 @@ var _ = BeforeSuite(func() {
 				"tenant",
 			},
 		})
+	Expect(err).Should(BeNil())
 
-	resList = map[string]string{
-		"git":         "git/gitlab",
-		"repo":        "repo/nexus",
-		"cluster":     "cluster/k8s",
-		"auth":        "auth/kubernetes",
-		"tenant/repo": "tenant/repo/harbor",
-	}
+	tenantRepo, err := (&v1.GetTenantRepoRequest{
+		Meta: &v1.TenantRepoMeta{Id: "harbor", Permission: "readonly"},
+	}).ConvertRequest()
+	Expect(err).Should(BeNil())
+	tenantGit, err := (&v1.GetTenantGitRequest{
+		Meta: &v1.TenantGitMeta{Id: "gitlab", Permission: "readonly"},
+	}).ConvertRequest()
 	Expect(err).Should(BeNil())
 
+	resList = map[string]string{
+		"git":              "git/gitlab",
+		"repo":             "repo/nexus",
+		"cluster":          "cluster/k8s",
+		"auth":             "auth/kubernetes",
+		"tenant/git":       tenantGit.FullPath,
+		"tenant/repo":      tenantRepo.FullPath,
+		"pki":              "pki/data/example.com",
+		"drift":            v1.DriftPath,
+	}
 })
 
 var _ = Describe("Permission", func() {
@@ -65,7 +76,7 @@ var _ = Describe("Permission", func() {
 		user := "API"
 
 		It("create/delete git resource is allowed", func() {
-			resours := []string{resList["git"], resList["cluster"], resList["tenant/repo"]}
+			resours := []string{resList["git"], resList["cluster"], resList["tenant/git"], resList["tenant/repo"], resList["pki"]}
 			for _, res := range resours {
 				err := auther.CheckSecretPermission(context.Background(), user, res, "POST")
 				Expect(err).Should(BeNil())
@@ -75,6 +86,51 @@ var _ = Describe("Permission", func() {
 			}
 		})
 
+		It("get git resource metadata is allowed", func() {
+			resours := []string{resList["git"], resList["cluster"], resList["tenant/git"], resList["tenant/repo"], resList["pki"]}
+			for _, res := range resours {
+				err := auther.CheckSecretPermission(context.Background(), user, res, "GET")
+				Expect(err).Should(BeNil())
+			}
+		})
+
+		It("rollback git resource is allowed", func() {
+			resours := []string{resList["git"], resList["cluster"], resList["tenant/git"], resList["tenant/repo"]}
+			for _, res := range resours {
+				err := auther.CheckSecretPermission(context.Background(), user, res, v1.ActionRollback)
+				Expect(err).Should(BeNil())
+			}
+		})
+
+		It("undelete and purge git resource is allowed", func() {
+			resours := []string{resList["git"], resList["cluster"], resList["tenant/git"], resList["tenant/repo"], resList["pki"]}
+			for _, res := range resours {
+				err := auther.CheckSecretPermission(context.Background(), user, res, v1.ActionUndelete)
+				Expect(err).Should(BeNil())
+
+				err = auther.CheckSecretPermission(context.Background(), user, res, v1.ActionPurge)
+				Expect(err).Should(BeNil())
+			}
+		})
+
+		It("read tenant repo values is allowed, read other values is not allowed", func() {
+			err := auther.CheckSecretPermission(context.Background(), user, resList["tenant/repo"], v1.ActionRead)
+			Expect(err).Should(BeNil())
+
+			resours := []string{resList["git"], resList["cluster"], resList["pki"], resList["repo"], resList["tenant/git"]}
+			for _, res := range resours {
+				err := auther.CheckSecretPermission(context.Background(), user, res, v1.ActionRead)
+				Expect(err).ShouldNot(BeNil())
+			}
+		})
+
+		It("get and reconcile drift is allowed", func() {
+			err := auther.CheckSecretPermission(context.Background(), user, resList["drift"], "GET")
+			Expect(err).Should(BeNil())
+			err = auther.CheckSecretPermission(context.Background(), user, resList["drift"], "POST")
+			Expect(err).Should(BeNil())
+		})
+
 		It("create/delete other resource is not allowed", func() {
 			resours := []string{resList["repo"], resList["auth"]}
 			for _, res := range resours {
@@ -121,6 +177,18 @@ var _ = Describe("Permission", func() {
 
 				err = auther.CheckSecretPermission(context.Background(), user, res, "DELETE")
 				Expect(err).ShouldNot(BeNil())
+
+				err = auther.CheckSecretPermission(context.Background(), user, res, "GET")
+				Expect(err).ShouldNot(BeNil())
+
+				err = auther.CheckSecretPermission(context.Background(), user, res, v1.ActionRollback)
+				Expect(err).ShouldNot(BeNil())
+
+				err = auther.CheckSecretPermission(context.Background(), user, res, v1.ActionPurge)
+				Expect(err).ShouldNot(BeNil())
+
+				err = auther.CheckSecretPermission(context.Background(), user, res, v1.ActionRead)
+				Expect(err).ShouldNot(BeNil())
 			}
 		})
 
@@ -132,6 +200,14 @@ var _ = Describe("Permission", func() {
 			Expect(err).Should(BeNil())
 		})
 
+		It("delete role does not allow undelete", func() {
+			res := "auth/kubernetes/role/humen"
+			err := auther.CheckSecretPermission(context.Background(), user, res, "DELETE")
+			Expect(err).Should(BeNil())
+			err = auther.CheckSecretPermission(context.Background(), user, res, v1.ActionUndelete)
+			Expect(err).ShouldNot(BeNil())
+		})
+
 		It("create role in tenant will failed", func() {
 			res := "auth/tenant/role/ARGO"
 			err := auther.CheckSecretPermission(context.Background(), user, res, "POST")
@@ -180,6 +256,76 @@ var _ = Describe("Other", func() {
 		})
 		Expect(err).Should(BeNil())
 	})
+
+	It("action of rollback request is not the http method", func() {
+		ctx := context.Background()
+		ctx = transport.NewServerContext(ctx, mockTransporter{Method: "POST"})
+		ctx = auth.NewAuthContext(ctx, "CLUSTER")
+		_, _, action, err := auth.GetSecretData(ctx, &v1.RollbackClusterRequest{
+			Meta: &v1.ClusterMeta{
+				Type:       "kubernetes",
+				Id:         "tenant",
+				Username:   "default",
+				Permission: "admin",
+			},
+			Version: 1,
+		})
+		Expect(err).Should(BeNil())
+		Expect(action).Should(Equal(v1.ActionRollback))
+	})
+	It("action of docker config request is read", func() {
+		ctx := context.Background()
+		ctx = transport.NewServerContext(ctx, mockTransporter{Method: "GET"})
+		ctx = auth.NewAuthContext(ctx, "REPO")
+		_, _, action, err := auth.GetSecretData(ctx, &v1.RenderRepoDockerConfigRequest{
+			Meta: &v1.RepoMeta{
+				ProviderId: "harbor",
+				Product:    "product",
+			},
+		})
+		Expect(err).Should(BeNil())
+		Expect(action).Should(Equal(v1.ActionRead))
+	})
+	It("every secret in batch request is authorized", func() {
+		ctx := context.Background()
+		ctx = transport.NewServerContext(ctx, mockTransporter{Method: "POST"})
+		ctx = auth.NewAuthContext(ctx, "CLUSTER")
+		clusterItem := &v1.CreateSecretRequest{
+			Type: "cluster",
+			Meta: map[string]string{"type": "kubernetes", "id": "tenant", "username": "default", "permission": "admin"},
+		}
+		gitItem := &v1.CreateSecretRequest{
+			Type: "git",
+			Meta: map[string]string{"provider_type": "gitlab", "id": "123", "username": "default", "permission": "readonly"},
+		}
+		err := auth.AuthProcess(ctx, auther, auth.BASIC, &v1.BatchCreateSecretsRequest{
+			Items: []*v1.CreateSecretRequest{clusterItem},
+		})
+		Expect(err).Should(BeNil())
+
+		err = auth.AuthProcess(ctx, auther, auth.BASIC, &v1.BatchCreateSecretsRequest{
+			Items: []*v1.CreateSecretRequest{clusterItem, gitItem},
+		})
+		Expect(v1.IsActionNotAllow(err)).Should(BeTrue())
+
+		err = auth.AuthProcess(ctx, auther, auth.BASIC, &v1.BatchDeleteSecretsRequest{
+			Items: []*v1.DeleteSecretRequest{{Type: "cluster", Meta: map[string]string{"type": "kubernetes"}}},
+		})
+		Expect(v1.IsInputArgError(err)).Should(BeTrue())
+	})
+	It("action of purge request is not the http method", func() {
+		ctx := context.Background()
+		ctx = transport.NewServerContext(ctx, mockTransporter{Method: "DELETE"})
+		ctx = auth.NewAuthContext(ctx, "REPO")
+		_, _, action, err := auth.GetSecretData(ctx, &v1.PurgeRepoRequest{
+			Meta: &v1.RepoMeta{
+				ProviderId: "nexus",
+				Product:    "product",
+			},
+		})
+		Expect(err).Should(BeNil())
+		Expect(action).Should(Equal(v1.ActionPurge))
+	})
 })
 
 var _ = Describe("Authentication", func() {
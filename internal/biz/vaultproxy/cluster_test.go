@@ -0,0 +1,131 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaultproxy_test
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vpApi "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+)
+
+// newMockKubeconfig returns a kubeconfig with the contexts "host" and "member",
+// the current context is "host" and uses the client cert.
+func newMockKubeconfig(ca, client *mockCert) string {
+	encode := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+	return fmt.Sprintf(`apiVersion: v1
+kind: Config
+current-context: host
+clusters:
+- name: host
+  cluster:
+    server: https://127.0.0.1:6443
+    certificate-authority-data: %s
+- name: member
+  cluster:
+    server: https://127.0.0.2:6443
+contexts:
+- name: host
+  context:
+    cluster: host
+    user: admin
+- name: member
+  context:
+    cluster: member
+    user: member
+users:
+- name: admin
+  user:
+    client-certificate-data: %s
+    client-key-data: %s
+- name: member
+  user:
+    token: abc
+`, encode(ca.certPEM), encode(client.certPEM), encode(client.keyPEM))
+}
+
+func newClusterRequest(kubeconfig string) *vpApi.ClusterRequest {
+	return &vpApi.ClusterRequest{
+		Meta: &vpApi.ClusterMeta{
+			Type:       "kubernetes",
+			Id:         "host",
+			Username:   "admin",
+			Permission: "admin",
+		},
+		Account: &vpApi.ClusterAccount{Kubeconfig: kubeconfig},
+	}
+}
+
+var _ = Describe("Cluster", func() {
+	var ca, client *mockCert
+
+	BeforeEach(func() {
+		ca = newMockCert("nautes-ca", nil, true, nil, time.Now().Add(time.Hour))
+		client = newMockCert("admin", nil, false, ca, time.Now().Add(time.Hour))
+	})
+
+	It("validate kubeconfig returns the server and the expiry of the current context", func() {
+		info, err := vpApi.ValidateKubeconfig(newMockKubeconfig(ca, client), time.Now())
+		Expect(err).Should(BeNil())
+		Expect(info.Server).Should(Equal("https://127.0.0.1:6443"))
+		Expect(info.Expiry).Should(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+
+		expired := newMockCert("admin", nil, false, ca, time.Now().Add(-time.Hour))
+		violations := vpApi.ValidateSecretData("cluster", map[string]interface{}{"kubeconfig": newMockKubeconfig(ca, expired)})
+		Expect(violations).Should(HaveLen(1))
+		Expect(violations[0].Description).Should(ContainSubstring("expired"))
+	})
+
+	It("create failed when kubeconfig can not be parsed", func() {
+		_, err := vpClient.CreateCluster(context.Background(), newClusterRequest("clusters: ["))
+		Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+	})
+
+	It("create failed when current context is not found", func() {
+		kubeconfig := strings.Replace(newMockKubeconfig(ca, client), "current-context: host", "current-context: other", 1)
+		_, err := vpClient.CreateCluster(context.Background(), newClusterRequest(kubeconfig))
+		Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+		Expect(err.Error()).Should(ContainSubstring("context other"))
+	})
+
+	It("create failed when embedded cert is expired", func() {
+		expired := newMockCert("admin", nil, false, ca, time.Now().Add(-time.Hour))
+		_, err := vpClient.CreateCluster(context.Background(), newClusterRequest(newMockKubeconfig(ca, expired)))
+		Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+		Expect(err.Error()).Should(ContainSubstring("expired"))
+	})
+
+	It("create secret failed when embedded cert is expired", func() {
+		expired := newMockCert("admin", nil, false, ca, time.Now().Add(-time.Hour))
+		_, err := vpClient.CreateSecret(context.Background(), newClusterRequest(newMockKubeconfig(ca, expired)))
+		Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+		Expect(err.Error()).Should(ContainSubstring("expired"))
+	})
+
+	It("batch create failed when embedded cert is expired", func() {
+		expired := newMockCert("admin", nil, false, ca, time.Now().Add(-time.Hour))
+		reqs := []vpApi.SecRequest{newClusterRequest(newMockKubeconfig(ca, expired))}
+		results, err := vpClient.BatchCreateSecrets(context.Background(), reqs, false)
+		Expect(err).Should(BeNil())
+		Expect(vpApi.IsInputArgError(results[0].Err)).Should(BeTrue())
+		Expect(results[0].Err.Error()).Should(ContainSubstring("expired"))
+	})
+})
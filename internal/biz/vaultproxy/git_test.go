@@ -0,0 +1,118 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaultproxy_test
+
+import (
+	"context"
+	"encoding/pem"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vault "github.com/hashicorp/vault/api"
+	vpApi "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+	"github.com/nautes-labs/vault-proxy/internal/biz/vaultproxy"
+	"golang.org/x/crypto/ssh"
+)
+
+func newGitRequest(kvs *vpApi.GitKVs) *vpApi.GitRequest {
+	return &vpApi.GitRequest{
+		Meta: &vpApi.GitMeta{
+			ProviderType: "gitlab",
+			Id:           "1",
+			Username:     "default",
+			Permission:   "readonly",
+		},
+		Kvs: kvs,
+	}
+}
+
+var _ = Describe("Git", func() {
+	It("create failed when deploy key is set and generate deploy key", func() {
+		req := newGitRequest(&vpApi.GitKVs{DeployKey: "key"})
+		req.GenerateDeployKey = true
+		_, _, err := vpClient.CreateGit(context.Background(), req)
+		Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+	})
+
+	It("create failed when the path has dots", func() {
+		req := newGitRequest(&vpApi.GitKVs{DeployKey: "key"})
+		req.Meta.Id = "my.repo"
+		_, _, err := vpClient.CreateGit(context.Background(), req)
+		Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+		Expect(err.Error()).Should(ContainSubstring("secret verify failed"))
+	})
+
+	Describe("Generate Deploy Key", func() {
+		var vaultServer *exec.Cmd
+
+		BeforeEach(func() {
+			vaultServer = exec.Command("vault", "server", "-dev", "-dev-root-token-id=test")
+			err := vaultServer.Start()
+			Expect(err).Should(BeNil())
+
+			for {
+				vaultServerHealthCheck := exec.Command("vault", "status", "-address=http://127.0.0.1:8200")
+				err := vaultServerHealthCheck.Run()
+				if err == nil {
+					break
+				}
+			}
+
+			err = vaultRawClient.Sys().Mount("git", &vault.MountInput{
+				Type:    "kv",
+				Options: map[string]string{"version": "2"},
+			})
+			Expect(err).Should(BeNil())
+		})
+
+		AfterEach(func() {
+			err := vaultServer.Process.Kill()
+			Expect(err).Should(BeNil())
+		})
+
+		DescribeTable("the stored deploy key is the private key of the returned public key",
+			func(keyType, pemType string) {
+				req := newGitRequest(&vpApi.GitKVs{AccessToken: "token"})
+				req.GenerateDeployKey = true
+				req.DeployKeyType = keyType
+				sec, publicKey, err := vpClient.CreateGit(context.Background(), req)
+				Expect(err).Should(BeNil())
+
+				kv, err := vaultRawClient.KVv2("git").Get(context.Background(), sec.SecretPath)
+				Expect(err).Should(BeNil())
+				Expect(kv.Data["accesstoken"]).Should(Equal("token"))
+				deployKey, _ := kv.Data["deploykey"].(string)
+				block, _ := pem.Decode([]byte(deployKey))
+				Expect(block).ShouldNot(BeNil())
+				Expect(block.Type).Should(Equal(pemType))
+
+				signer, err := ssh.ParsePrivateKey([]byte(deployKey))
+				Expect(err).Should(BeNil())
+				authorizedKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+				Expect(err).Should(BeNil())
+				Expect(signer.PublicKey().Marshal()).Should(Equal(authorizedKey.Marshal()))
+
+				data := []byte("deploy key")
+				signature, err := signer.Sign(nil, data)
+				Expect(err).Should(BeNil())
+				Expect(authorizedKey.Verify(data, signature)).Should(BeNil())
+			},
+			Entry("ed25519", vaultproxy.DeployKeyTypeEd25519, "OPENSSH PRIVATE KEY"),
+			Entry("rsa", vaultproxy.DeployKeyTypeRSA, "RSA PRIVATE KEY"),
+		)
+	})
+})
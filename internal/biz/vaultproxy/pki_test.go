@@ -0,0 +1,129 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaultproxy_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vpApi "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+)
+
+type mockCert struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM string
+	keyPEM  string
+}
+
+func newMockCert(commonName string, dnsNames []string, isCA bool, parent *mockCert, notAfter time.Time) *mockCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).Should(BeNil())
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		DNSNames:              dnsNames,
+		NotBefore:             notAfter.Add(-2 * time.Hour),
+		NotAfter:              notAfter,
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+
+	signerCert, signerKey := tmpl, key
+	if parent != nil {
+		signerCert, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signerCert, &key.PublicKey, signerKey)
+	Expect(err).Should(BeNil())
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).Should(BeNil())
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	Expect(err).Should(BeNil())
+
+	return &mockCert{
+		cert:    cert,
+		key:     key,
+		certPEM: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		keyPEM:  string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+	}
+}
+
+var _ = Describe("Pki", func() {
+	var ca, intermediate, leaf *mockCert
+	var req *vpApi.PkiRequest
+
+	BeforeEach(func() {
+		ca = newMockCert("nautes-ca", nil, true, nil, time.Now().Add(time.Hour))
+		intermediate = newMockCert("nautes-intermediate", nil, true, ca, time.Now().Add(time.Hour))
+		leaf = newMockCert("example.com", []string{"example.com"}, false, intermediate, time.Now().Add(time.Hour))
+		req = &vpApi.PkiRequest{
+			Domain: "example.com",
+			Cacert: ca.certPEM,
+			Cert:   leaf.certPEM + intermediate.certPEM,
+			Key:    leaf.keyPEM,
+		}
+	})
+
+	It("store cert under pki with a read policy", func() {
+		sec, err := req.ConvertRequest()
+		Expect(err).Should(BeNil())
+		Expect(sec.SecretName).Should(Equal("pki"))
+		Expect(sec.SecretPath).Should(Equal("example.com"))
+		Expect(sec.FullPath).Should(Equal("pki/data/example.com"))
+		Expect(sec.PolicyName).Should(MatchRegexp(`^pki-example\.com-[0-9a-f]{12}$`))
+		Expect(sec.LegacyPolicyName).Should(Equal("pki-example.com"))
+		Expect(sec.SecretData).Should(Equal(map[string]interface{}{
+			"cacert": req.Cacert,
+			"cert":   req.Cert,
+			"key":    req.Key,
+		}))
+	})
+
+	It("create failed when cert and key are not a pair", func() {
+		req.Key = newMockCert("example.com", []string{"example.com"}, false, intermediate, time.Now().Add(time.Hour)).keyPEM
+		_, err := vpClient.CreatePki(context.Background(), req)
+		Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+	})
+
+	It("create failed when cert chain can not be verified by cacert", func() {
+		req.Cacert = newMockCert("other-ca", nil, true, nil, time.Now().Add(time.Hour)).certPEM
+		_, err := vpClient.CreatePki(context.Background(), req)
+		Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+	})
+
+	It("create failed when intermediate cert is missing", func() {
+		req.Cert = leaf.certPEM
+		_, err := vpClient.CreatePki(context.Background(), req)
+		Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+	})
+
+	It("create failed when cert is not issued for the domain", func() {
+		req.Domain = "nautes.io"
+		_, err := vpClient.CreatePki(context.Background(), req)
+		Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+	})
+})
@@ -0,0 +1,59 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaultproxy_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vpApi "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+)
+
+func newRepoRequest(account *vpApi.RepoAccount) *vpApi.RepoRequest {
+	return &vpApi.RepoRequest{
+		Meta:    &vpApi.RepoMeta{ProviderId: "nexus", Product: "product", Project: "project"},
+		Account: account,
+	}
+}
+
+var _ = Describe("Repo", func() {
+	It("create failed when generate password for token auth type", func() {
+		req := newRepoRequest(&vpApi.RepoAccount{
+			AuthType:         vpApi.AuthTypeToken,
+			GeneratePassword: true,
+		})
+		_, _, err := vpClient.CreateRepoAccount(context.Background(), req)
+		Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+	})
+
+	It("create failed when password is set and generate password", func() {
+		req := newRepoRequest(&vpApi.RepoAccount{
+			AuthType:         vpApi.AuthTypePassword,
+			Account:          &vpApi.Account{Username: "admin", Password: "password"},
+			GeneratePassword: true,
+		})
+		_, _, err := vpClient.CreateRepoAccount(context.Background(), req)
+		Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+	})
+
+	It("render docker config failed when registry of provider is not configured", func() {
+		_, err := vpClient.RenderDockerConfig(context.Background(), &vpApi.RenderRepoDockerConfigRequest{
+			Meta: &vpApi.RepoMeta{ProviderId: "harbor", Product: "product"},
+		})
+		Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+	})
+})
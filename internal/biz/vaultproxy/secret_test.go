@@ -16,21 +16,29 @@ package vaultproxy_test
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"os"
 	"os/exec"
+	"strings"
 	"time"
+	"unicode/utf8"
 
+	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	vault "github.com/hashicorp/vault/api"
 	vpApi "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+	"github.com/nautes-labs/vault-proxy/internal/biz/auth"
 	"github.com/nautes-labs/vault-proxy/internal/biz/vaultproxy"
 	"github.com/nautes-labs/vault-proxy/internal/conf"
 	vpData "github.com/nautes-labs/vault-proxy/internal/data"
+	"github.com/nautes-labs/vault-proxy/internal/pkg/dryrun"
+	"github.com/nautes-labs/vault-proxy/internal/pkg/requestid"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 type mockSecret struct {
@@ -41,6 +49,7 @@ type mockSecret struct {
 	PolicyName string
 	SecretData map[string]interface{}
 	PolicyData string
+	Version    *int
 	err        error
 }
 
@@ -50,9 +59,10 @@ func (m *mockSecret) ConvertRequest() (*vpApi.SecretRequest, error) {
 	}
 	secretMeta, _ := m.GetNames()
 	return &vpApi.SecretRequest{
-		SecretMeta: *secretMeta,
-		SecretData: m.SecretData,
-		PolicyData: m.PolicyData,
+		SecretMeta:  *secretMeta,
+		SecretData:  m.SecretData,
+		PolicyData:  m.PolicyData,
+		CheckAndSet: m.Version,
 	}, nil
 }
 
@@ -66,6 +76,11 @@ func (m *mockSecret) GetNames() (*vpApi.SecretMeta, error) {
 	}, nil
 }
 
+// Action makes the mock usable as undelete and purge requests.
+func (m *mockSecret) Action() string {
+	return ""
+}
+
 type mockPolicyRequest struct {
 	ClusterName string
 	DestUser    string
@@ -94,7 +109,9 @@ var _ = BeforeSuite(func() {
 		Authorization: &conf.Server_Authorization{
 			Resource: &conf.Server_Authorization_Casbin{Acl: casbinPermissionFile},
 		},
-	}, log.DefaultLogger)
+		Registries: map[string]string{"nexus": "nexus.example.com"},
+		Nautes:     &conf.Nautes{TenantName: []string{"nautes"}},
+	}, &vaultClientCfg, log.DefaultLogger)
 
 	cfg := vault.DefaultConfig()
 	cfg.Address = "http://127.0.0.1:8200"
@@ -165,85 +182,1264 @@ var _ = Describe("Secret", func() {
 				It("will failed and remove secret", func() {
 					secret.PolicyName = ""
 
-					_, err := vpClient.CreateSecret(context.Background(), secret)
-					Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+					_, err := vpClient.CreateSecret(context.Background(), secret)
+					Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+
+					_, err = vaultRawClient.KVv2(secret.SecretName).Get(context.Background(), secret.SecretPath)
+					Expect(err).NotTo(BeNil())
+				})
+			})
+
+			It("when req is not legal, create secret failed", func() {
+				req := &mockSecret{
+					err: errors.New("err"),
+				}
+				_, err := vpClient.CreateSecret(context.Background(), req)
+				Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+
+				req = &mockSecret{
+					FullPath: "%(*&%",
+				}
+
+				_, err = vpClient.CreateSecret(context.Background(), req)
+				Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+			})
+
+			It("when data does not match the schema, create secret failed with the violations", func() {
+				req := &vpApi.RepoRequest{
+					Meta:    &vpApi.RepoMeta{ProviderId: "nexus", Product: "product"},
+					Account: &vpApi.RepoAccount{AuthType: vpApi.AuthTypePassword},
+				}
+				_, err := vpClient.CreateSecret(context.Background(), req)
+				Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+				Expect(kerrors.FromError(err).Metadata).Should(HaveKeyWithValue("username", "key is required"))
+
+				_, err = vaultRawClient.KVv2("repo").Get(context.Background(), "nexus/product")
+				Expect(err).NotTo(BeNil())
+			})
+
+		})
+
+		It("create repo account with generated password and returns the password", func() {
+			err := vaultRawClient.Sys().Mount("repo", &vault.MountInput{
+				Type:    "kv",
+				Options: map[string]string{"version": "2"},
+			})
+			Expect(err).Should(BeNil())
+
+			req := newRepoRequest(&vpApi.RepoAccount{
+				AuthType:         vpApi.AuthTypePassword,
+				Account:          &vpApi.Account{Username: "admin"},
+				GeneratePassword: true,
+			})
+			sec, password, err := vpClient.CreateRepoAccount(context.Background(), req)
+			Expect(err).Should(BeNil())
+			Expect(password).Should(HaveLen(32))
+			Expect(req.Account.Account.Password).Should(BeEmpty())
+
+			kv, err := vaultRawClient.KVv2("repo").Get(context.Background(), sec.SecretPath)
+			Expect(err).Should(BeNil())
+			Expect(kv.Data["username"]).Should(Equal("admin"))
+			Expect(kv.Data["password"]).Should(Equal(password))
+		})
+
+		It("generate password of the symbols which are not ascii", func() {
+			err := vaultRawClient.Sys().Mount("repo", &vault.MountInput{
+				Type:    "kv",
+				Options: map[string]string{"version": "2"},
+			})
+			Expect(err).Should(BeNil())
+			dataCfg := &conf.Data{
+				Vault: &conf.Data_Vault{
+					Addr:  "http://127.0.0.1:8200",
+					Token: "test",
+				},
+			}
+			policyClient := vaultproxy.NewVaultUsercase(vpData.NewVaultClient(dataCfg, log.DefaultLogger), &conf.Server{
+				Authorization: &conf.Server_Authorization{
+					Resource: &conf.Server_Authorization_Casbin{Acl: casbinPermissionFile},
+				},
+				PasswordPolicy: &conf.Server_PasswordPolicy{
+					Length:           16,
+					CharacterClasses: []string{vaultproxy.CharacterClassDigits, vaultproxy.CharacterClassSymbols},
+					Symbols:          "äöü€",
+				},
+			}, dataCfg, log.DefaultLogger)
+
+			_, password, err := policyClient.CreateRepoAccount(context.Background(), newRepoRequest(&vpApi.RepoAccount{
+				AuthType:         vpApi.AuthTypePassword,
+				Account:          &vpApi.Account{Username: "admin"},
+				GeneratePassword: true,
+			}))
+			Expect(err).Should(BeNil())
+			Expect(utf8.ValidString(password)).Should(BeTrue())
+			Expect([]rune(password)).Should(HaveLen(16))
+			Expect(password).Should(MatchRegexp(`^[0-9äöü€]+$`))
+			Expect(password).Should(MatchRegexp(`[äöü€]`))
+		})
+
+		It("render docker config of repo account", func() {
+			err := vaultRawClient.Sys().Mount("repo", &vault.MountInput{
+				Type:    "kv",
+				Options: map[string]string{"version": "2"},
+			})
+			Expect(err).Should(BeNil())
+
+			_, err = vpClient.CreateSecret(context.Background(), newRepoRequest(&vpApi.RepoAccount{
+				AuthType: vpApi.AuthTypePassword,
+				Account:  &vpApi.Account{Username: "admin", Password: "password"},
+			}))
+			Expect(err).Should(BeNil())
+
+			manifest, err := vpClient.RenderDockerConfig(context.Background(), &vpApi.RenderRepoDockerConfigRequest{
+				Meta:      &vpApi.RepoMeta{ProviderId: "nexus", Product: "product", Project: "project"},
+				Namespace: "default",
+			})
+			Expect(err).Should(BeNil())
+			Expect(manifest).Should(ContainSubstring("type: kubernetes.io/dockerconfigjson"))
+			Expect(manifest).Should(ContainSubstring("name: nexus-product-project"))
+			Expect(manifest).Should(ContainSubstring("namespace: default"))
+
+			config := `{"auths":{"nexus.example.com":{"username":"admin","password":"password","auth":"YWRtaW46cGFzc3dvcmQ="}}}`
+			Expect(manifest).Should(ContainSubstring(base64.StdEncoding.EncodeToString([]byte(config))))
+		})
+
+		It("render docker config of repo account with token only", func() {
+			err := vaultRawClient.Sys().Mount("repo", &vault.MountInput{
+				Type:    "kv",
+				Options: map[string]string{"version": "2"},
+			})
+			Expect(err).Should(BeNil())
+
+			_, err = vpClient.CreateSecret(context.Background(), newRepoRequest(&vpApi.RepoAccount{
+				AuthType: vpApi.AuthTypeToken,
+				Token:    &vpApi.Token{Token: "token"},
+			}))
+			Expect(err).Should(BeNil())
+
+			manifest, err := vpClient.RenderDockerConfig(context.Background(), &vpApi.RenderRepoDockerConfigRequest{
+				Meta: &vpApi.RepoMeta{ProviderId: "nexus", Product: "product", Project: "project"},
+			})
+			Expect(err).Should(BeNil())
+
+			config := `{"auths":{"nexus.example.com":{"password":"token","auth":"OnRva2Vu"}}}`
+			Expect(manifest).Should(ContainSubstring(base64.StdEncoding.EncodeToString([]byte(config))))
+		})
+
+		It("render docker config failed when the repo account is deleted or its path is not a valid name", func() {
+			err := vaultRawClient.Sys().Mount("repo", &vault.MountInput{
+				Type:    "kv",
+				Options: map[string]string{"version": "2"},
+			})
+			Expect(err).Should(BeNil())
+
+			req := newRepoRequest(&vpApi.RepoAccount{
+				AuthType: vpApi.AuthTypeToken,
+				Token:    &vpApi.Token{Token: "token"},
+			})
+			_, err = vpClient.CreateSecret(context.Background(), req)
+			Expect(err).Should(BeNil())
+			_, err = vpClient.DeleteSecret(context.Background(), req)
+			Expect(err).Should(BeNil())
+			_, err = vpClient.RenderDockerConfig(context.Background(), &vpApi.RenderRepoDockerConfigRequest{
+				Meta: &vpApi.RepoMeta{ProviderId: "nexus", Product: "product", Project: "project"},
+			})
+			Expect(vpApi.IsResourceNotFound(err)).Should(BeTrue())
+
+			invalidName := &vpApi.RepoRequest{
+				Meta:    &vpApi.RepoMeta{ProviderId: "nexus", Product: "product-"},
+				Account: &vpApi.RepoAccount{AuthType: vpApi.AuthTypeToken, Token: &vpApi.Token{Token: "token"}},
+			}
+			_, err = vpClient.CreateSecret(context.Background(), invalidName)
+			Expect(err).Should(BeNil())
+			_, err = vpClient.RenderDockerConfig(context.Background(), &vpApi.RenderRepoDockerConfigRequest{Meta: invalidName.Meta})
+			Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+			manifest, err := vpClient.RenderDockerConfig(context.Background(), &vpApi.RenderRepoDockerConfigRequest{
+				Meta: invalidName.Meta,
+				Name: "product",
+			})
+			Expect(err).Should(BeNil())
+			Expect(manifest).Should(ContainSubstring("name: product"))
+		})
+
+		It("create cluster with the current context only and records server and expiry", func() {
+			err := vaultRawClient.Sys().Mount("cluster", &vault.MountInput{
+				Type:    "kv",
+				Options: map[string]string{"version": "2"},
+			})
+			Expect(err).Should(BeNil())
+
+			ca := newMockCert("nautes-ca", nil, true, nil, time.Now().Add(time.Hour))
+			client := newMockCert("admin", nil, false, ca, time.Now().Add(time.Hour))
+			req := newClusterRequest(newMockKubeconfig(ca, client))
+			req.Account.StripContexts = true
+			sec, err := vpClient.CreateCluster(context.Background(), req)
+			Expect(err).Should(BeNil())
+
+			kv, err := vaultRawClient.KVv2("cluster").Get(context.Background(), sec.SecretPath)
+			Expect(err).Should(BeNil())
+			Expect(kv.Data["kubeconfig"]).Should(ContainSubstring("https://127.0.0.1:6443"))
+			Expect(kv.Data["kubeconfig"]).ShouldNot(ContainSubstring("member"))
+
+			metadata, err := vaultRawClient.KVv2("cluster").GetMetadata(context.Background(), sec.SecretPath)
+			Expect(err).Should(BeNil())
+			Expect(metadata.CustomMetadata["server"]).Should(Equal("https://127.0.0.1:6443"))
+			expiry := client.cert.NotAfter
+			if ca.cert.NotAfter.Before(expiry) {
+				expiry = ca.cert.NotAfter
+			}
+			Expect(metadata.CustomMetadata["expiry"]).Should(Equal(expiry.UTC().Format(time.RFC3339)))
+		})
+
+		Context("secret has already exist", func() {
+			BeforeEach(func() {
+				_, err := vpClient.CreateSecret(context.Background(), secret)
+				Expect(err).Should(BeNil())
+			})
+
+			Context("when create policy failed", func() {
+				It("will update secret failed and rollback to the pre version", func() {
+					secret.PolicyName = ""
+
+					_, err := vpClient.CreateSecret(context.Background(), secret)
+					Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+
+					rst, _ := vaultRawClient.KVv2(secret.SecretName).Get(context.Background(), secret.SecretPath)
+					Expect(len(rst.Data)).Should(BeNumerically("==", 1))
+				})
+			})
+
+			It("will update secret when expected version is current version", func() {
+				version := 1
+				secret.Version = &version
+
+				sec, err := vpClient.CreateSecret(context.Background(), secret)
+				Expect(err).Should(BeNil())
+				Expect(sec.SecretVersion).Should(Equal(2))
+			})
+
+			It("will return conflict when expected version is not current version", func() {
+				version := 0
+				secret.Version = &version
+
+				_, err := vpClient.CreateSecret(context.Background(), secret)
+				Expect(vpApi.IsVersionConflict(err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Describe("Delete Secret", func() {
+		BeforeEach(func() {
+			_, err := vpClient.CreateSecret(context.Background(), secret)
+			Expect(err).Should(BeNil())
+		})
+
+		It("delete secret seccessed", func() {
+			_, err := vpClient.DeleteSecret(context.Background(), secret)
+			Expect(err).Should(BeNil())
+		})
+
+		It("delete product with all projects when it is recursive", func() {
+			err := vaultRawClient.Sys().Mount("repo", &vault.MountInput{
+				Type:    "kv",
+				Options: map[string]string{"version": "2"},
+			})
+			Expect(err).Should(BeNil())
+			for _, project := range []string{"", "project-a", "project-b"} {
+				req := &vpApi.RepoRequest{
+					Meta:    &vpApi.RepoMeta{ProviderId: "nexus", Product: "product", Project: project},
+					Account: &vpApi.RepoAccount{AuthType: vpApi.AuthTypeToken, Token: &vpApi.Token{Token: "token"}},
+				}
+				_, err := vpClient.CreateSecret(context.Background(), req)
+				Expect(err).Should(BeNil())
+			}
+
+			req := &vpApi.RepoRequest{Meta: &vpApi.RepoMeta{ProviderId: "nexus", Product: "product"}, Recursive: true}
+			deletedPaths, _, err := vpClient.DeleteSecretRecursive(context.Background(), req, nil)
+			Expect(err).Should(BeNil())
+			Expect(deletedPaths).Should(Equal([]string{"nexus/product/project-a", "nexus/product/project-b", "nexus/product"}))
+
+			projectSecret, err := vpApi.NewSecretRequestFromPath("repo", "nexus/product/project-a")
+			Expect(err).Should(BeNil())
+			policy, err := vaultRawClient.Sys().GetPolicy(projectSecret.PolicyName)
+			Expect(err).Should(BeNil())
+			Expect(policy).ShouldNot(BeEmpty())
+			metadata, err := vaultRawClient.KVv2("repo").GetMetadata(context.Background(), "nexus/product/project-a")
+			Expect(err).Should(BeNil())
+			Expect(metadata.Versions["1"].DeletionTime.IsZero()).Should(BeFalse())
+		})
+
+		It("nothing is deleted if a project is not allowed to delete", func() {
+			err := vaultRawClient.Sys().Mount("repo", &vault.MountInput{
+				Type:    "kv",
+				Options: map[string]string{"version": "2"},
+			})
+			Expect(err).Should(BeNil())
+			for _, project := range []string{"", "project-a"} {
+				req := &vpApi.RepoRequest{
+					Meta:    &vpApi.RepoMeta{ProviderId: "nexus", Product: "product", Project: project},
+					Account: &vpApi.RepoAccount{AuthType: vpApi.AuthTypeToken, Token: &vpApi.Token{Token: "token"}},
+				}
+				_, err := vpClient.CreateSecret(context.Background(), req)
+				Expect(err).Should(BeNil())
+			}
+
+			req := &vpApi.RepoRequest{Meta: &vpApi.RepoMeta{ProviderId: "nexus", Product: "product"}, Recursive: true}
+			_, _, err = vpClient.DeleteSecretRecursive(context.Background(), req, func(resource string) bool {
+				return !strings.HasSuffix(resource, "project-a")
+			})
+			Expect(vpApi.IsActionNotAllow(err)).Should(BeTrue())
+
+			metadata, err := vaultRawClient.KVv2("repo").GetMetadata(context.Background(), "nexus/product")
+			Expect(err).Should(BeNil())
+			Expect(metadata.Versions["1"].DeletionTime.IsZero()).Should(BeTrue())
+		})
+
+		It("soft deleted secret can be undeleted", func() {
+			meta := &vpApi.GitMeta{ProviderType: "gitlab", Id: "123", Username: "default", Permission: "readonly"}
+			_, err := vpClient.CreateSecret(context.Background(), &vpApi.GitRequest{Meta: meta, Kvs: &vpApi.GitKVs{DeployKey: "key"}})
+			Expect(err).Should(BeNil())
+
+			_, err = vpClient.DeleteSecret(context.Background(), &vpApi.GitRequest{Meta: meta})
+			Expect(err).Should(BeNil())
+			kv, err := vaultRawClient.KVv2("git").Get(context.Background(), "gitlab/123/default/readonly")
+			Expect(err == nil && kv.Data != nil).Should(BeFalse())
+
+			sec, _, err := vpClient.UndeleteSecret(context.Background(), &vpApi.UndeleteGitRequest{Meta: meta})
+			Expect(err).Should(BeNil())
+			Expect(sec.SecretVersion).Should(Equal(1))
+			kv, err = vaultRawClient.KVv2("git").Get(context.Background(), "gitlab/123/default/readonly")
+			Expect(err).Should(BeNil())
+			Expect(kv.Data["deploy_key"]).Should(Equal("key"))
+
+			_, _, err = vpClient.UndeleteSecret(context.Background(), &vpApi.UndeleteGitRequest{Meta: meta})
+			Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+		})
+
+		It("purge secret destroys all versions and the policy", func() {
+			_, err := vpClient.PurgeSecret(context.Background(), secret)
+			Expect(err).Should(BeNil())
+
+			_, err = vaultRawClient.KVv2("git").GetMetadata(context.Background(), secret.SecretPath)
+			Expect(errors.Is(err, vault.ErrSecretNotFound)).Should(BeTrue())
+			policy, err := vaultRawClient.Sys().GetPolicy(secret.PolicyName)
+			Expect(err).Should(BeNil())
+			Expect(policy).Should(BeEmpty())
+
+			_, _, err = vpClient.UndeleteSecret(context.Background(), secret)
+			Expect(vpApi.IsResourceNotFound(err)).Should(BeTrue())
+		})
+
+		Context("delete policy failed", func() {
+			It("will return internal error", func() {
+				// secret.PolicyName = "newPolicyName"
+				// wantErr := vpApi.ErrorInternalServiceError("delete policy of %s in %s failed", secret.SecretPath, secret.SecretName)
+
+				// _, err := vpClient.DeleteSecret(context.Background(), secret)
+				// Expect(err).To(Equal(wantErr))
+			})
+
+			It("when req is not legal, create secret failed", func() {
+				req := &mockSecret{
+					err: errors.New("err"),
+				}
+				_, err := vpClient.DeleteSecret(context.Background(), req)
+				Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+
+				req = &mockSecret{
+					FullPath: "%(*&%",
+				}
+
+				_, err = vpClient.DeleteSecret(context.Background(), req)
+				Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Describe("Get Secret", func() {
+		It("return metadata of the secret without secret data", func() {
+			_, err := vpClient.CreateSecret(context.Background(), secret)
+			Expect(err).Should(BeNil())
+			_, err = vpClient.CreateSecret(context.Background(), secret)
+			Expect(err).Should(BeNil())
+
+			sec, err := vpClient.GetSecret(context.Background(), secret)
+			Expect(err).Should(BeNil())
+			Expect(sec.SecretName).Should(Equal(secret.SecretName))
+			Expect(sec.SecretPath).Should(Equal(secret.SecretPath))
+			Expect(sec.SecretVersion).Should(Equal(2))
+			Expect(sec.PolicyName).Should(Equal(secret.PolicyName))
+			Expect(sec.UpdatedTime.After(sec.CreatedTime)).Should(BeTrue())
+		})
+
+		It("return provenance of the secret", func() {
+			ctx := requestid.NewContext(auth.NewAuthContext(context.Background(), "RUNTIME"), "request-1")
+			_, err := vpClient.CreateSecret(ctx, secret)
+			Expect(err).Should(BeNil())
+			ctx = requestid.NewContext(auth.NewAuthContext(context.Background(), "API"), "request-2")
+			_, err = vpClient.CreateSecret(ctx, secret)
+			Expect(err).Should(BeNil())
+
+			sec, err := vpClient.GetSecret(context.Background(), secret)
+			Expect(err).Should(BeNil())
+			Expect(sec.Provenance).Should(Equal(vaultproxy.Provenance{
+				CreatedBy:  "RUNTIME",
+				UpdatedBy:  "API",
+				RequestID:  "request-2",
+				Tenant:     "nautes",
+				SourceType: secret.SecretType,
+			}))
+		})
+
+		It("roll back the secret and return the error when the provenance can not be recorded", func() {
+			// Vault rejects the custom metadata value longer than 512 bytes
+			ctx := requestid.NewContext(context.Background(), strings.Repeat("a", 513))
+			_, err := vpClient.CreateSecret(ctx, secret)
+			Expect(vpApi.IsInternalServiceError(err)).Should(BeTrue())
+			_, err = vpClient.GetSecret(context.Background(), secret)
+			Expect(vpApi.IsResourceNotFound(err)).Should(BeTrue())
+		})
+
+		It("return not found when secret is not exist", func() {
+			_, err := vpClient.GetSecret(context.Background(), secret)
+			Expect(vpApi.IsResourceNotFound(err)).Should(BeTrue())
+		})
+
+		It("create, get and delete secret of a built-in type by the generic apis", func() {
+			meta := map[string]string{"provider_type": "gitlab", "id": "123", "username": "default", "permission": "readonly"}
+			created, err := vpClient.CreateSecret(context.Background(), &vpApi.CreateSecretRequest{
+				Type: "git",
+				Meta: meta,
+				Data: map[string]string{"deploykey": "key"},
+			})
+			Expect(err).Should(BeNil())
+			Expect(created.SecretPath).Should(Equal("gitlab/123/default/readonly"))
+
+			sec, err := vpClient.GetSecret(context.Background(), &vpApi.GetGitRequest{
+				Meta: &vpApi.GitMeta{ProviderType: "gitlab", Id: "123", Username: "default", Permission: "readonly"},
+			})
+			Expect(err).Should(BeNil())
+			Expect(sec.PolicyName).Should(MatchRegexp(`^gitlab-123-default-readonly-[0-9a-f]{12}$`))
+
+			_, err = vpClient.DeleteSecret(context.Background(), &vpApi.DeleteSecretRequest{Type: "git", Meta: meta})
+			Expect(err).Should(BeNil())
+		})
+
+		It("undelete and purge secret by the generic apis", func() {
+			meta := map[string]string{"provider_type": "gitlab", "id": "123", "username": "default", "permission": "readonly"}
+			_, err := vpClient.CreateSecret(context.Background(), &vpApi.CreateSecretRequest{
+				Type: "git",
+				Meta: meta,
+				Data: map[string]string{"deploykey": "key"},
+			})
+			Expect(err).Should(BeNil())
+			_, err = vpClient.DeleteSecret(context.Background(), &vpApi.DeleteSecretRequest{Type: "git", Meta: meta})
+			Expect(err).Should(BeNil())
+
+			sec, _, err := vpClient.UndeleteSecret(context.Background(), &vpApi.UndeleteSecretRequest{Type: "git", Meta: meta})
+			Expect(err).Should(BeNil())
+			Expect(sec.SecretVersion).Should(Equal(1))
+
+			_, err = vpClient.PurgeSecret(context.Background(), &vpApi.PurgeSecretRequest{Type: "git", Meta: meta})
+			Expect(err).Should(BeNil())
+			_, _, err = vpClient.UndeleteSecret(context.Background(), &vpApi.UndeleteSecretRequest{Type: "git", Meta: meta})
+			Expect(vpApi.IsResourceNotFound(err)).Should(BeTrue())
+		})
+	})
+
+	Describe("Secret Version", func() {
+		var req *vpApi.RollbackGitRequest
+		BeforeEach(func() {
+			req = &vpApi.RollbackGitRequest{
+				Meta: &vpApi.GitMeta{
+					ProviderType: "gitlab",
+					Id:           "123",
+					Username:     "default",
+					Permission:   "readonly",
+				},
+				Version: 1,
+			}
+			secret.SecretPath = "gitlab/123/default/readonly"
+			secret.SecretData = map[string]interface{}{"account": "456"}
+			_, err := vpClient.CreateSecret(context.Background(), secret)
+			Expect(err).Should(BeNil())
+			secret.SecretData = map[string]interface{}{"account": "123"}
+			_, err = vpClient.CreateSecret(context.Background(), secret)
+			Expect(err).Should(BeNil())
+		})
+
+		It("list versions of the secret", func() {
+			versions, err := vpClient.ListSecretVersions(context.Background(), req)
+			Expect(err).Should(BeNil())
+			Expect(len(versions)).Should(Equal(2))
+			Expect(versions[0].Version).Should(Equal(1))
+			Expect(versions[1].Version).Should(Equal(2))
+		})
+
+		It("roll back to a version will create a new version with its data", func() {
+			sec, err := vpClient.RollbackSecret(context.Background(), req)
+			Expect(err).Should(BeNil())
+			Expect(sec.SecretVersion).Should(Equal(3))
+
+			kv, err := vaultRawClient.KVv2("git").Get(context.Background(), "gitlab/123/default/readonly")
+			Expect(err).Should(BeNil())
+			Expect(kv.Data["account"]).Should(Equal("456"))
+		})
+
+		It("return not found when version is not exist", func() {
+			req.Version = 5
+			_, err := vpClient.RollbackSecret(context.Background(), req)
+			Expect(vpApi.IsResourceNotFound(err)).Should(BeTrue())
+		})
+
+		It("reject the rollback to a version which does not pass the checks of its type", func() {
+			// The version is written before the schema of the type is checked
+			_, err := vaultRawClient.KVv2("git").Put(context.Background(), "gitlab/123/default/readonly", map[string]interface{}{
+				"deploykey": strings.Repeat("a", 17<<10),
+			})
+			Expect(err).Should(BeNil())
+			secret.SecretData = map[string]interface{}{"account": "789"}
+			_, err = vpClient.CreateSecret(context.Background(), secret)
+			Expect(err).Should(BeNil())
+
+			req.Version = 3
+			_, err = vpClient.RollbackSecret(context.Background(), req)
+			Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+			versions, err := vpClient.ListSecretVersions(context.Background(), req)
+			Expect(err).Should(BeNil())
+			Expect(versions).Should(HaveLen(4))
+		})
+	})
+
+	Describe("List Secret", func() {
+		BeforeEach(func() {
+			for _, secretPath := range []string{"gitlab/1/default/readonly", "gitlab/2/default/readonly", "gitlab/10/default/readonly", "github/1/default/readonly"} {
+				sec := *secret
+				sec.SecretPath = secretPath
+				_, err := vpClient.CreateSecret(context.Background(), &sec)
+				Expect(err).Should(BeNil())
+			}
+		})
+
+		It("list secrets under the prefix by pages", func() {
+			req := &vpApi.ListGitRequest{ProviderType: "gitlab", PageSize: 2}
+			page, err := vpClient.ListSecret(context.Background(), req, nil)
+			Expect(err).Should(BeNil())
+			Expect(len(page.Secrets)).Should(Equal(2))
+			Expect(page.Secrets[0].SecretPath).Should(Equal("gitlab/1/default/readonly"))
+			Expect(page.Secrets[1].SecretPath).Should(Equal("gitlab/10/default/readonly"))
+			Expect(page.NextPageToken).ShouldNot(BeEmpty())
+
+			req.PageToken = page.NextPageToken
+			page, err = vpClient.ListSecret(context.Background(), req, nil)
+			Expect(err).Should(BeNil())
+			Expect(len(page.Secrets)).Should(Equal(1))
+			Expect(page.Secrets[0].SecretPath).Should(Equal("gitlab/2/default/readonly"))
+			Expect(page.NextPageToken).Should(BeEmpty())
+		})
+
+		It("skip the secrets which are filtered", func() {
+			req := &vpApi.ListGitRequest{ProviderType: "gitlab", Id: "1"}
+			filter := func(fullPath string) bool {
+				return fullPath != "git/data/gitlab/1/default/readonly"
+			}
+			page, err := vpClient.ListSecret(context.Background(), req, filter)
+			Expect(err).Should(BeNil())
+			Expect(len(page.Secrets)).Should(Equal(0))
+		})
+
+		It("return input error when page token is not belong to the prefix", func() {
+			page, err := vpClient.ListSecret(context.Background(), &vpApi.ListGitRequest{ProviderType: "github"}, nil)
+			Expect(err).Should(BeNil())
+			Expect(len(page.Secrets)).Should(Equal(1))
+
+			req := &vpApi.ListGitRequest{ProviderType: "gitlab", Id: "2", PageToken: "Z2l0aHViLzE"}
+			_, err = vpClient.ListSecret(context.Background(), req, nil)
+			Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+		})
+	})
+
+	Describe("Owner Only", func() {
+		var ownerClient *vaultproxy.VaultUsercase
+		var creatorCtx, otherCtx context.Context
+		var req *vpApi.CreateSecretRequest
+		BeforeEach(func() {
+			dataCfg := &conf.Data{
+				Vault: &conf.Data_Vault{
+					Addr:  "http://127.0.0.1:8200",
+					Token: "test",
+				},
+			}
+			ownerClient = vaultproxy.NewVaultUsercase(vpData.NewVaultClient(dataCfg, log.DefaultLogger), &conf.Server{
+				Authorization: &conf.Server_Authorization{
+					Resource: &conf.Server_Authorization_Casbin{Acl: casbinPermissionFile},
+				},
+				OwnerOnly:   true,
+				OwnerAdmins: []string{"ADMIN"},
+			}, dataCfg, log.DefaultLogger)
+
+			creatorCtx = auth.NewAuthContext(context.Background(), "API")
+			otherCtx = auth.NewAuthContext(context.Background(), "RUNTIME")
+			req = &vpApi.CreateSecretRequest{
+				Type: "git",
+				Meta: map[string]string{"provider_type": "gitlab", "id": "123", "username": "default", "permission": "readonly"},
+				Data: map[string]string{"deploykey": "key"},
+			}
+			_, err := ownerClient.CreateSecret(creatorCtx, req)
+			Expect(err).Should(BeNil())
+		})
+
+		It("only the creator can update and delete the secret", func() {
+			_, err := ownerClient.CreateSecret(otherCtx, req)
+			Expect(vpApi.IsActionNotAllow(err)).Should(BeTrue())
+			deleteReq := &vpApi.DeleteSecretRequest{Type: req.Type, Meta: req.Meta}
+			_, err = ownerClient.DeleteSecret(otherCtx, deleteReq)
+			Expect(vpApi.IsActionNotAllow(err)).Should(BeTrue())
+
+			_, err = ownerClient.CreateSecret(creatorCtx, req)
+			Expect(err).Should(BeNil())
+			_, err = ownerClient.DeleteSecret(creatorCtx, deleteReq)
+			Expect(err).Should(BeNil())
+		})
+
+		It("co-owners can update the secret", func() {
+			ownersReq := &vpApi.UpdateSecretOwnersRequest{Type: req.Type, Meta: req.Meta, CoOwners: []string{"RUNTIME"}}
+			_, err := ownerClient.UpdateSecretOwners(otherCtx, ownersReq)
+			Expect(vpApi.IsActionNotAllow(err)).Should(BeTrue())
+
+			sec, err := ownerClient.UpdateSecretOwners(creatorCtx, ownersReq)
+			Expect(err).Should(BeNil())
+			Expect(sec.Provenance.CoOwners).Should(Equal([]string{"RUNTIME"}))
+
+			_, err = ownerClient.CreateSecret(otherCtx, req)
+			Expect(err).Should(BeNil())
+			sec, err = ownerClient.GetSecret(context.Background(), req)
+			Expect(err).Should(BeNil())
+			Expect(sec.Provenance.CreatedBy).Should(Equal("API"))
+			Expect(sec.Provenance.UpdatedBy).Should(Equal("RUNTIME"))
+		})
+
+		It("reject the co-owners longer than a custom metadata value", func() {
+			// Vault rejects the custom metadata value longer than 512 bytes
+			var coOwners []string
+			for i := 0; i < 20; i++ {
+				coOwners = append(coOwners, fmt.Sprintf("co-owner-with-a-long-common-name-%02d", i))
+			}
+			ownersReq := &vpApi.UpdateSecretOwnersRequest{Type: req.Type, Meta: req.Meta, CoOwners: coOwners}
+			_, err := ownerClient.UpdateSecretOwners(creatorCtx, ownersReq)
+			Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+
+			sec, err := ownerClient.GetSecret(context.Background(), req)
+			Expect(err).Should(BeNil())
+			Expect(sec.Provenance.CoOwners).Should(BeEmpty())
+		})
+
+		It("only the owner admins can update the secret with more versions but without the creator", func() {
+			_, err := ownerClient.CreateSecret(creatorCtx, req)
+			Expect(err).Should(BeNil())
+			err = vaultRawClient.KVv2("git").PatchMetadata(context.Background(), "gitlab/123/default/readonly", vault.KVMetadataPatchInput{
+				CustomMetadata: map[string]interface{}{"created_by": nil},
+			})
+			Expect(err).Should(BeNil())
+
+			_, err = ownerClient.CreateSecret(otherCtx, req)
+			Expect(vpApi.IsActionNotAllow(err)).Should(BeTrue())
+			_, err = ownerClient.CreateSecret(auth.NewAuthContext(context.Background(), "ADMIN"), req)
+			Expect(err).Should(BeNil())
+		})
+
+		It("only the owner admins can update the legacy secret with one version and without the creator", func() {
+			req.Meta["id"] = "456"
+			_, err := vaultRawClient.KVv2("git").Put(context.Background(), "gitlab/456/default/readonly", map[string]interface{}{"deploykey": "key"})
+			Expect(err).Should(BeNil())
+
+			_, err = ownerClient.CreateSecret(otherCtx, req)
+			Expect(vpApi.IsActionNotAllow(err)).Should(BeTrue())
+			deleteReq := &vpApi.DeleteSecretRequest{Type: req.Type, Meta: req.Meta}
+			_, err = ownerClient.DeleteSecret(otherCtx, deleteReq)
+			Expect(vpApi.IsActionNotAllow(err)).Should(BeTrue())
+
+			_, err = ownerClient.CreateSecret(auth.NewAuthContext(context.Background(), "ADMIN"), req)
+			Expect(err).Should(BeNil())
+		})
+
+		It("roll back the secret when the creator can not be recorded", func() {
+			req.Meta["id"] = "456"
+			// Vault rejects the custom metadata value longer than 512 bytes
+			ctx := requestid.NewContext(creatorCtx, strings.Repeat("a", 513))
+			sec, err := req.ConvertRequest()
+			Expect(err).Should(BeNil())
+
+			_, err = ownerClient.CreateSecret(ctx, req)
+			Expect(vpApi.IsInternalServiceError(err)).Should(BeTrue())
+			_, err = ownerClient.GetSecret(context.Background(), req)
+			Expect(vpApi.IsResourceNotFound(err)).Should(BeTrue())
+			policy, err := vaultRawClient.Sys().GetPolicy(sec.PolicyName)
+			Expect(err).Should(BeNil())
+			Expect(policy).Should(BeEmpty())
+		})
+	})
+
+	Describe("Expiration", func() {
+		It("list the secrets which expire before the deadline", func() {
+			expiring := &vpApi.CreateSecretRequest{
+				Type: "git",
+				Meta: map[string]string{"provider_type": "gitlab", "id": "123", "username": "default", "permission": "readonly"},
+				Data: map[string]string{"deploykey": "key"},
+				Ttl:  "1h",
+			}
+			_, err := vpClient.CreateSecret(context.Background(), expiring)
+			Expect(err).Should(BeNil())
+			_, err = vpClient.CreateSecret(context.Background(), secret)
+			Expect(err).Should(BeNil())
+
+			expirations, err := vpClient.ListExpirations(context.Background(), time.Now().Add(2*time.Hour))
+			Expect(err).Should(BeNil())
+			Expect(expirations).Should(HaveLen(1))
+			Expect(expirations[0].SecretPath).Should(Equal("gitlab/123/default/readonly"))
+
+			expirations, err = vpClient.ListExpirations(context.Background(), time.Now().Add(time.Minute))
+			Expect(err).Should(BeNil())
+			Expect(expirations).Should(BeEmpty())
+		})
+
+		It("remove the expire time when a new version is written without ttl", func() {
+			expiring := &vpApi.CreateSecretRequest{
+				Type: "git",
+				Meta: map[string]string{"provider_type": "gitlab", "id": "123", "username": "default", "permission": "readonly"},
+				Data: map[string]string{"deploykey": "key"},
+				Ttl:  "1h",
+			}
+			_, err := vpClient.CreateSecret(context.Background(), expiring)
+			Expect(err).Should(BeNil())
+			expiring.Ttl = ""
+			_, err = vpClient.CreateSecret(context.Background(), expiring)
+			Expect(err).Should(BeNil())
+
+			expirations, err := vpClient.ListExpirations(context.Background(), time.Now().Add(2*time.Hour))
+			Expect(err).Should(BeNil())
+			Expect(expirations).Should(BeEmpty())
+		})
+
+		It("purge the expired secrets and delete their policies", func() {
+			_, err := vpClient.CreateSecret(context.Background(), secret)
+			Expect(err).Should(BeNil())
+			err = vaultRawClient.KVv2(secret.SecretName).PatchMetadata(context.Background(), secret.SecretPath, vault.KVMetadataPatchInput{
+				CustomMetadata: map[string]interface{}{"expire_time": time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)},
+			})
+			Expect(err).Should(BeNil())
+
+			purged, err := vpClient.ReapExpiredSecrets(context.Background())
+			Expect(err).Should(BeNil())
+			Expect(purged).Should(HaveLen(1))
+
+			_, err = vpClient.GetSecret(context.Background(), secret)
+			Expect(vpApi.IsResourceNotFound(err)).Should(BeTrue())
+			policy, err := vaultRawClient.Sys().GetPolicy(secret.PolicyName)
+			Expect(err).Should(BeNil())
+			Expect(policy).Should(BeEmpty())
+		})
+	})
+
+	Describe("Journal", func() {
+		var journalClient *vaultproxy.VaultUsercase
+		BeforeEach(func() {
+			dataCfg := &conf.Data{
+				Vault: &conf.Data_Vault{
+					Addr:  "http://127.0.0.1:8200",
+					Token: "test",
+				},
+				Journal: &conf.Data_Journal{
+					SecretName: "git",
+					Path:       "vault-proxy/journal",
+				},
+			}
+			journalClient = vaultproxy.NewVaultUsercase(vpData.NewVaultClient(dataCfg, log.DefaultLogger), &conf.Server{
+				Authorization: &conf.Server_Authorization{
+					Resource: &conf.Server_Authorization_Casbin{Acl: casbinPermissionFile},
+				},
+			}, dataCfg, log.DefaultLogger)
+		})
+
+		It("journal is removed after secret is created", func() {
+			_, err := journalClient.CreateSecret(context.Background(), secret)
+			Expect(err).Should(BeNil())
+
+			keys, err := vaultRawClient.Logical().List("git/metadata/vault-proxy/journal")
+			Expect(err).Should(BeNil())
+			Expect(keys).Should(BeNil())
+		})
+
+		It("complete the secret without policy when recover", func() {
+			kv, err := vaultRawClient.KVv2("git").Put(context.Background(), secret.SecretPath, secret.SecretData)
+			Expect(err).Should(BeNil())
+			_, err = vaultRawClient.KVv2("git").Put(context.Background(), "vault-proxy/journal/git-gitlab-123-1", map[string]interface{}{
+				"step":        "policy",
+				"secret_name": secret.SecretName,
+				"secret_path": secret.SecretPath,
+				"policy_name": secret.PolicyName,
+				"policy_data": secret.PolicyData,
+				"pre_version": 0,
+				"version":     kv.VersionMetadata.Version,
+			})
+			Expect(err).Should(BeNil())
+
+			err = journalClient.RecoverJournal(context.Background())
+			Expect(err).Should(BeNil())
+
+			policy, err := vaultRawClient.Sys().GetPolicy(secret.PolicyName)
+			Expect(err).Should(BeNil())
+			Expect(policy).Should(Equal(secret.PolicyData))
+			keys, err := vaultRawClient.Logical().List("git/metadata/vault-proxy/journal")
+			Expect(err).Should(BeNil())
+			Expect(keys).Should(BeNil())
+		})
+
+		It("undo the secret when it is rolling back", func() {
+			kv, err := vaultRawClient.KVv2("git").Put(context.Background(), secret.SecretPath, secret.SecretData)
+			Expect(err).Should(BeNil())
+			_, err = vaultRawClient.KVv2("git").Put(context.Background(), "vault-proxy/journal/git-gitlab-123-1", map[string]interface{}{
+				"step":        "rollback",
+				"secret_name": secret.SecretName,
+				"secret_path": secret.SecretPath,
+				"policy_name": secret.PolicyName,
+				"policy_data": secret.PolicyData,
+				"pre_version": 0,
+				"version":     kv.VersionMetadata.Version,
+			})
+			Expect(err).Should(BeNil())
+
+			err = journalClient.RecoverJournal(context.Background())
+			Expect(err).Should(BeNil())
+
+			_, err = vaultRawClient.KVv2("git").GetMetadata(context.Background(), secret.SecretPath)
+			Expect(errors.Is(err, vault.ErrSecretNotFound)).Should(BeTrue())
+		})
+
+		It("leave the entry of another replica until its lease expires", func() {
+			_, err := vaultRawClient.KVv2("git").Put(context.Background(), secret.SecretPath, secret.SecretData)
+			Expect(err).Should(BeNil())
+			_, err = vaultRawClient.KVv2("git").Put(context.Background(), "vault-proxy/journal/git-gitlab-123-1", map[string]interface{}{
+				"step":              "rollback",
+				"secret_name":       secret.SecretName,
+				"secret_path":       secret.SecretPath,
+				"policy_name":       secret.PolicyName,
+				"policy_data":       secret.PolicyData,
+				"pre_version":       0,
+				"version":           1,
+				"owner":             "another-replica",
+				"lease_expire_time": time.Now().Add(time.Hour).Format(time.RFC3339),
+			})
+			Expect(err).Should(BeNil())
+
+			err = journalClient.RecoverJournal(context.Background())
+			Expect(err).Should(BeNil())
+
+			_, err = vaultRawClient.KVv2("git").Get(context.Background(), secret.SecretPath)
+			Expect(err).Should(BeNil())
+			keys, err := vaultRawClient.Logical().List("git/metadata/vault-proxy/journal")
+			Expect(err).Should(BeNil())
+			Expect(keys).ShouldNot(BeNil())
+		})
+
+		It("recover the expired entries in background when the reconcile is not configured", func() {
+			dataCfg := &conf.Data{
+				Vault: &conf.Data_Vault{
+					Addr:  "http://127.0.0.1:8200",
+					Token: "test",
+				},
+				Journal: &conf.Data_Journal{
+					SecretName: "git",
+					Path:       "vault-proxy/journal",
+					Lease:      durationpb.New(100 * time.Millisecond),
+				},
+			}
+			serverCfg := &conf.Server{
+				Authorization: &conf.Server_Authorization{
+					Resource: &conf.Server_Authorization_Casbin{Acl: casbinPermissionFile},
+				},
+			}
+			reconciler := vaultproxy.NewReconciler(vaultproxy.NewVaultUsercase(vpData.NewVaultClient(dataCfg, log.DefaultLogger), serverCfg, dataCfg, log.DefaultLogger), serverCfg)
+			done := make(chan error)
+			go func() { done <- reconciler.Start(context.Background()) }()
+
+			kv, err := vaultRawClient.KVv2("git").Put(context.Background(), secret.SecretPath, secret.SecretData)
+			Expect(err).Should(BeNil())
+			_, err = vaultRawClient.KVv2("git").Put(context.Background(), "vault-proxy/journal/git-gitlab-123-1", map[string]interface{}{
+				"step":              "policy",
+				"secret_name":       secret.SecretName,
+				"secret_path":       secret.SecretPath,
+				"policy_name":       secret.PolicyName,
+				"policy_data":       secret.PolicyData,
+				"pre_version":       0,
+				"version":           kv.VersionMetadata.Version,
+				"owner":             "another-replica",
+				"lease_expire_time": time.Now().Format(time.RFC3339),
+			})
+			Expect(err).Should(BeNil())
+
+			Eventually(func() string {
+				policy, _ := vaultRawClient.Sys().GetPolicy(secret.PolicyName)
+				return policy
+			}, 5*time.Second).Should(Equal(secret.PolicyData))
+			Expect(reconciler.Stop(context.Background())).Should(BeNil())
+			Expect(<-done).Should(BeNil())
+		})
+
+		It("keep the entries which can not be recovered and recover the others", func() {
+			_, err := vaultRawClient.KVv2("git").Put(context.Background(), "vault-proxy/journal/git-gitlab-123-1", map[string]interface{}{
+				"step":        "unknown",
+				"secret_name": secret.SecretName,
+				"secret_path": secret.SecretPath,
+			})
+			Expect(err).Should(BeNil())
+			_, err = vaultRawClient.KVv2("git").Put(context.Background(), "vault-proxy/journal/git-gitlab-123-2", map[string]interface{}{
+				"step":        "policy",
+				"pre_version": "broken",
+			})
+			Expect(err).Should(BeNil())
+			kv, err := vaultRawClient.KVv2("git").Put(context.Background(), secret.SecretPath, secret.SecretData)
+			Expect(err).Should(BeNil())
+			_, err = vaultRawClient.KVv2("git").Put(context.Background(), "vault-proxy/journal/git-gitlab-123-3", map[string]interface{}{
+				"step":        "policy",
+				"secret_name": secret.SecretName,
+				"secret_path": secret.SecretPath,
+				"policy_name": secret.PolicyName,
+				"policy_data": secret.PolicyData,
+				"pre_version": 0,
+				"version":     kv.VersionMetadata.Version,
+			})
+			Expect(err).Should(BeNil())
+
+			err = journalClient.RecoverExpiredJournal(context.Background())
+			Expect(err).ShouldNot(BeNil())
+			// The recovery on start only logs the errors, it does not stop vault proxy from starting
+			err = journalClient.RecoverJournal(context.Background())
+			Expect(err).Should(BeNil())
+
+			policy, err := vaultRawClient.Sys().GetPolicy(secret.PolicyName)
+			Expect(err).Should(BeNil())
+			Expect(policy).Should(Equal(secret.PolicyData))
+			keys, err := vaultRawClient.Logical().List("git/metadata/vault-proxy/journal")
+			Expect(err).Should(BeNil())
+			Expect(keys.Data["keys"]).Should(ConsistOf("git-gitlab-123-1", "git-gitlab-123-2"))
+		})
+	})
+
+	Describe("Reconcile", func() {
+		BeforeEach(func() {
+			req := &vpApi.GitRequest{
+				Meta: &vpApi.GitMeta{
+					ProviderType: "gitlab",
+					Id:           "123",
+					Username:     "default",
+					Permission:   "readonly",
+				},
+				Kvs: &vpApi.GitKVs{DeployKey: "key"},
+			}
+			_, err := vpClient.CreateSecret(context.Background(), req)
+			Expect(err).Should(BeNil())
+			secret.SecretPath = "gitlab/123/default/readonly"
+			rebuilt, err := vpApi.NewSecretRequestFromPath(secret.SecretName, secret.SecretPath)
+			Expect(err).Should(BeNil())
+			secret.PolicyName = rebuilt.PolicyName
+		})
+
+		It("report nothing when secret and policy are matched", func() {
+			report, err := vpClient.Reconcile(context.Background(), false)
+			Expect(err).Should(BeNil())
+			Expect(len(report.Drifts)).Should(Equal(0))
+		})
+
+		It("find and repair the secret without policy", func() {
+			err := vaultRawClient.Sys().DeletePolicy(secret.PolicyName)
+			Expect(err).Should(BeNil())
+
+			report, err := vpClient.Reconcile(context.Background(), true)
+			Expect(err).Should(BeNil())
+			Expect(len(report.Drifts)).Should(Equal(1))
+			Expect(report.Drifts[0].Kind).Should(Equal(vaultproxy.DriftSecretWithoutPolicy))
+			Expect(report.Drifts[0].Repaired).Should(BeTrue())
+
+			policy, err := vaultRawClient.Sys().GetPolicy(secret.PolicyName)
+			Expect(err).Should(BeNil())
+			Expect(policy).ShouldNot(BeEmpty())
+		})
+
+		It("report nothing when the secret is granted by its legacy policy", func() {
+			rebuilt, err := vpApi.NewSecretRequestFromPath(secret.SecretName, secret.SecretPath)
+			Expect(err).Should(BeNil())
+			err = vaultRawClient.Sys().PutPolicy(rebuilt.LegacyPolicyName, rebuilt.PolicyData)
+			Expect(err).Should(BeNil())
+			err = vaultRawClient.Sys().DeletePolicy(secret.PolicyName)
+			Expect(err).Should(BeNil())
+
+			report, err := vpClient.Reconcile(context.Background(), true)
+			Expect(err).Should(BeNil())
+			Expect(len(report.Drifts)).Should(Equal(0))
+
+			policy, err := vaultRawClient.Sys().GetPolicy(secret.PolicyName)
+			Expect(err).Should(BeNil())
+			Expect(policy).Should(BeEmpty())
+		})
+
+		It("find and repair the policy without secret", func() {
+			err := vaultRawClient.KVv2(secret.SecretName).DeleteMetadata(context.Background(), secret.SecretPath)
+			Expect(err).Should(BeNil())
+
+			report, err := vpClient.Reconcile(context.Background(), true)
+			Expect(err).Should(BeNil())
+			Expect(len(report.Drifts)).Should(Equal(1))
+			Expect(report.Drifts[0].Kind).Should(Equal(vaultproxy.DriftPolicyWithoutSecret))
+
+			policy, err := vaultRawClient.Sys().GetPolicy(secret.PolicyName)
+			Expect(err).Should(BeNil())
+			Expect(policy).Should(BeEmpty())
+		})
+	})
+
+	Describe("Batch", func() {
+		var items []vpApi.SecRequest
+		BeforeEach(func() {
+			expected := int32(3)
+			items = []vpApi.SecRequest{
+				&vpApi.CreateSecretRequest{
+					Type: "git",
+					Meta: map[string]string{"provider_type": "gitlab", "id": "123", "username": "default", "permission": "readonly"},
+					Data: map[string]string{"deploykey": "key"},
+				},
+				&vpApi.CreateSecretRequest{
+					Type:            "git",
+					Meta:            map[string]string{"provider_type": "gitlab", "id": "456", "username": "default", "permission": "readonly"},
+					Data:            map[string]string{"deploykey": "key"},
+					ExpectedVersion: &expected,
+				},
+			}
+		})
+
+		It("create each secret on its own in best effort mode", func() {
+			results, err := vpClient.BatchCreateSecrets(context.Background(), items, false)
+			Expect(err).Should(BeNil())
+			Expect(results).Should(HaveLen(2))
+			Expect(results[0].Err).Should(BeNil())
+			Expect(results[0].Secret.SecretVersion).Should(Equal(1))
+			Expect(vpApi.IsVersionConflict(results[1].Err)).Should(BeTrue())
 
-					_, err = vaultRawClient.KVv2(secret.SecretName).Get(context.Background(), secret.SecretPath)
-					Expect(err).NotTo(BeNil())
-				})
-			})
+			_, err = vpClient.GetSecret(context.Background(), items[0])
+			Expect(err).Should(BeNil())
+		})
 
-			It("when req is not legal, create secret failed", func() {
-				req := &mockSecret{
-					err: errors.New("err"),
-				}
-				_, err := vpClient.CreateSecret(context.Background(), req)
-				Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+		It("reject the options which need the type-specific create", func() {
+			reqs := []vpApi.SecRequest{
+				&vpApi.GitRequest{
+					Meta:              &vpApi.GitMeta{ProviderType: "gitlab", Id: "789", Username: "default", Permission: "readonly"},
+					GenerateDeployKey: true,
+				},
+				&vpApi.RepoRequest{
+					Meta:    &vpApi.RepoMeta{ProviderId: "nexus", Product: "demo"},
+					Account: &vpApi.RepoAccount{AuthType: vpApi.AuthTypePassword, GeneratePassword: true},
+				},
+				&vpApi.ClusterRequest{
+					Meta:    &vpApi.ClusterMeta{Type: "kubernetes", Id: "host", Username: "admin", Permission: "admin"},
+					Account: &vpApi.ClusterAccount{Kubeconfig: "clusters: []", StripContexts: true},
+				},
+			}
+			results, err := vpClient.BatchCreateSecrets(context.Background(), reqs, false)
+			Expect(err).Should(BeNil())
+			for _, result := range results {
+				Expect(vpApi.IsInputArgError(result.Err)).Should(BeTrue())
+				Expect(result.Err.Error()).Should(ContainSubstring("not supported by batch create"))
+			}
 
-				req = &mockSecret{
-					FullPath: "%(*&%",
-				}
+			_, err = vpClient.BatchCreateSecrets(context.Background(), append(items[:1:1], reqs[0]), true)
+			Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+			_, err = vpClient.GetSecret(context.Background(), items[0])
+			Expect(vpApi.IsResourceNotFound(err)).Should(BeTrue())
+		})
 
-				_, err = vpClient.CreateSecret(context.Background(), req)
-				Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
-			})
+		It("roll back the written secrets in atomic mode", func() {
+			results, err := vpClient.BatchCreateSecrets(context.Background(), items, true)
+			Expect(vpApi.IsVersionConflict(err)).Should(BeTrue())
+			Expect(results[0].RolledBack).Should(BeTrue())
 
+			_, err = vpClient.GetSecret(context.Background(), items[0])
+			Expect(vpApi.IsResourceNotFound(err)).Should(BeTrue())
+			secret, err := items[0].ConvertRequest()
+			Expect(err).Should(BeNil())
+			policy, err := vaultRawClient.Sys().GetPolicy(secret.PolicyName)
+			Expect(err).Should(BeNil())
+			Expect(policy).Should(BeEmpty())
 		})
 
-		Context("secret has already exist", func() {
-			BeforeEach(func() {
-				_, err := vpClient.CreateSecret(context.Background(), secret)
-				Expect(err).Should(BeNil())
-			})
+		It("restore the metadata and the policy of the overwritten secrets in atomic mode", func() {
+			existed := &vpApi.CreateSecretRequest{
+				Type: "git",
+				Meta: map[string]string{"provider_type": "gitlab", "id": "123", "username": "default", "permission": "readonly"},
+				Data: map[string]string{"deploykey": "old"},
+				Ttl:  "1h",
+			}
+			_, err := vpClient.CreateSecret(requestid.NewContext(context.Background(), "request-1"), existed)
+			Expect(err).Should(BeNil())
+			secret, err := items[0].ConvertRequest()
+			Expect(err).Should(BeNil())
+			before, err := vaultRawClient.KVv2("git").GetMetadata(context.Background(), secret.SecretPath)
+			Expect(err).Should(BeNil())
+			err = vaultRawClient.Sys().PutPolicy(secret.PolicyName, `path "git/data/other" { capabilities = ["read"] }`)
+			Expect(err).Should(BeNil())
 
-			Context("when create policy failed", func() {
-				It("will update secret failed and rollback to the pre version", func() {
-					secret.PolicyName = ""
+			_, err = vpClient.BatchCreateSecrets(requestid.NewContext(context.Background(), "request-2"), items, true)
+			Expect(vpApi.IsVersionConflict(err)).Should(BeTrue())
 
-					_, err := vpClient.CreateSecret(context.Background(), secret)
-					Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+			after, err := vaultRawClient.KVv2("git").GetMetadata(context.Background(), secret.SecretPath)
+			Expect(err).Should(BeNil())
+			Expect(after.CustomMetadata).Should(Equal(before.CustomMetadata))
+			policy, err := vaultRawClient.Sys().GetPolicy(secret.PolicyName)
+			Expect(err).Should(BeNil())
+			Expect(policy).Should(Equal(`path "git/data/other" { capabilities = ["read"] }`))
+		})
 
-					rst, _ := vaultRawClient.KVv2(secret.SecretName).Get(context.Background(), secret.SecretPath)
-					Expect(len(rst.Data)).Should(BeNumerically("==", 1))
-				})
-			})
+		It("undelete the deleted secrets in atomic mode", func() {
+			_, err := vpClient.CreateSecret(context.Background(), items[0])
+			Expect(err).Should(BeNil())
+
+			results, err := vpClient.BatchDeleteSecrets(context.Background(), []vpApi.SecRequest{
+				items[0],
+				&vpApi.DeleteSecretRequest{Type: "git", Meta: map[string]string{"provider_type": "gitlab"}},
+			}, true)
+			Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+			Expect(results[0].RolledBack).Should(BeFalse())
+			_, err = vpClient.GetSecret(context.Background(), items[0])
+			Expect(err).Should(BeNil())
+
+			results, err = vpClient.BatchDeleteSecrets(context.Background(), items[:1], true)
+			Expect(err).Should(BeNil())
+			Expect(results[0].Secret.SecretVersion).Should(Equal(1))
 		})
 	})
 
-	Describe("Delete Secret", func() {
+	Describe("Dry Run", func() {
+		var secretReq *vpApi.CreateSecretRequest
 		BeforeEach(func() {
-			_, err := vpClient.CreateSecret(context.Background(), secret)
+			secretReq = &vpApi.CreateSecretRequest{
+				Type: "git",
+				Meta: map[string]string{"provider_type": "gitlab", "id": "123", "username": "default", "permission": "readonly"},
+				Data: map[string]string{"deploykey": "key"},
+			}
+		})
+
+		It("report the secret and the policy to create without writing them", func() {
+			plan := &vpApi.DryRunPlan{}
+			secretData, err := vpClient.CreateSecret(dryrun.NewContext(context.Background(), plan), secretReq)
+			Expect(err).Should(BeNil())
+			Expect(secretData.SecretVersion).Should(Equal(1))
+
+			secret, err := secretReq.ConvertRequest()
+			Expect(err).Should(BeNil())
+			Expect(plan.SecretPath).Should(Equal(secret.SecretPath))
+			Expect(plan.PolicyName).Should(Equal(secret.PolicyName))
+			Expect(plan.PolicyData).Should(Equal(secret.PolicyData))
+
+			_, err = vpClient.GetSecret(context.Background(), secretReq)
+			Expect(vpApi.IsResourceNotFound(err)).Should(BeTrue())
+			policy, err := vaultRawClient.Sys().GetPolicy(secret.PolicyName)
 			Expect(err).Should(BeNil())
+			Expect(policy).Should(BeEmpty())
 		})
 
-		It("delete secret seccessed", func() {
-			err := vpClient.DeleteSecret(context.Background(), secret)
+		It("report the version conflict as the create does", func() {
+			expected := int32(3)
+			secretReq.ExpectedVersion = &expected
+			_, err := vpClient.CreateSecret(dryrun.NewContext(context.Background(), &vpApi.DryRunPlan{}), secretReq)
+			Expect(vpApi.IsVersionConflict(err)).Should(BeTrue())
+		})
+
+		It("keep the secret when delete in dry run", func() {
+			_, err := vpClient.CreateSecret(context.Background(), secretReq)
+			Expect(err).Should(BeNil())
+
+			plan := &vpApi.DryRunPlan{}
+			_, err = vpClient.DeleteSecret(dryrun.NewContext(context.Background(), plan), secretReq)
+			Expect(err).Should(BeNil())
+			Expect(plan.PolicyName).ShouldNot(BeEmpty())
+
+			_, err = vpClient.GetSecret(context.Background(), secretReq)
 			Expect(err).Should(BeNil())
 		})
 
-		Context("delete policy failed", func() {
-			It("will return internal error", func() {
-				// secret.PolicyName = "newPolicyName"
-				// wantErr := vpApi.ErrorInternalServiceError("delete policy of %s in %s failed", secret.SecretPath, secret.SecretName)
+		It("do not return the generated deploy key and password", func() {
+			gitReq := newGitRequest(&vpApi.GitKVs{AccessToken: "token"})
+			gitReq.GenerateDeployKey = true
+			_, publicKey, err := vpClient.CreateGit(dryrun.NewContext(context.Background(), &vpApi.DryRunPlan{}), gitReq)
+			Expect(err).Should(BeNil())
+			Expect(publicKey).Should(BeEmpty())
 
-				// err := vpClient.DeleteSecret(context.Background(), secret)
-				// Expect(err).To(Equal(wantErr))
+			err = vaultRawClient.Sys().Mount("repo", &vault.MountInput{
+				Type:    "kv",
+				Options: map[string]string{"version": "2"},
+			})
+			Expect(err).Should(BeNil())
+			repoReq := newRepoRequest(&vpApi.RepoAccount{
+				AuthType:         vpApi.AuthTypePassword,
+				Account:          &vpApi.Account{Username: "admin"},
+				GeneratePassword: true,
 			})
+			_, password, err := vpClient.CreateRepoAccount(dryrun.NewContext(context.Background(), &vpApi.DryRunPlan{}), repoReq)
+			Expect(err).Should(BeNil())
+			Expect(password).Should(BeEmpty())
+		})
 
-			It("when req is not legal, create secret failed", func() {
-				req := &mockSecret{
-					err: errors.New("err"),
-				}
-				err := vpClient.DeleteSecret(context.Background(), req)
-				Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+		It("reject the recursive delete in dry run", func() {
+			_, _, err := vpClient.DeleteSecretRecursive(dryrun.NewContext(context.Background(), &vpApi.DryRunPlan{}), secretReq, nil)
+			Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+		})
+	})
 
-				req = &mockSecret{
-					FullPath: "%(*&%",
-				}
+	Describe("Rotation", func() {
+		It("flag the secrets older than the rotation period of their type", func() {
+			dataCfg := &conf.Data{
+				Vault: &conf.Data_Vault{
+					Addr:  "http://127.0.0.1:8200",
+					Token: "test",
+				},
+			}
+			rotationClient := vaultproxy.NewVaultUsercase(vpData.NewVaultClient(dataCfg, log.DefaultLogger), &conf.Server{
+				Authorization: &conf.Server_Authorization{
+					Resource: &conf.Server_Authorization_Casbin{Acl: casbinPermissionFile},
+				},
+				Rotation: &conf.Server_Rotation{
+					Periods: map[string]*durationpb.Duration{"git": durationpb.New(time.Nanosecond)},
+				},
+			}, dataCfg, log.DefaultLogger)
 
-				err = vpClient.DeleteSecret(context.Background(), req)
-				Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
+			ctx := auth.NewAuthContext(context.Background(), "RUNTIME")
+			_, err := rotationClient.CreateSecret(ctx, &vpApi.CreateSecretRequest{
+				Type: "git",
+				Meta: map[string]string{"provider_type": "gitlab", "id": "123", "username": "default", "permission": "readonly"},
+				Data: map[string]string{"deploykey": "key"},
+			})
+			Expect(err).Should(BeNil())
+			err = vaultRawClient.Sys().Mount(vpApi.TenantSecretName, &vault.MountInput{Type: "kv", Options: map[string]string{"version": "2"}})
+			Expect(err).Should(BeNil())
+			_, err = rotationClient.CreateSecret(ctx, &vpApi.CreateSecretRequest{
+				Type: "tenant-git",
+				Meta: map[string]string{"id": "123", "permission": "readonly"},
+				Data: map[string]string{"deploykey": "key"},
 			})
+			Expect(err).Should(BeNil())
+
+			report, err := rotationClient.ReportRotations(context.Background(), false)
+			Expect(err).Should(BeNil())
+			Expect(report.Rotations).Should(HaveLen(2))
+
+			report, err = rotationClient.ReportRotations(context.Background(), true)
+			Expect(err).Should(BeNil())
+			Expect(report.Rotations).Should(HaveLen(1))
+			rotation := report.Rotations[0]
+			Expect(rotation.SecretPath).Should(Equal("gitlab/123/default/readonly"))
+			Expect(rotation.SecretType).Should(Equal("git"))
+			Expect(rotation.Version).Should(Equal(1))
+			Expect(rotation.CreatedBy).Should(Equal("RUNTIME"))
+			Expect(rotation.Stale).Should(BeTrue())
 		})
 	})
 
@@ -516,6 +1712,32 @@ var _ = Describe("Auth", func() {
 			Expect(role.Data["token_policies"].([]interface{})[0]).Should(Equal(baseGrant.Secret.PolicyName))
 		})
 
+		It("grant the secret whose secret engine is not the name of its type", func() {
+			// The dev server mounts the kv v2 secret engine "secret"
+			grant := &mockPolicyRequest{
+				ClusterName: baseGrant.ClusterName,
+				DestUser:    baseGrant.DestUser,
+				Secret: mockSecret{
+					SecretName: "secret",
+					SecretPath: "sonarqube/123",
+					SecretType: "sonarqube",
+					FullPath:   "secret/data/sonarqube/123",
+					PolicyName: "sonarqube-123",
+					SecretData: map[string]interface{}{"token": "123"},
+					PolicyData: "path \"secret/data/sonarqube/123\" {\n    capabilities = [\"read\"]\n}",
+				},
+			}
+			_, err := vpClient.CreateSecret(context.Background(), &grant.Secret)
+			Expect(err).Should(BeNil())
+
+			err = vpClient.GrantPermision(context.Background(), grant)
+			Expect(err).Should(BeNil())
+
+			role, err := vaultRawClient.Logical().Read(rolePath)
+			Expect(err).Should(BeNil())
+			Expect(role.Data["token_policies"]).Should(ContainElement(grant.Secret.PolicyName))
+		})
+
 		It("grant an exist policy will not duplicate append", func() {
 			err := vpClient.GrantPermision(context.Background(), baseGrant)
 			Expect(err).Should(BeNil())
@@ -528,6 +1750,20 @@ var _ = Describe("Auth", func() {
 			Expect(role.Data["token_policies"].([]interface{})[0]).Should(Equal(baseGrant.Secret.PolicyName))
 		})
 
+		It("report the policy to grant without updating the role in dry run", func() {
+			plan := &vpApi.DryRunPlan{}
+			err := vpClient.GrantPermision(dryrun.NewContext(context.Background(), plan), baseGrant)
+			Expect(err).Should(BeNil())
+			Expect(plan.Roles).Should(HaveLen(1))
+			Expect(plan.Roles[0].RolePath).Should(Equal(rolePath))
+			Expect(plan.Roles[0].AddedPolicies).Should(Equal([]string{baseGrant.Secret.PolicyName}))
+
+			role, err := vaultRawClient.Logical().Read(rolePath)
+			Expect(err).Should(BeNil())
+			policies, _ := role.Data["token_policies"].([]interface{})
+			Expect(policies).Should(BeEmpty())
+		})
+
 	})
 
 	Describe("Revoke Permission", func() {
@@ -595,6 +1831,116 @@ var _ = Describe("Auth", func() {
 			Expect(vpApi.IsInputArgError(err)).Should(BeTrue())
 		})
 
+		It("delete secret will revoke its policy from the roles", func() {
+			err := vpClient.GrantPermision(context.Background(), baseGrant)
+			Expect(err).Should(BeNil())
+
+			revokedRoles, err := vpClient.DeleteSecret(context.Background(), &baseGrant.Secret)
+			Expect(err).Should(BeNil())
+			Expect(revokedRoles).Should(Equal([]string{rolePath}))
+
+			role, err := vaultRawClient.Logical().Read(rolePath)
+			Expect(err).Should(BeNil())
+			Expect(len(role.Data["token_policies"].([]interface{}))).Should(Equal(0))
+		})
+
+		It("undelete secret will grant its policy to the revoked roles again", func() {
+			err := vpClient.GrantPermision(context.Background(), baseGrant)
+			Expect(err).Should(BeNil())
+			_, err = vpClient.DeleteSecret(context.Background(), &baseGrant.Secret)
+			Expect(err).Should(BeNil())
+
+			err = vpClient.GrantPermision(context.Background(), baseGrant)
+			Expect(vpApi.IsResourceNotFound(err)).Should(BeTrue())
+
+			_, grantedRoles, err := vpClient.UndeleteSecret(context.Background(), &baseGrant.Secret)
+			Expect(err).Should(BeNil())
+			Expect(grantedRoles).Should(Equal([]string{rolePath}))
+
+			role, err := vaultRawClient.Logical().Read(rolePath)
+			Expect(err).Should(BeNil())
+			Expect(role.Data["token_policies"]).Should(Equal([]interface{}{baseGrant.Secret.PolicyName}))
+		})
+
+		It("undelete after the secret is created again will not grant the roles revoked before", func() {
+			err := vpClient.GrantPermision(context.Background(), baseGrant)
+			Expect(err).Should(BeNil())
+			_, err = vpClient.DeleteSecret(context.Background(), &baseGrant.Secret)
+			Expect(err).Should(BeNil())
+
+			_, err = vpClient.CreateSecret(context.Background(), &baseGrant.Secret)
+			Expect(err).Should(BeNil())
+			_, err = vpClient.DeleteSecret(context.Background(), &baseGrant.Secret)
+			Expect(err).Should(BeNil())
+
+			_, grantedRoles, err := vpClient.UndeleteSecret(context.Background(), &baseGrant.Secret)
+			Expect(err).Should(BeNil())
+			Expect(grantedRoles).Should(BeEmpty())
+		})
+
+		It("undelete secret will grant its policy to the roles longer than a custom metadata value", func() {
+			// Vault rejects the custom metadata value longer than 512 bytes
+			var rolePaths []string
+			for i := 0; i < 20; i++ {
+				path := fmt.Sprintf("auth/%s/role/runtime-of-a-long-project-name-%02d", baseRole.ClusterName, i)
+				_, err := vaultRawClient.Logical().Write(path, map[string]interface{}{
+					"bound_service_account_names":      []string{"default"},
+					"bound_service_account_namespaces": []string{"default"},
+					"token_policies":                   []string{baseGrant.Secret.PolicyName},
+				})
+				Expect(err).Should(BeNil())
+				rolePaths = append(rolePaths, path)
+			}
+			Expect(len(strings.Join(rolePaths, ","))).Should(BeNumerically(">", 512))
+
+			revokedRoles, err := vpClient.DeleteSecret(context.Background(), &baseGrant.Secret)
+			Expect(err).Should(BeNil())
+			Expect(revokedRoles).Should(ConsistOf(rolePaths))
+
+			_, grantedRoles, err := vpClient.UndeleteSecret(context.Background(), &baseGrant.Secret)
+			Expect(err).Should(BeNil())
+			Expect(grantedRoles).Should(ConsistOf(rolePaths))
+			for _, path := range rolePaths {
+				role, err := vaultRawClient.Logical().Read(path)
+				Expect(err).Should(BeNil())
+				Expect(role.Data["token_policies"]).Should(Equal([]interface{}{baseGrant.Secret.PolicyName}))
+			}
+		})
+
+		It("undelete secret will grant its policy to the roles recorded in the journal", func() {
+			dataCfg := &conf.Data{
+				Vault: &conf.Data_Vault{
+					Addr:  "http://127.0.0.1:8200",
+					Token: "test",
+				},
+				Journal: &conf.Data_Journal{
+					SecretName: "git",
+					Path:       "vault-proxy/journal",
+				},
+			}
+			journalClient := vaultproxy.NewVaultUsercase(vpData.NewVaultClient(dataCfg, log.DefaultLogger), &conf.Server{
+				Authorization: &conf.Server_Authorization{
+					Resource: &conf.Server_Authorization_Casbin{Acl: casbinPermissionFile},
+				},
+			}, dataCfg, log.DefaultLogger)
+			err := journalClient.GrantPermision(context.Background(), baseGrant)
+			Expect(err).Should(BeNil())
+
+			_, err = journalClient.DeleteSecret(context.Background(), &baseGrant.Secret)
+			Expect(err).Should(BeNil())
+			metadata, err := vaultRawClient.KVv2("git").GetMetadata(context.Background(), baseGrant.Secret.SecretPath)
+			Expect(err).Should(BeNil())
+			Expect(metadata.CustomMetadata).ShouldNot(HaveKey("revoked_roles"))
+
+			_, grantedRoles, err := journalClient.UndeleteSecret(context.Background(), &baseGrant.Secret)
+			Expect(err).Should(BeNil())
+			Expect(grantedRoles).Should(Equal([]string{rolePath}))
+
+			keys, err := vaultRawClient.Logical().List("git/metadata/vault-proxy/journal")
+			Expect(err).Should(BeNil())
+			Expect(keys).Should(BeNil())
+		})
+
 		It("if role not exist, revoke will success", func() {
 			err := vpClient.GrantPermision(context.Background(), baseGrant)
 			Expect(err).Should(BeNil())
@@ -607,4 +1953,142 @@ var _ = Describe("Auth", func() {
 			Expect(err).Should(BeNil())
 		})
 	})
+
+	Describe("Migrate Policy Names", func() {
+		var rolePath string
+		var gitSecret *vpApi.SecretRequest
+		BeforeEach(func() {
+			err := vpClient.EnableAuth(context.Background(), baseAuth)
+			Expect(err).Should(BeNil())
+			err = vpClient.CreateRole(context.Background(), baseRole)
+			Expect(err).Should(BeNil())
+			err = vaultRawClient.Sys().Mount("git", &vault.MountInput{
+				Type:    "kv",
+				Options: map[string]string{"version": "2"},
+			})
+			Expect(err).Should(BeNil())
+
+			req := &vpApi.GitRequest{
+				Meta: &vpApi.GitMeta{ProviderType: "gitlab", Id: "123", Username: "default", Permission: "readonly"},
+				Kvs:  &vpApi.GitKVs{DeployKey: "key"},
+			}
+			_, err = vpClient.CreateSecret(context.Background(), req)
+			Expect(err).Should(BeNil())
+			gitSecret, err = req.ConvertRequest()
+			Expect(err).Should(BeNil())
+
+			// Move the policy to the legacy name, as the secrets created before the hash suffix
+			err = vaultRawClient.Sys().PutPolicy(gitSecret.LegacyPolicyName, gitSecret.PolicyData)
+			Expect(err).Should(BeNil())
+			err = vaultRawClient.Sys().DeletePolicy(gitSecret.PolicyName)
+			Expect(err).Should(BeNil())
+			rolePath = fmt.Sprintf("auth/%s/role/%s", baseRole.ClusterName, baseRole.DestUser)
+			_, err = vaultRawClient.Logical().Write(rolePath, map[string]interface{}{
+				"token_policies": []string{"default", gitSecret.LegacyPolicyName},
+			})
+			Expect(err).Should(BeNil())
+		})
+
+		AfterEach(func() {
+			os.Remove(casbinPermissionFile)
+		})
+
+		It("report the steps without changes in dry run mode", func() {
+			report, err := vpClient.MigratePolicyNames(context.Background(), true)
+			Expect(err).Should(BeNil())
+			Expect(report.Migrations).Should(HaveLen(3))
+			Expect(report.Migrations[0].Step).Should(Equal(vaultproxy.MigrationCreatePolicy))
+			Expect(report.Migrations[1].Step).Should(Equal(vaultproxy.MigrationUpdateRole))
+			Expect(report.Migrations[1].RolePath).Should(Equal(rolePath))
+			Expect(report.Migrations[2].Step).Should(Equal(vaultproxy.MigrationDeletePolicy))
+			for _, migration := range report.Migrations {
+				Expect(migration.OldPolicyName).Should(Equal(gitSecret.LegacyPolicyName))
+				Expect(migration.NewPolicyName).Should(Equal(gitSecret.PolicyName))
+				Expect(migration.Done).Should(BeFalse())
+			}
+
+			policy, err := vaultRawClient.Sys().GetPolicy(gitSecret.PolicyName)
+			Expect(err).Should(BeNil())
+			Expect(policy).Should(BeEmpty())
+		})
+
+		It("delete and purge the secret which is not migrated revoke and delete the legacy policy", func() {
+			revokedRoles, err := vpClient.DeleteSecret(context.Background(), &vpApi.GitRequest{
+				Meta: &vpApi.GitMeta{ProviderType: "gitlab", Id: "123", Username: "default", Permission: "readonly"},
+			})
+			Expect(err).Should(BeNil())
+			Expect(revokedRoles).Should(Equal([]string{rolePath}))
+			role, err := vaultRawClient.Logical().Read(rolePath)
+			Expect(err).Should(BeNil())
+			Expect(role.Data["token_policies"]).Should(Equal([]interface{}{"default"}))
+
+			_, err = vpClient.PurgeSecret(context.Background(), &vpApi.PurgeGitRequest{
+				Meta: &vpApi.GitMeta{ProviderType: "gitlab", Id: "123", Username: "default", Permission: "readonly"},
+			})
+			Expect(err).Should(BeNil())
+			policy, err := vaultRawClient.Sys().GetPolicy(gitSecret.LegacyPolicyName)
+			Expect(err).Should(BeNil())
+			Expect(policy).Should(BeEmpty())
+		})
+
+		It("grant the legacy policy of the secret which is not migrated", func() {
+			_, err := vaultRawClient.Logical().Write(rolePath, map[string]interface{}{
+				"token_policies": []string{"default"},
+			})
+			Expect(err).Should(BeNil())
+
+			err = vpClient.GrantPermision(context.Background(), &vpApi.AuthroleGitPolicyRequest{
+				ClusterName: baseRole.ClusterName,
+				DestUser:    baseRole.DestUser,
+				Secret: &vpApi.GitMeta{
+					ProviderType: "gitlab",
+					Id:           "123",
+					Username:     "default",
+					Permission:   "readonly",
+				},
+			})
+			Expect(err).Should(BeNil())
+			role, err := vaultRawClient.Logical().Read(rolePath)
+			Expect(err).Should(BeNil())
+			Expect(role.Data["token_policies"]).Should(Equal([]interface{}{"default", gitSecret.LegacyPolicyName}))
+
+			err = vpClient.RevokePermision(context.Background(), &vpApi.AuthroleGitPolicyRequest{
+				ClusterName: baseRole.ClusterName,
+				DestUser:    baseRole.DestUser,
+				Secret: &vpApi.GitMeta{
+					ProviderType: "gitlab",
+					Id:           "123",
+					Username:     "default",
+					Permission:   "readonly",
+				},
+			})
+			Expect(err).Should(BeNil())
+			role, err = vaultRawClient.Logical().Read(rolePath)
+			Expect(err).Should(BeNil())
+			Expect(role.Data["token_policies"]).Should(Equal([]interface{}{"default"}))
+		})
+
+		It("rename the legacy policy and replace it in the roles", func() {
+			report, err := vpClient.MigratePolicyNames(context.Background(), false)
+			Expect(err).Should(BeNil())
+			Expect(report.Migrations).Should(HaveLen(3))
+			for _, migration := range report.Migrations {
+				Expect(migration.Done).Should(BeTrue())
+			}
+
+			policy, err := vaultRawClient.Sys().GetPolicy(gitSecret.PolicyName)
+			Expect(err).Should(BeNil())
+			Expect(policy).Should(Equal(gitSecret.PolicyData))
+			policy, err = vaultRawClient.Sys().GetPolicy(gitSecret.LegacyPolicyName)
+			Expect(err).Should(BeNil())
+			Expect(policy).Should(BeEmpty())
+			role, err := vaultRawClient.Logical().Read(rolePath)
+			Expect(err).Should(BeNil())
+			Expect(role.Data["token_policies"]).Should(Equal([]interface{}{"default", gitSecret.PolicyName}))
+
+			report, err = vpClient.MigratePolicyNames(context.Background(), false)
+			Expect(err).Should(BeNil())
+			Expect(report.Migrations).Should(BeEmpty())
+		})
+	})
 })
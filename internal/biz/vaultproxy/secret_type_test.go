@@ -0,0 +1,250 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaultproxy_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vpApi "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+)
+
+var _ = Describe("Secret Type", Ordered, func() {
+	BeforeAll(func() {
+		err := vpApi.RegisterSecretType(vpApi.SecretTypeDefinition{
+			Name:               "sonarqube",
+			SecretName:         "sonarqube",
+			PathTemplate:       "{{.id}}/{{.permission}}",
+			PolicyNameTemplate: "sonarqube-{{.id}}-{{.permission}}",
+			Schema: &vpApi.SecretSchema{
+				Keys: []vpApi.SecretKeySchema{
+					{Name: "token", Required: true, MaxSize: 64},
+					{Name: "url", Format: vpApi.FormatURL},
+				},
+			},
+		})
+		Expect(err).Should(BeNil())
+	})
+
+	It("convert the generic request by the registered type", func() {
+		secret, err := (&vpApi.CreateSecretRequest{
+			Type: "sonarqube",
+			Meta: map[string]string{"id": "sonar-01", "permission": "readonly"},
+			Data: map[string]string{"token": "abc"},
+		}).ConvertRequest()
+		Expect(err).Should(BeNil())
+		Expect(secret.FullPath).Should(Equal("sonarqube/data/sonar-01/readonly"))
+		Expect(secret.PolicyName).Should(MatchRegexp(`^sonarqube-sonar-01-readonly-[0-9a-f]{12}$`))
+		Expect(secret.PolicyData).Should(ContainSubstring(`path "sonarqube/data/sonar-01/readonly"`))
+		Expect(secret.SecretData).Should(Equal(map[string]interface{}{"token": "abc"}))
+
+		rebuilt, err := vpApi.NewSecretRequestFromPath("sonarqube", "sonar-01/readonly")
+		Expect(err).Should(BeNil())
+		Expect(rebuilt.PolicyName).Should(Equal(secret.PolicyName))
+	})
+
+	It("reject the request which does not match the type", func() {
+		reqs := []*vpApi.CreateSecretRequest{
+			{Type: "unknown", Meta: map[string]string{"id": "sonar-01", "permission": "readonly"}, Data: map[string]string{"token": "abc"}},
+			{Type: "sonarqube", Meta: map[string]string{"id": "sonar-01"}, Data: map[string]string{"token": "abc"}},
+			{Type: "sonarqube", Meta: map[string]string{"id": "sonar/01", "permission": "readonly"}, Data: map[string]string{"token": "abc"}},
+		}
+		for _, req := range reqs {
+			_, err := req.ConvertRequest()
+			Expect(err).ShouldNot(BeNil())
+		}
+	})
+
+	It("validate the secret data by the schema of the type", func() {
+		violations := vpApi.ValidateSecretData("sonarqube", map[string]interface{}{"token": "abc", "url": "https://sonar"})
+		Expect(violations).Should(BeEmpty())
+
+		violations = vpApi.ValidateSecretData("sonarqube", map[string]interface{}{
+			"url":      "sonar",
+			"password": "abc",
+		})
+		Expect(violations).Should(HaveLen(3))
+		Expect(violations[0].Field).Should(Equal("password"))
+		Expect(violations[1].Field).Should(Equal("token"))
+		Expect(violations[2].Field).Should(Equal("url"))
+
+		violations = vpApi.ValidateSecretData("repo", map[string]interface{}{"authType": "token", "token": ""})
+		Expect(violations).Should(Equal([]vpApi.FieldViolation{{Field: "token", Description: "key is required"}}))
+		violations = vpApi.ValidateSecretData("repo", map[string]interface{}{"authType": "ssh"})
+		Expect(violations).Should(HaveLen(1))
+		Expect(violations[0].Field).Should(Equal("authType"))
+
+		violations = vpApi.ValidateSecretData("cluster", map[string]interface{}{"kubeconfig": "not a kubeconfig"})
+		Expect(violations).Should(HaveLen(1))
+		Expect(violations[0].Field).Should(Equal("kubeconfig"))
+		violations = vpApi.ValidateSecretData("cluster", map[string]interface{}{"kubeconfig": `
+apiVersion: v1
+kind: Config
+clusters:
+- name: host
+  cluster:
+    server: https://127.0.0.1:6443
+users:
+- name: admin
+  user:
+    token: abc
+`})
+		Expect(violations).Should(BeEmpty())
+	})
+
+	It("map the permission to the capabilities of the policy", func() {
+		err := vpApi.ConfigureSecretTypePolicy("sonarqube", "", map[string][]string{
+			"readonly":  {"read"},
+			"readwrite": {"read", "update"},
+		})
+		Expect(err).Should(BeNil())
+
+		secret, err := (&vpApi.CreateSecretRequest{
+			Type: "sonarqube",
+			Meta: map[string]string{"id": "sonar-01", "permission": "readwrite"},
+			Data: map[string]string{"token": "abc"},
+		}).ConvertRequest()
+		Expect(err).Should(BeNil())
+		Expect(secret.PolicyData).Should(ContainSubstring(`capabilities = ["read", "update"]`))
+		Expect(vpApi.ValidatePolicy(secret.PolicyData)).Should(BeNil())
+
+		secret, err = vpApi.NewSecretRequestFromPath("sonarqube", "sonar-01/readonly")
+		Expect(err).Should(BeNil())
+		Expect(secret.PolicyData).Should(ContainSubstring(`capabilities = ["read"]`))
+	})
+
+	It("map the permission of the built-in types without the configured mapping", func() {
+		secret, err := (&vpApi.GitRequest{
+			Meta: &vpApi.GitMeta{ProviderType: "gitlab", Id: "123", Username: "default", Permission: "readwrite"},
+		}).ConvertRequest()
+		Expect(err).Should(BeNil())
+		Expect(secret.PolicyData).Should(ContainSubstring(`capabilities = ["read", "update"]`))
+
+		secret, err = vpApi.NewSecretRequestFromPath("tenant", "repo/harbor/readonly")
+		Expect(err).Should(BeNil())
+		Expect(secret.PolicyData).Should(ContainSubstring(`capabilities = ["read"]`))
+	})
+
+	It("reject the invalid policy", func() {
+		err := vpApi.ConfigureSecretTypePolicy("sonarqube", "", map[string][]string{"readwrite": {"write"}})
+		Expect(err).ShouldNot(BeNil())
+		err = vpApi.ConfigureSecretTypePolicy("sonarqube", `path "{{.FullPath}}" { allow = true }`, nil)
+		Expect(err).ShouldNot(BeNil())
+		err = vpApi.ConfigureSecretTypePolicy("unknown", "", map[string][]string{"readonly": {"read"}})
+		Expect(err).ShouldNot(BeNil())
+
+		policies := []string{
+			`path "git/data/a" {`,
+			`path "git/data/a" { capabilities = ["write"] }`,
+			`path "git/data/a" { capabilities = ["read"] } name = "git"`,
+			`path { capabilities = ["read"] }`,
+			``,
+		}
+		for _, policy := range policies {
+			Expect(vpApi.ValidatePolicy(policy)).ShouldNot(BeNil(), policy)
+		}
+	})
+
+	It("rebuild the secrets of the built-in types and the registered types from the paths", func() {
+		err := vpApi.RegisterSecretType(vpApi.SecretTypeDefinition{
+			Name:               "artifact",
+			SecretName:         "repo",
+			PathTemplate:       "{{.id}}/{{.name}}",
+			PolicyNameTemplate: "artifact-{{.id}}-{{.name}}",
+		})
+		Expect(err).Should(BeNil())
+		err = vpApi.RegisterSecretType(vpApi.SecretTypeDefinition{
+			Name:               "jenkins",
+			SecretName:         "ci",
+			PathTemplate:       "jenkins/{{.id}}{{ if .job }}/{{.job}}{{ end }}",
+			PolicyNameTemplate: "jenkins-{{.id}}-{{.job}}",
+		})
+		Expect(err).Should(BeNil())
+
+		repo, err := (&vpApi.RepoRequest{Meta: &vpApi.RepoMeta{ProviderId: "nexus", Product: "demo"}}).ConvertRequest()
+		Expect(err).Should(BeNil())
+		rebuilt, err := vpApi.NewSecretRequestFromPath("repo", "nexus/demo")
+		Expect(err).Should(BeNil())
+		Expect(rebuilt.PolicyName).Should(Equal(repo.PolicyName))
+
+		project, err := (&vpApi.RepoRequest{Meta: &vpApi.RepoMeta{ProviderId: "nexus", Product: "demo", Project: "web"}}).ConvertRequest()
+		Expect(err).Should(BeNil())
+		rebuilt, err = vpApi.NewSecretRequestFromPath("repo", "nexus/demo/web")
+		Expect(err).Should(BeNil())
+		Expect(rebuilt.PolicyName).Should(Equal(project.PolicyName))
+
+		job, err := (&vpApi.CreateSecretRequest{Type: "jenkins", Meta: map[string]string{"id": "ci-01", "job": "build"}}).ConvertRequest()
+		Expect(err).Should(BeNil())
+		rebuilt, err = vpApi.NewSecretRequestFromPath("ci", "jenkins/ci-01/build")
+		Expect(err).Should(BeNil())
+		Expect(rebuilt.PolicyName).Should(Equal(job.PolicyName))
+		Expect(rebuilt.FullPath).Should(Equal("ci/data/jenkins/ci-01/build"))
+
+		_, err = vpApi.NewSecretRequestFromPath("ci", "other/ci-01")
+		Expect(err).ShouldNot(BeNil())
+	})
+
+	It("policy names of different secrets never collide", func() {
+		secret, err := vpApi.NewSecretRequestFromPath("git", "a-b/c/default/readonly")
+		Expect(err).Should(BeNil())
+		other, err := vpApi.NewSecretRequestFromPath("git", "a/b-c/default/readonly")
+		Expect(err).Should(BeNil())
+
+		Expect(secret.LegacyPolicyName).Should(Equal(other.LegacyPolicyName))
+		Expect(secret.PolicyName).ShouldNot(Equal(other.PolicyName))
+		Expect(secret.PolicyName).Should(HavePrefix(secret.LegacyPolicyName + "-"))
+	})
+
+	It("convert the ttl or the expire time to the expire time of the secret", func() {
+		req := &vpApi.CreateSecretRequest{
+			Type: "sonarqube",
+			Meta: map[string]string{"id": "sonar-01", "permission": "readonly"},
+			Data: map[string]string{"token": "abc"},
+			Ttl:  "1h",
+		}
+		secret, err := req.ConvertRequest()
+		Expect(err).Should(BeNil())
+		Expect(*secret.ExpireTime).Should(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+
+		req.Ttl = ""
+		req.ExpireTime = "2999-01-01T00:00:00Z"
+		secret, err = req.ConvertRequest()
+		Expect(err).Should(BeNil())
+		Expect(secret.ExpireTime.Format(time.RFC3339)).Should(Equal("2999-01-01T00:00:00Z"))
+
+		invalids := [][2]string{{"1h", "2999-01-01T00:00:00Z"}, {"-1h", ""}, {"1d", ""}, {"", "2000-01-01T00:00:00Z"}, {"", "2999-01-01"}}
+		for _, invalid := range invalids {
+			req.Ttl, req.ExpireTime = invalid[0], invalid[1]
+			_, err = req.ConvertRequest()
+			Expect(err).ShouldNot(BeNil())
+		}
+	})
+
+	It("built-in type can not be replaced", func() {
+		err := vpApi.RegisterSecretType(vpApi.SecretTypeDefinition{
+			Name:               "git",
+			SecretName:         "git",
+			PathTemplate:       "{{.id}}",
+			PolicyNameTemplate: "git-{{.id}}",
+		})
+		Expect(err).ShouldNot(BeNil())
+
+		def, ok := vpApi.GetSecretType("git")
+		Expect(ok).Should(BeTrue())
+		Expect(def.PathTemplate).Should(Equal("{{.provider_type}}/{{.id}}/{{.username}}/{{.permission}}"))
+	})
+})
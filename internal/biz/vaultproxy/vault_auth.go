@@ -17,8 +17,11 @@ package vaultproxy
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	pb "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+	"github.com/nautes-labs/vault-proxy/internal/pkg/dryrun"
 
 	vault "github.com/hashicorp/vault/api"
 )
@@ -34,6 +37,11 @@ func (uc *VaultUsercase) EnableAuth(ctx context.Context, req *pb.AuthRequest) er
 	if err != nil {
 		return pb.ErrorInternalServiceError("get auth %s info failed: %s", path, err)
 	}
+	if plan := dryrun.FromContext(ctx); plan != nil {
+		plan.AuthPath = fmt.Sprintf("auth/%s", path)
+		plan.EnableAuth = cfg == nil
+		return nil
+	}
 
 	if cfg == nil {
 		authOpts := &vault.MountInput{
@@ -99,6 +107,22 @@ func (uc *VaultUsercase) CreateRole(ctx context.Context, req *pb.AuthroleRequest
 		"bound_service_account_namespaces": req.GetKubernetes().Namespaces,
 		"bound_service_account_names":      req.GetKubernetes().ServiceAccounts,
 	}
+	if plan := dryrun.FromContext(ctx); plan != nil {
+		roleCFG, err := uc.client.Read(ctx, path)
+		if err != nil {
+			return pb.ErrorInternalServiceError("get role %s failed: %s", req.DestUser, err)
+		}
+		plan.AuthPath = fmt.Sprintf("auth/%s", req.ClusterName)
+		plan.Roles = append(plan.Roles, &pb.RoleDiff{
+			RolePath: path,
+			Create:   roleCFG == nil,
+			Config: map[string]string{
+				"bound_service_account_namespaces": strings.Join(req.GetKubernetes().GetNamespaces(), ","),
+				"bound_service_account_names":      strings.Join(req.GetKubernetes().GetServiceAccounts(), ","),
+			},
+		})
+		return nil
+	}
 
 	uc.log.WithContext(ctx).Infof("create or update role %s", path)
 	_, err = uc.client.Write(ctx, path, opts)
@@ -138,9 +162,11 @@ func (uc *VaultUsercase) GrantPermision(ctx context.Context, req pb.AuthGrantReq
 		return pb.ErrorInputArgError("input cluster name or user name is wrong format.")
 	}
 
-	if err := uc.secretIsExist(ctx, *secret); err != nil {
+	policyName, err := uc.secretIsExist(ctx, secret)
+	if err != nil {
 		return pb.ErrorResourceNotFound("secret %s is broken, you may need to recreate it: %s", secret.FullPath, err)
 	}
+	secret.PolicyName = policyName
 
 	// Get info from role
 	roleCFG, err := uc.client.Read(ctx, role.RolePath)
@@ -148,6 +174,11 @@ func (uc *VaultUsercase) GrantPermision(ctx context.Context, req pb.AuthGrantReq
 		return pb.ErrorResourceNotFound("get %s role info failed: %s", role.Name, err)
 	}
 
+	if plan := dryrun.FromContext(ctx); plan != nil {
+		planRolePolicy(plan, secret, role.RolePath, roleCFG, true)
+		return nil
+	}
+
 	// Append new policy and update role
 	policyList := roleCFG.Data["token_policies"].([]interface{})
 	for _, policy := range policyList {
@@ -183,12 +214,22 @@ func (uc *VaultUsercase) RevokePermision(ctx context.Context, req pb.AuthGrantRe
 		return nil
 	}
 
+	if plan := dryrun.FromContext(ctx); plan != nil {
+		planRolePolicy(plan, secret, role.RolePath, roleCFG, false)
+		return nil
+	}
+
+	// The legacy policy of the secret which is not migrated is removed too
+	removePolicies, err := uc.policyNamesOf(ctx, secret)
+	if err != nil {
+		return pb.ErrorInternalServiceError("get policies of %s failed: %s", secret.FullPath, err)
+	}
+
 	// Loop role policy list, remove specify policy and then update role
 	var newPolicyList []interface{}
 	policyList := roleCFG.Data["token_policies"].([]interface{})
-	removePolicy := secret.PolicyName
 	for _, v := range policyList {
-		if v != removePolicy {
+		if policyName, _ := v.(string); !containsString(removePolicies, policyName) {
 			newPolicyList = append(newPolicyList, v)
 		}
 	}
@@ -202,18 +243,141 @@ func (uc *VaultUsercase) RevokePermision(ctx context.Context, req pb.AuthGrantRe
 	return nil
 }
 
-func (uc *VaultUsercase) secretIsExist(ctx context.Context, secReq pb.SecretRequest) error {
-	policyData, err := uc.client.GetPolicy(ctx, secReq.PolicyName)
+// secretIsExist checks the secret and its policy exist, it returns the name of the policy.
+// The legacy policy is returned if the secret is not migrated yet.
+func (uc *VaultUsercase) secretIsExist(ctx context.Context, secReq *pb.SecretRequest) (string, error) {
+	policyName := secReq.PolicyName
+	policyData, err := uc.client.GetPolicy(ctx, policyName)
 	if err != nil {
-		return fmt.Errorf("get policy for secret %s failed. %s", secReq.FullPath, err)
+		return "", fmt.Errorf("get policy for secret %s failed. %s", secReq.FullPath, err)
 	}
 	if policyData == "" {
-		return fmt.Errorf("policy for secret %s is empty", secReq.FullPath)
+		policyNames, err := uc.policyNamesOf(ctx, secReq)
+		if err != nil {
+			return "", fmt.Errorf("get policy for secret %s failed. %s", secReq.FullPath, err)
+		}
+		if len(policyNames) == 1 {
+			return "", fmt.Errorf("policy for secret %s is empty", secReq.FullPath)
+		}
+		policyName = policyNames[1]
+	}
+
+	kv, err := uc.client.GetSecret(ctx, secReq.SecretName, secReq.SecretPath)
+	if err != nil {
+		return "", fmt.Errorf("get secret %s failed. %s", secReq.FullPath, err)
+	}
+	// The data of a soft deleted version is empty
+	if kv.Data == nil {
+		return "", fmt.Errorf("secret %s is deleted", secReq.FullPath)
 	}
+	return policyName, nil
+}
 
-	_, err = uc.client.GetSecret(ctx, secReq.SecretType, secReq.SecretPath)
+// walkRoles visits the roles of all kubernetes auths, the role path is like "auth/<cluster>/role/<name>".
+func (uc *VaultUsercase) walkRoles(ctx context.Context, visit func(rolePath string, roleCFG *vault.Secret) error) error {
+	auths, err := uc.client.Read(ctx, "sys/auth")
 	if err != nil {
-		return fmt.Errorf("get secret %s failed. %s", secReq.FullPath, err)
+		return fmt.Errorf("list auths failed: %w", err)
+	}
+	if auths == nil {
+		return nil
+	}
+
+	authPaths := make([]string, 0, len(auths.Data))
+	for authPath, mount := range auths.Data {
+		mountInfo, ok := mount.(map[string]interface{})
+		if ok && mountInfo["type"] == "kubernetes" {
+			authPaths = append(authPaths, authPath)
+		}
+	}
+	sort.Strings(authPaths)
+
+	for _, authPath := range authPaths {
+		rolesPath := fmt.Sprintf("auth/%srole", authPath)
+		roles, err := uc.listKeys(ctx, rolesPath)
+		if err != nil {
+			return fmt.Errorf("list roles in %s failed: %w", authPath, err)
+		}
+		for _, roleName := range roles {
+			rolePath := fmt.Sprintf("%s/%s", rolesPath, roleName)
+			roleCFG, err := uc.client.Read(ctx, rolePath)
+			if err != nil {
+				return fmt.Errorf("get role %s failed: %w", rolePath, err)
+			}
+			if roleCFG == nil {
+				continue
+			}
+			if err := visit(rolePath, roleCFG); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
+
+// rolesWithPolicy returns the paths of the roles of all kubernetes auths which have any of the policies.
+func (uc *VaultUsercase) rolesWithPolicy(ctx context.Context, policyNames ...string) ([]string, error) {
+	var rolePaths []string
+	err := uc.walkRoles(ctx, func(rolePath string, roleCFG *vault.Secret) error {
+		for _, policyName := range policyNames {
+			if hasPolicy(roleCFG, policyName) {
+				rolePaths = append(rolePaths, rolePath)
+				break
+			}
+		}
+		return nil
+	})
+	return rolePaths, err
+}
+
+// revokePolicyFromRoles removes the policies from the roles of all kubernetes auths,
+// it returns the paths of the roles which had any of the policies.
+func (uc *VaultUsercase) revokePolicyFromRoles(ctx context.Context, policyNames ...string) ([]string, error) {
+	policyName := strings.Join(policyNames, ",")
+	var revokedRoles []string
+	err := uc.walkRoles(ctx, func(rolePath string, roleCFG *vault.Secret) error {
+		policyList, _ := roleCFG.Data["token_policies"].([]interface{})
+		var newPolicyList []interface{}
+		for _, policy := range policyList {
+			if name, _ := policy.(string); !containsString(policyNames, name) {
+				newPolicyList = append(newPolicyList, policy)
+			}
+		}
+		if len(newPolicyList) == len(policyList) {
+			return nil
+		}
+
+		roleCFG.Data["token_policies"] = newPolicyList
+		if _, err := uc.client.Write(ctx, rolePath, roleCFG.Data); err != nil {
+			return fmt.Errorf("revoke policy %s from %s failed: %w", policyName, rolePath, err)
+		}
+		uc.log.WithContext(ctx).Infof("policy %s is revoked from %s", policyName, rolePath)
+		revokedRoles = append(revokedRoles, rolePath)
+		return nil
+	})
+	return revokedRoles, err
+}
+
+// grantPolicyToRole appends the policy to the role, it returns false if the role does not exist.
+func (uc *VaultUsercase) grantPolicyToRole(ctx context.Context, rolePath, policyName string) (bool, error) {
+	roleCFG, err := uc.client.Read(ctx, rolePath)
+	if err != nil {
+		return false, err
+	}
+	if roleCFG == nil {
+		return false, nil
+	}
+
+	policyList, _ := roleCFG.Data["token_policies"].([]interface{})
+	for _, policy := range policyList {
+		if policy == policyName {
+			return true, nil
+		}
+	}
+	roleCFG.Data["token_policies"] = append(policyList, policyName)
+	if _, err := uc.client.Write(ctx, rolePath, roleCFG.Data); err != nil {
+		return false, err
+	}
+	uc.log.WithContext(ctx).Infof("policy %s is granted to %s", policyName, rolePath)
+	return true, nil
+}
@@ -48,15 +48,43 @@ type VaultUsercase struct {
 	client     vpData.VaultClientInterface
 	tmpl       template.Template
 	casbinFile string
-	log        *log.Helper
+	journal    *journal
+	// Policy of the generated passwords, the default policy is used if it is nil
+	passwordPolicy *conf.Server_PasswordPolicy
+	// Registry hosts of the repo providers, the key is the provider id
+	registries map[string]string
+	// Nautes tenant recorded in the provenance of the secrets
+	tenant string
+	// Only the creator and the co-owners can change the secret if it is true
+	ownerOnly bool
+	// Common names which can change the secrets without a recorded creator
+	ownerAdmins []string
+	// Rotation periods of the secret types, secrets older than the period are stale
+	rotation *conf.Server_Rotation
+	log      *log.Helper
 }
 
-func NewVaultUsercase(client vpData.VaultClientInterface, cfg *conf.Server, logger log.Logger) *VaultUsercase {
+func NewVaultUsercase(client vpData.VaultClientInterface, cfg *conf.Server, data *conf.Data, logger log.Logger) *VaultUsercase {
 	return &VaultUsercase{
-		client:     client,
-		tmpl:       NewTemplateList(),
-		casbinFile: cfg.Authorization.Resource.Acl,
-		log:        log.NewHelper(logger)}
+		client:         client,
+		tmpl:           NewTemplateList(),
+		casbinFile:     cfg.Authorization.Resource.Acl,
+		journal:        newJournal(client, data),
+		passwordPolicy: cfg.PasswordPolicy,
+		registries:     cfg.Registries,
+		tenant:         getTenant(cfg.Nautes),
+		ownerOnly:      cfg.OwnerOnly,
+		ownerAdmins:    cfg.OwnerAdmins,
+		rotation:       cfg.Rotation,
+		log:            log.NewHelper(logger)}
+}
+
+// getTenant returns the first tenant name in the config as the tenant vault proxy serves.
+func getTenant(nautes *conf.Nautes) string {
+	if len(nautes.GetTenantName()) == 0 {
+		return ""
+	}
+	return nautes.GetTenantName()[0]
 }
 
 func NewTemplateList() template.Template {
@@ -0,0 +1,283 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaultproxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	pb "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	vault "github.com/hashicorp/vault/api"
+)
+
+// BatchResult is the result of a secret in the batch request.
+type BatchResult struct {
+	Secret *SecretData
+	// Paths of the roles the policy is revoked from by the delete
+	RevokedRoles []string
+	Err          error
+	// The secret is written and then rolled back, because another secret in the atomic batch failed
+	RolledBack bool
+}
+
+// batchRollback undoes the change of the item at index.
+type batchRollback struct {
+	index int
+	run   func() error
+}
+
+// secretState is the state of a secret before it is changed by the batch, it is used to roll back the change.
+type secretState struct {
+	// Current version of the secret, 0 means the secret does not exist
+	version int
+	deleted bool
+	// Custom metadata of the secret, such as the provenance and the expire time
+	customMetadata map[string]interface{}
+	// Data of the policy of the secret, empty if the policy does not exist
+	policyData string
+}
+
+// BatchCreateSecrets creates the secrets in order.
+// In atomic mode, all the secrets are checked before any of them is written. If one of them fails,
+// the written secrets are rolled back in reverse order and the error of the failed secret is returned.
+// Otherwise each secret is created on its own, the errors are only in the results.
+func (uc *VaultUsercase) BatchCreateSecrets(ctx context.Context, reqs []pb.SecRequest, atomic bool) ([]BatchResult, error) {
+	results := make([]BatchResult, len(reqs))
+	secrets := make([]*pb.SecretRequest, len(reqs))
+	for i, req := range reqs {
+		if results[i].Err = checkBatchCreateOptions(req); results[i].Err == nil {
+			secrets[i], results[i].Err = uc.checkSecretToCreate(ctx, req)
+		}
+		if atomic && results[i].Err != nil {
+			return results, batchItemError(i, results[i].Err)
+		}
+	}
+
+	var rollbacks []batchRollback
+	for i, secret := range secrets {
+		if results[i].Err != nil {
+			continue
+		}
+		secret := secret
+		state, err := uc.getSecretState(ctx, secret)
+		if err == nil {
+			results[i].Secret, err = uc.createSecret(ctx, secret)
+		}
+		if err != nil {
+			results[i].Err = err
+			if atomic {
+				return results, uc.rollbackBatch(ctx, results, rollbacks, i)
+			}
+			continue
+		}
+		rollbacks = append(rollbacks, batchRollback{index: i, run: func() error {
+			return uc.rollbackCreate(ctx, secret, state)
+		}})
+	}
+	return results, nil
+}
+
+// checkBatchCreateOptions rejects the options which need the type-specific create, such as the generated deploy key.
+// The batch stores the data in the request as it is, and its reply can not return the generated values.
+func checkBatchCreateOptions(req pb.SecRequest) error {
+	switch r := req.(type) {
+	case *pb.GitRequest:
+		if r.GetGenerateDeployKey() {
+			return pb.ErrorInputArgError("generate deploy key is not supported by batch create")
+		}
+	case repoAccountRequest:
+		if r.GetAccount().GetGeneratePassword() {
+			return pb.ErrorInputArgError("generate password is not supported by batch create")
+		}
+	case *pb.ClusterRequest:
+		if r.GetAccount().GetStripContexts() {
+			return pb.ErrorInputArgError("strip contexts is not supported by batch create")
+		}
+	}
+	return nil
+}
+
+// BatchDeleteSecrets soft deletes the secrets in order, as BatchCreateSecrets does.
+// In atomic mode, the deleted secrets are undeleted and their policies are granted back if one of them fails.
+func (uc *VaultUsercase) BatchDeleteSecrets(ctx context.Context, reqs []pb.SecRequest, atomic bool) ([]BatchResult, error) {
+	results := make([]BatchResult, len(reqs))
+	secrets := make([]*pb.SecretRequest, len(reqs))
+	for i, req := range reqs {
+		secrets[i], results[i].Err = uc.checkSecretToDelete(ctx, req)
+		if atomic && results[i].Err != nil {
+			return results, batchItemError(i, results[i].Err)
+		}
+	}
+
+	var rollbacks []batchRollback
+	for i, secret := range secrets {
+		if results[i].Err != nil {
+			continue
+		}
+		secret := secret
+		state, err := uc.getSecretState(ctx, secret)
+		if err == nil {
+			results[i].RevokedRoles, err = uc.softDeleteSecret(ctx, secret)
+		}
+		if err != nil {
+			results[i].Err = err
+			if atomic {
+				return results, uc.rollbackBatch(ctx, results, rollbacks, i)
+			}
+			continue
+		}
+		results[i].Secret = &SecretData{
+			SecretName:    secret.SecretName,
+			SecretPath:    secret.SecretPath,
+			SecretVersion: state.version,
+		}
+		revokedRoles := results[i].RevokedRoles
+		rollbacks = append(rollbacks, batchRollback{index: i, run: func() error {
+			return uc.rollbackDelete(ctx, secret, state, revokedRoles)
+		}})
+	}
+	return results, nil
+}
+
+func (uc *VaultUsercase) checkSecretToDelete(ctx context.Context, req pb.SecRequest) (*pb.SecretRequest, error) {
+	secret, err := req.ConvertRequest()
+	if err != nil {
+		return nil, pb.ErrorInputArgError("convert to secret failed: %s", err)
+	}
+	if !verifySecret(secret) {
+		return nil, errorSecretVerifyFailed
+	}
+	if err := uc.checkOwner(ctx, secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+func (uc *VaultUsercase) getSecretState(ctx context.Context, secret *pb.SecretRequest) (secretState, error) {
+	metadata, err := uc.client.GetSecretMetadata(ctx, secret.SecretName, secret.SecretPath)
+	if errors.Is(err, vault.ErrSecretNotFound) {
+		return secretState{}, nil
+	} else if err != nil {
+		return secretState{}, pb.ErrorInternalServiceError("get metadata of secret %s in %s failed: %s", secret.SecretPath, secret.SecretName, err)
+	}
+	policyData, err := uc.client.GetPolicy(ctx, secret.PolicyName)
+	if err != nil {
+		return secretState{}, pb.ErrorInternalServiceError("get policy of secret %s in %s failed: %s", secret.SecretPath, secret.SecretName, err)
+	}
+	latest := metadata.Versions[strconv.Itoa(metadata.CurrentVersion)]
+	return secretState{
+		version:        metadata.CurrentVersion,
+		deleted:        latest.Destroyed || !latest.DeletionTime.IsZero(),
+		customMetadata: metadata.CustomMetadata,
+		policyData:     policyData,
+	}, nil
+}
+
+// rollbackBatch runs the rollbacks of the written secrets in reverse order.
+// It returns the error of the failed secret, or an internal error if any of the rollbacks fails.
+func (uc *VaultUsercase) rollbackBatch(ctx context.Context, results []BatchResult, rollbacks []batchRollback, failed int) error {
+	var rollbackFailed []int
+	for i := len(rollbacks) - 1; i >= 0; i-- {
+		index := rollbacks[i].index
+		if err := rollbacks[i].run(); err != nil {
+			uc.log.WithContext(ctx).Errorf("roll back item %d of batch failed: %s", index, err)
+			results[index].Err = err
+			rollbackFailed = append(rollbackFailed, index)
+			continue
+		}
+		results[index].RolledBack = true
+	}
+
+	if len(rollbackFailed) != 0 {
+		return pb.ErrorInternalServiceError("item %d failed: %s, roll back items %v failed",
+			failed, kerrors.FromError(results[failed].Err).Message, rollbackFailed)
+	}
+	return batchItemError(failed, results[failed].Err)
+}
+
+// rollbackCreate restores the secret to the state before it is created by the batch.
+// The data, the custom metadata and the policy of an overwritten secret are restored.
+func (uc *VaultUsercase) rollbackCreate(ctx context.Context, secret *pb.SecretRequest, state secretState) error {
+	if state.version == 0 {
+		_, err := uc.purgeSecret(ctx, secret)
+		return err
+	}
+
+	var err error
+	if state.deleted {
+		err = uc.client.SoftDeleteSecret(ctx, secret.SecretName, secret.SecretPath)
+	} else {
+		_, err = uc.client.RollBackSecret(ctx, secret.SecretName, secret.SecretPath, state.version)
+	}
+	if err != nil {
+		return err
+	}
+	if err := uc.restoreCustomMetadata(ctx, secret, state.customMetadata); err != nil {
+		return fmt.Errorf("restore metadata of secret %s in %s failed: %w", secret.SecretPath, secret.SecretName, err)
+	}
+	if state.policyData == "" {
+		err = uc.client.DeletePolicy(ctx, secret.PolicyName)
+	} else {
+		err = uc.client.CreatePolicy(ctx, secret.PolicyName, state.policyData)
+	}
+	if err != nil {
+		return fmt.Errorf("restore policy %s failed: %w", secret.PolicyName, err)
+	}
+	return nil
+}
+
+// restoreCustomMetadata sets the custom metadata of the secret back to the snapshot, the keys added after it are removed.
+func (uc *VaultUsercase) restoreCustomMetadata(ctx context.Context, secret *pb.SecretRequest, snapshot map[string]interface{}) error {
+	metadata, err := uc.client.GetSecretMetadata(ctx, secret.SecretName, secret.SecretPath)
+	if err != nil {
+		return err
+	}
+	customMetadata := map[string]interface{}{}
+	for key := range metadata.CustomMetadata {
+		customMetadata[key] = nil
+	}
+	for key, value := range snapshot {
+		customMetadata[key] = value
+	}
+	if len(customMetadata) == 0 {
+		return nil
+	}
+	return uc.client.PatchSecretMetadata(ctx, secret.SecretName, secret.SecretPath, customMetadata)
+}
+
+// rollbackDelete undeletes the secret deleted by the batch, and grants the policy back to the roles it is revoked from.
+func (uc *VaultUsercase) rollbackDelete(ctx context.Context, secret *pb.SecretRequest, state secretState, revokedRoles []string) error {
+	if state.version != 0 && !state.deleted {
+		_, _, err := uc.undeleteSecret(ctx, secret)
+		return err
+	}
+	for _, rolePath := range revokedRoles {
+		if _, err := uc.grantPolicyToRole(ctx, rolePath, secret.PolicyName); err != nil {
+			return fmt.Errorf("grant policy %s to %s failed: %w", secret.PolicyName, rolePath, err)
+		}
+	}
+	return nil
+}
+
+// batchItemError adds the index of the item to the message of the error, the reason and the code are kept.
+func batchItemError(index int, err error) error {
+	e := kerrors.Clone(kerrors.FromError(err))
+	e.Message = fmt.Sprintf("item %d: %s", index, e.Message)
+	return e
+}
@@ -0,0 +1,130 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaultproxy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// Keys of the custom metadata of the cluster secrets.
+const (
+	// Server url of the current context
+	clusterServerMetadataKey = "server"
+	// The earliest expiry of the certs embedded in the current context, in RFC3339
+	clusterExpiryMetadataKey = "expiry"
+)
+
+// CreateCluster stores the kubeconfig of a cluster in vault, the contexts other than the current one are stripped if requested.
+// The kubeconfig is verified and its server url and expiry are recorded when the secret is created, as other cluster secrets.
+func (uc *VaultUsercase) CreateCluster(ctx context.Context, req *pb.ClusterRequest) (*SecretData, error) {
+	account := req.GetAccount()
+	if account.GetStripContexts() {
+		info, err := verifyKubeconfig(account.GetKubeconfig(), time.Now())
+		if err != nil {
+			return nil, pb.ErrorInputArgError("verify kubeconfig failed: %s", err)
+		}
+		stripped, err := stripKubeconfig(account.GetKubeconfig(), info)
+		if err != nil {
+			return nil, pb.ErrorInputArgError("strip kubeconfig failed: %s", err)
+		}
+		req = proto.Clone(req).(*pb.ClusterRequest)
+		req.Account.Kubeconfig = stripped
+	}
+	return uc.CreateSecret(ctx, req)
+}
+
+// verifyClusterSecret verifies the kubeconfig of a cluster secret, other secret types are not checked.
+func verifyClusterSecret(secret *pb.SecretRequest) error {
+	if secret.SecretType != pb.CLUSTER.String() {
+		return nil
+	}
+	if _, err := verifyKubeconfig(clusterKubeconfig(secret), time.Now()); err != nil {
+		return pb.ErrorInputArgError("verify kubeconfig failed: %s", err)
+	}
+	return nil
+}
+
+// clusterMetadata returns the server url and the expiry of the current context of a cluster secret to record in the metadata,
+// it is nil if the secret is not a cluster or its data is not in the request.
+func clusterMetadata(secret *pb.SecretRequest) map[string]interface{} {
+	if secret.SecretType != pb.CLUSTER.String() {
+		return nil
+	}
+	info, err := verifyKubeconfig(clusterKubeconfig(secret), time.Now())
+	if err != nil {
+		return nil
+	}
+	metadata := map[string]interface{}{
+		clusterServerMetadataKey: info.Server,
+		clusterExpiryMetadataKey: nil,
+	}
+	if !info.Expiry.IsZero() {
+		metadata[clusterExpiryMetadataKey] = info.Expiry.UTC().Format(time.RFC3339)
+	}
+	return metadata
+}
+
+func clusterKubeconfig(secret *pb.SecretRequest) string {
+	raw, _ := secret.SecretData["kubeconfig"].(string)
+	return raw
+}
+
+// verifyKubeconfig checks the kubeconfig by its schema, and the current context of it must be set.
+func verifyKubeconfig(raw string, now time.Time) (*pb.KubeconfigInfo, error) {
+	info, err := pb.ValidateKubeconfig(raw, now)
+	if err != nil {
+		return nil, err
+	}
+	if info.Context == "" {
+		return nil, fmt.Errorf("current context is not set")
+	}
+	return info, nil
+}
+
+// stripKubeconfig removes the contexts except the current one, and the clusters and users not used by it.
+// Other fields of the kubeconfig are kept.
+func stripKubeconfig(raw string, info *pb.KubeconfigInfo) (string, error) {
+	config := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(raw), &config); err != nil {
+		return "", err
+	}
+	config["contexts"] = filterNamedItems(config["contexts"], info.Context)
+	config["clusters"] = filterNamedItems(config["clusters"], info.Cluster)
+	config["users"] = filterNamedItems(config["users"], info.User)
+
+	out, err := yaml.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+func filterNamedItems(items interface{}, name string) []interface{} {
+	list, _ := items.([]interface{})
+	var kept []interface{}
+	for _, item := range list {
+		if m, ok := item.(map[string]interface{}); ok && m["name"] == name {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
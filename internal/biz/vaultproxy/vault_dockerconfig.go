@@ -0,0 +1,156 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaultproxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	pb "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+
+	vault "github.com/hashicorp/vault/api"
+	"gopkg.in/yaml.v3"
+)
+
+const dockerConfigSecretType = "kubernetes.io/dockerconfigjson"
+
+// kubernetesNameRegex matches the DNS-1123 subdomain, which is the name of a kubernetes secret.
+var kubernetesNameRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`)
+
+type dockerConfigAuth struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password"`
+	Auth     string `json:"auth"`
+}
+
+type dockerConfig struct {
+	Auths map[string]dockerConfigAuth `json:"auths"`
+}
+
+type kubernetesSecretMetadata struct {
+	Name      string `yaml:"name"`
+	Namespace string `yaml:"namespace,omitempty"`
+}
+
+type kubernetesSecret struct {
+	APIVersion string                   `yaml:"apiVersion"`
+	Kind       string                   `yaml:"kind"`
+	Metadata   kubernetesSecretMetadata `yaml:"metadata"`
+	Type       string                   `yaml:"type"`
+	Data       map[string]string        `yaml:"data"`
+}
+
+// RenderDockerConfig reads the repo account and renders it as a kubernetes secret of type kubernetes.io/dockerconfigjson,
+// the registry host is the one configured for the provider of the repo.
+// The password of the password auth type or the token of the token auth type is used as the password,
+// the username is required for the password auth type, and is left empty for a token without it.
+func (uc *VaultUsercase) RenderDockerConfig(ctx context.Context, req pb.DockerConfigRequest) (string, error) {
+	secret, err := req.ConvertRequest()
+	if err != nil {
+		return "", pb.ErrorInputArgError("convert to secret failed: %s", err)
+	}
+	if !verifySecret(secret) {
+		return "", errorSecretVerifyFailed
+	}
+	registry, ok := uc.registries[req.GetProvider()]
+	if !ok {
+		return "", pb.ErrorInputArgError("registry of provider %s is not configured", req.GetProvider())
+	}
+
+	kv, err := uc.client.GetSecret(ctx, secret.SecretName, secret.SecretPath)
+	if errors.Is(err, vault.ErrSecretNotFound) {
+		return "", pb.ErrorResourceNotFound("can not find secret %s in %s", secret.SecretPath, secret.SecretName)
+	} else if err != nil {
+		return "", pb.ErrorInternalServiceError("get secret %s in %s failed: %s", secret.SecretPath, secret.SecretName, err)
+	}
+	// The data of a soft deleted version is empty
+	if kv.Data == nil {
+		return "", pb.ErrorResourceNotFound("secret %s in %s is deleted", secret.SecretPath, secret.SecretName)
+	}
+
+	username, _ := kv.Data["username"].(string)
+	var password string
+	switch authType, _ := kv.Data[pb.AuthTypeKey].(string); authType {
+	case pb.AuthTypePassword:
+		password, _ = kv.Data["password"].(string)
+		if username == "" || password == "" {
+			return "", pb.ErrorInputArgError("username and password of secret %s are required to render docker config", secret.SecretPath)
+		}
+	case pb.AuthTypeToken:
+		password, _ = kv.Data["token"].(string)
+		if password == "" {
+			return "", pb.ErrorInputArgError("token of secret %s is required to render docker config", secret.SecretPath)
+		}
+	default:
+		return "", pb.ErrorInputArgError("auth type %s of secret %s can not be rendered", authType, secret.SecretPath)
+	}
+
+	name := req.GetName()
+	if name == "" {
+		name = strings.ToLower(strings.ReplaceAll(secret.SecretPath, "/", "-"))
+	}
+	if len(name) > 253 || !kubernetesNameRegex.MatchString(name) {
+		return "", pb.ErrorInputArgError("name %s of the docker config is not a valid kubernetes secret name", name)
+	}
+	manifest, err := renderDockerConfigSecret(name, req.GetNamespace(), registry, username, password)
+	if err != nil {
+		return "", pb.ErrorInternalServiceError("render docker config of secret %s failed: %s", secret.SecretPath, err)
+	}
+	return manifest, nil
+}
+
+func renderDockerConfigSecret(name, namespace, registry, username, password string) (string, error) {
+	config, err := json.Marshal(dockerConfig{
+		Auths: map[string]dockerConfigAuth{
+			registry: {
+				Username: username,
+				Password: password,
+				Auth:     base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", username, password))),
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var manifest bytes.Buffer
+	encoder := yaml.NewEncoder(&manifest)
+	encoder.SetIndent(2)
+	err = encoder.Encode(kubernetesSecret{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Metadata: kubernetesSecretMetadata{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Type: dockerConfigSecretType,
+		Data: map[string]string{
+			".dockerconfigjson": base64.StdEncoding.EncodeToString(config),
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+	return manifest.String(), nil
+}
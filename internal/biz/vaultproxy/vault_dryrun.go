@@ -0,0 +1,98 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaultproxy
+
+import (
+	"context"
+
+	pb "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+
+	vault "github.com/hashicorp/vault/api"
+)
+
+// planCreateSecret records the secret and the policy which would be written by the create.
+// The returned version is the version the secret would have.
+func (uc *VaultUsercase) planCreateSecret(ctx context.Context, plan *pb.DryRunPlan, secret *pb.SecretRequest) (*SecretData, error) {
+	version, err := uc.currentVersion(ctx, secret.SecretName, secret.SecretPath)
+	if err != nil {
+		return nil, pb.ErrorInternalServiceError("get metadata of secret %s in %s failed: %s", secret.SecretPath, secret.SecretName, err)
+	}
+	if secret.CheckAndSet != nil && *secret.CheckAndSet != version {
+		return nil, pb.ErrorVersionConflict("secret %s in %s is not at version %d", secret.SecretPath, secret.SecretName, *secret.CheckAndSet)
+	}
+
+	plan.SecretName = secret.SecretName
+	plan.SecretPath = secret.SecretPath
+	plan.PolicyName = secret.PolicyName
+	plan.PolicyData = secret.PolicyData
+	return &SecretData{
+		SecretName:    secret.SecretName,
+		SecretPath:    secret.SecretPath,
+		SecretVersion: version + 1,
+	}, nil
+}
+
+// planDeleteSecret records the roles which the policy would be revoked from by the delete, it returns the paths of the roles.
+func (uc *VaultUsercase) planDeleteSecret(ctx context.Context, plan *pb.DryRunPlan, secret *pb.SecretRequest) ([]string, error) {
+	plan.SecretName = secret.SecretName
+	plan.SecretPath = secret.SecretPath
+	plan.PolicyName = secret.PolicyName
+
+	var revokedRoles []string
+	err := uc.walkRoles(ctx, func(rolePath string, roleCFG *vault.Secret) error {
+		if !hasPolicy(roleCFG, secret.PolicyName) {
+			return nil
+		}
+		plan.Roles = append(plan.Roles, &pb.RoleDiff{
+			RolePath:        rolePath,
+			RemovedPolicies: []string{secret.PolicyName},
+		})
+		revokedRoles = append(revokedRoles, rolePath)
+		return nil
+	})
+	if err != nil {
+		return nil, pb.ErrorInternalServiceError("revoke policy of %s in %s from roles failed: %s", secret.SecretPath, secret.SecretName, err)
+	}
+	return revokedRoles, nil
+}
+
+// planRolePolicy records the policy which would be granted to or revoked from the role.
+func planRolePolicy(plan *pb.DryRunPlan, secret *pb.SecretRequest, rolePath string, roleCFG *vault.Secret, grant bool) {
+	plan.SecretName = secret.SecretName
+	plan.SecretPath = secret.SecretPath
+	plan.PolicyName = secret.PolicyName
+
+	diff := &pb.RoleDiff{RolePath: rolePath}
+	switch granted := hasPolicy(roleCFG, secret.PolicyName); {
+	case grant && !granted:
+		diff.AddedPolicies = []string{secret.PolicyName}
+	case !grant && granted:
+		diff.RemovedPolicies = []string{secret.PolicyName}
+	}
+	plan.Roles = append(plan.Roles, diff)
+}
+
+func hasPolicy(roleCFG *vault.Secret, policyName string) bool {
+	if roleCFG == nil {
+		return false
+	}
+	policyList, _ := roleCFG.Data["token_policies"].([]interface{})
+	for _, policy := range policyList {
+		if policy == policyName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,161 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaultproxy
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	pb "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+	"github.com/nautes-labs/vault-proxy/internal/pkg/dryrun"
+
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/protobuf/proto"
+)
+
+// Types of the generated deploy keys.
+const (
+	DeployKeyTypeEd25519 = "ed25519"
+	DeployKeyTypeRSA     = "rsa"
+
+	rsaDeployKeyBits = 4096
+)
+
+// CreateGit stores the git secret in vault, if the request asks for a deploy key,
+// a key pair is generated and the private key is stored as the deploy key.
+// It returns the public key of the generated key in authorized keys format.
+func (uc *VaultUsercase) CreateGit(ctx context.Context, req *pb.GitRequest) (*SecretData, string, error) {
+	if !req.GetGenerateDeployKey() {
+		secretData, err := uc.CreateSecret(ctx, req)
+		return secretData, "", err
+	}
+	if req.GetKvs().GetDeployKey() != "" {
+		return nil, "", pb.ErrorInputArgError("deploy key can not be set when generate deploy key")
+	}
+
+	privateKey, publicKey, err := generateDeployKey(req.GetDeployKeyType())
+	if err != nil {
+		return nil, "", pb.ErrorInternalServiceError("generate deploy key failed: %s", err)
+	}
+	req = proto.Clone(req).(*pb.GitRequest)
+	if req.Kvs == nil {
+		req.Kvs = &pb.GitKVs{}
+	}
+	req.Kvs.DeployKey = privateKey
+
+	secretData, err := uc.CreateSecret(ctx, req)
+	if err != nil {
+		return nil, "", err
+	}
+	// Nothing is stored in dry run, the generated key is never used and is not returned
+	if dryrun.FromContext(ctx) != nil {
+		return secretData, "", nil
+	}
+	return secretData, publicKey, nil
+}
+
+// generateDeployKey returns the private key in pem and the public key in authorized keys format.
+func generateDeployKey(keyType string) (privateKey, publicKey string, err error) {
+	var signer interface{}
+	var block *pem.Block
+	switch keyType {
+	case "", DeployKeyTypeEd25519:
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return "", "", err
+		}
+		signer = pub
+		block, err = marshalED25519PrivateKey(pub, priv)
+		if err != nil {
+			return "", "", err
+		}
+	case DeployKeyTypeRSA:
+		priv, err := rsa.GenerateKey(rand.Reader, rsaDeployKeyBits)
+		if err != nil {
+			return "", "", err
+		}
+		signer = &priv.PublicKey
+		block = &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(priv)}
+	default:
+		return "", "", fmt.Errorf("deploy key type %s is not supported", keyType)
+	}
+
+	sshPublicKey, err := ssh.NewPublicKey(signer)
+	if err != nil {
+		return "", "", err
+	}
+	return string(pem.EncodeToMemory(block)), strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPublicKey))), nil
+}
+
+// marshalED25519PrivateKey encodes the key in the unencrypted openssh private key format,
+// ssh clients do not accept ed25519 keys in pkcs8.
+func marshalED25519PrivateKey(pub ed25519.PublicKey, priv ed25519.PrivateKey) (*pem.Block, error) {
+	sshPublicKey, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		return nil, err
+	}
+
+	var check [4]byte
+	if _, err := rand.Read(check[:]); err != nil {
+		return nil, err
+	}
+	checkInt := binary.BigEndian.Uint32(check[:])
+	key := struct {
+		Check1  uint32
+		Check2  uint32
+		Keytype string
+		Pub     []byte
+		Priv    []byte
+		Comment string
+		Pad     []byte `ssh:"rest"`
+	}{
+		Check1:  checkInt,
+		Check2:  checkInt,
+		Keytype: ssh.KeyAlgoED25519,
+		Pub:     pub,
+		Priv:    priv,
+	}
+	// The private key block is padded to the cipher block size 8
+	padLen := (8 - len(ssh.Marshal(key))%8) % 8
+	for i := 1; i <= padLen; i++ {
+		key.Pad = append(key.Pad, byte(i))
+	}
+
+	w := struct {
+		CipherName   string
+		KdfName      string
+		KdfOpts      string
+		NumKeys      uint32
+		PubKey       []byte
+		PrivKeyBlock []byte
+	}{
+		CipherName:   "none",
+		KdfName:      "none",
+		NumKeys:      1,
+		PubKey:       sshPublicKey.Marshal(),
+		PrivKeyBlock: ssh.Marshal(key),
+	}
+	return &pem.Block{
+		Type:  "OPENSSH PRIVATE KEY",
+		Bytes: append([]byte("openssh-key-v1\x00"), ssh.Marshal(w)...),
+	}, nil
+}
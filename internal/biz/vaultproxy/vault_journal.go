@@ -0,0 +1,344 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaultproxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	pb "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+	"github.com/nautes-labs/vault-proxy/internal/conf"
+	vpData "github.com/nautes-labs/vault-proxy/internal/data"
+
+	vault "github.com/hashicorp/vault/api"
+)
+
+// Steps of a secret write, the step is recorded before it starts.
+const (
+	// The secret is going to be written
+	stepWriteSecret = "secret"
+	// The secret is written, the policy is going to be written
+	stepWritePolicy = "policy"
+	// The policy write failed, the secret is going to be rolled back
+	stepRollback = "rollback"
+)
+
+type journalEntry struct {
+	ID         string `json:"id"`
+	Step       string `json:"step"`
+	SecretName string `json:"secret_name"`
+	SecretPath string `json:"secret_path"`
+	PolicyName string `json:"policy_name"`
+	PolicyData string `json:"policy_data"`
+	// The current version before the write, 0 means the secret did not exist
+	PreVersion int `json:"pre_version"`
+	// The version written by this operation, it is set after the secret is written
+	Version     int       `json:"version"`
+	CreatedTime time.Time `json:"created_time"`
+	// Replica which runs the operation, the entry is only recovered by others after the lease expires
+	Owner           string    `json:"owner"`
+	LeaseExpireTime time.Time `json:"lease_expire_time"`
+	// Version of the entry in the journal, it is used to claim the entry by check-and-set
+	revision int
+}
+
+// defaultJournalLease is how long an entry is owned by the replica which writes it, if the lease is not configured.
+const defaultJournalLease = 5 * time.Minute
+
+// journal records the intent of each step of the secret and policy writes in a kv v2 path,
+// so that the half-finished writes can be completed or undone when the process restarts.
+// A nil journal records nothing.
+type journal struct {
+	client     vpData.VaultClientInterface
+	secretName string
+	path       string
+	owner      string
+	lease      time.Duration
+}
+
+func newJournal(client vpData.VaultClientInterface, c *conf.Data) *journal {
+	if c == nil || c.Journal == nil || c.Journal.SecretName == "" {
+		return nil
+	}
+	owner, _ := os.Hostname()
+	lease := c.Journal.Lease.AsDuration()
+	if lease <= 0 {
+		lease = defaultJournalLease
+	}
+	return &journal{
+		client:     client,
+		secretName: c.Journal.SecretName,
+		path:       strings.Trim(c.Journal.Path, "/"),
+		owner:      owner,
+		lease:      lease,
+	}
+}
+
+func (j *journal) entryPath(id string) string {
+	if j.path == "" {
+		return id
+	}
+	return fmt.Sprintf("%s/%s", j.path, id)
+}
+
+func (j *journal) begin(ctx context.Context, secret *pb.SecretRequest, preVersion int) (*journalEntry, error) {
+	if j == nil {
+		return nil, nil
+	}
+	entry := &journalEntry{
+		ID:          fmt.Sprintf("%s-%d", secret.PolicyName, time.Now().UnixNano()),
+		Step:        stepWriteSecret,
+		SecretName:  secret.SecretName,
+		SecretPath:  secret.SecretPath,
+		PolicyName:  secret.PolicyName,
+		PolicyData:  secret.PolicyData,
+		PreVersion:  preVersion,
+		CreatedTime: time.Now(),
+	}
+	return entry, j.save(ctx, entry)
+}
+
+func (j *journal) save(ctx context.Context, entry *journalEntry) error {
+	if j == nil || entry == nil {
+		return nil
+	}
+	return j.write(ctx, entry)
+}
+
+// write renews the lease of the entry and writes it, the options are passed to the kv write.
+func (j *journal) write(ctx context.Context, entry *journalEntry, opts ...vault.KVOption) error {
+	entry.Owner = j.owner
+	entry.LeaseExpireTime = time.Now().Add(j.lease)
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	data := map[string]interface{}{}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return err
+	}
+	kv, err := j.client.CreateSecret(ctx, j.secretName, j.entryPath(entry.ID), data, opts...)
+	if err != nil {
+		return err
+	}
+	entry.revision = kv.VersionMetadata.Version
+	return nil
+}
+
+// claim takes the entry over from its owner, it returns false if the entry is changed or claimed by another replica.
+func (j *journal) claim(ctx context.Context, entry *journalEntry) (bool, error) {
+	err := j.write(ctx, entry, vault.WithCheckAndSet(entry.revision))
+	if errors.Is(err, vpData.ErrCheckAndSetMismatch) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// recoverable reports whether the entry can be recovered by this replica.
+// The entries of this replica are left by the previous run if it is starting, the entries of others are in progress until the lease expires.
+func (j *journal) recoverable(entry *journalEntry, starting bool) bool {
+	if starting && entry.Owner == j.owner {
+		return true
+	}
+	return time.Now().After(entry.LeaseExpireTime)
+}
+
+func (j *journal) step(ctx context.Context, entry *journalEntry, step string) error {
+	if j == nil || entry == nil {
+		return nil
+	}
+	entry.Step = step
+	return j.save(ctx, entry)
+}
+
+func (j *journal) finish(ctx context.Context, entry *journalEntry) error {
+	if j == nil || entry == nil {
+		return nil
+	}
+	return j.client.DeleteSecret(ctx, j.secretName, j.entryPath(entry.ID))
+}
+
+// list returns the entries in the journal, the entries which can not be read or parsed are skipped and kept,
+// their errors are returned with the other entries.
+func (j *journal) list(ctx context.Context) ([]*journalEntry, error) {
+	if j == nil {
+		return nil, nil
+	}
+	keys, err := j.client.ListSecret(ctx, j.secretName, j.path)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*journalEntry, 0, len(keys))
+	var errs []error
+	for _, key := range keys {
+		if strings.HasSuffix(key, "/") {
+			continue
+		}
+		kv, err := j.client.GetSecret(ctx, j.secretName, j.entryPath(key))
+		if errors.Is(err, vault.ErrSecretNotFound) {
+			continue
+		} else if err != nil {
+			errs = append(errs, fmt.Errorf("read journal %s failed: %w", key, err))
+			continue
+		}
+		raw, err := json.Marshal(kv.Data)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("journal %s is broken: %w", key, err))
+			continue
+		}
+		entry := &journalEntry{}
+		if err := json.Unmarshal(raw, entry); err != nil {
+			errs = append(errs, fmt.Errorf("journal %s is broken: %w", key, err))
+			continue
+		}
+		entry.revision = kv.VersionMetadata.Version
+		entries = append(entries, entry)
+	}
+	return entries, errors.Join(errs...)
+}
+
+// beginJournal records the intent to write the secret, it returns nil if the journal is disabled.
+func (uc *VaultUsercase) beginJournal(ctx context.Context, secret *pb.SecretRequest) (*journalEntry, error) {
+	if uc.journal == nil {
+		return nil, nil
+	}
+	preVersion, err := uc.currentVersion(ctx, secret.SecretName, secret.SecretPath)
+	if err != nil {
+		return nil, err
+	}
+	return uc.journal.begin(ctx, secret, preVersion)
+}
+
+// stepJournal records the next step, a failed record only logs,
+// the recovery of the previous step still leads to a consistent state.
+func (uc *VaultUsercase) stepJournal(ctx context.Context, entry *journalEntry, step string) {
+	if err := uc.journal.step(ctx, entry, step); err != nil {
+		uc.log.WithContext(ctx).Errorf("record step %s of journal %s failed: %s", step, entry.ID, err)
+	}
+}
+
+func (uc *VaultUsercase) finishJournal(ctx context.Context, entry *journalEntry) {
+	if err := uc.journal.finish(ctx, entry); err != nil {
+		uc.log.WithContext(ctx).Errorf("remove journal %s failed: %s", entry.ID, err)
+	}
+}
+
+// currentVersion returns the current version of the secret, 0 means it does not exist.
+func (uc *VaultUsercase) currentVersion(ctx context.Context, secretName, secretPath string) (int, error) {
+	metadata, err := uc.client.GetSecretMetadata(ctx, secretName, secretPath)
+	if errors.Is(err, vault.ErrSecretNotFound) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return metadata.CurrentVersion, nil
+}
+
+// RecoverJournal completes or undoes the secret writes left in the journal when vault proxy starts.
+// A written secret is completed by writing its policy, if the policy can not be written, the secret is rolled back.
+// The entries left by the previous run of this replica and the entries whose lease is expired are recovered,
+// the entries of other replicas are left to them. The entries which can not be recovered are logged and kept
+// for the next recovery, they never stop vault proxy from starting, so it always returns nil.
+func (uc *VaultUsercase) RecoverJournal(ctx context.Context) error {
+	if err := uc.recoverJournal(ctx, true); err != nil {
+		uc.log.WithContext(ctx).Errorf("recover journal failed, the entries are kept for the next recovery: %s", err)
+	}
+	return nil
+}
+
+// RecoverExpiredJournal recovers the entries whose lease is expired, the owner of them is gone.
+// It returns the errors of all the entries which can not be recovered.
+func (uc *VaultUsercase) RecoverExpiredJournal(ctx context.Context) error {
+	return uc.recoverJournal(ctx, false)
+}
+
+func (uc *VaultUsercase) recoverJournal(ctx context.Context, starting bool) error {
+	// The entries which can not be listed are skipped, the others are still recovered
+	entries, err := uc.journal.list(ctx)
+	var errs []error
+	if err != nil {
+		errs = append(errs, fmt.Errorf("list journal failed: %w", err))
+	}
+	for _, entry := range entries {
+		if !uc.journal.recoverable(entry, starting) {
+			uc.log.WithContext(ctx).Infof("journal %s is owned by %s until %s, skip it", entry.ID, entry.Owner, entry.LeaseExpireTime.Format(time.RFC3339))
+			continue
+		}
+		claimed, err := uc.journal.claim(ctx, entry)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("claim %s failed: %w", entry.ID, err))
+			continue
+		} else if !claimed {
+			uc.log.WithContext(ctx).Infof("journal %s is claimed by another replica, skip it", entry.ID)
+			continue
+		}
+
+		uc.log.WithContext(ctx).Infof("recover %s of secret %s in %s at step %s", entry.ID, entry.SecretPath, entry.SecretName, entry.Step)
+		if err := uc.recoverEntry(ctx, entry); err != nil {
+			errs = append(errs, fmt.Errorf("recover %s failed: %w", entry.ID, err))
+			continue
+		}
+		if err := uc.journal.finish(ctx, entry); err != nil {
+			errs = append(errs, fmt.Errorf("remove %s failed: %w", entry.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (uc *VaultUsercase) recoverEntry(ctx context.Context, entry *journalEntry) error {
+	version, err := uc.currentVersion(ctx, entry.SecretName, entry.SecretPath)
+	if err != nil {
+		return err
+	}
+
+	switch entry.Step {
+	case stepWriteSecret:
+		// The secret is not written, there is nothing to recover
+		if version == entry.PreVersion {
+			return nil
+		}
+		entry.Version = version
+	case stepWritePolicy, stepRollback:
+	default:
+		return fmt.Errorf("unknown step %s", entry.Step)
+	}
+
+	// The secret is changed by others after this operation, leave it to them
+	if entry.Version != version {
+		uc.log.WithContext(ctx).Infof("secret %s in %s is at version %d, skip the recovery of version %d",
+			entry.SecretPath, entry.SecretName, version, entry.Version)
+		return nil
+	}
+
+	if entry.Step != stepRollback {
+		err := uc.client.CreatePolicy(ctx, entry.PolicyName, entry.PolicyData)
+		if err == nil {
+			return nil
+		}
+		uc.log.WithContext(ctx).Errorf("write policy %s failed, roll back the secret: %s", entry.PolicyName, err)
+	}
+
+	if entry.PreVersion == 0 {
+		return uc.client.DeleteSecret(ctx, entry.SecretName, entry.SecretPath)
+	}
+	_, err = uc.client.RollBackSecret(ctx, entry.SecretName, entry.SecretPath, entry.PreVersion)
+	return err
+}
@@ -0,0 +1,148 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaultproxy
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"path"
+	"strings"
+
+	pb "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+)
+
+const defaultPageSize = 100
+
+type SecretPage struct {
+	Secrets       []SecretData
+	NextPageToken string
+}
+
+// SecretFilter reports whether the secret in full path can be returned to the caller.
+type SecretFilter func(fullPath string) bool
+
+// ListSecret walks the metadata tree under the prefix of the request.
+// Secrets are returned in the order of the tree walk, the page token is the path of the last returned secret.
+func (uc *VaultUsercase) ListSecret(ctx context.Context, req pb.SecListRequest, filter SecretFilter) (*SecretPage, error) {
+	secret, err := req.ConvertRequest()
+	if err != nil {
+		return nil, pb.ErrorInputArgError("convert to secret failed: %s", err)
+	}
+	if !verifySecret(secret) {
+		return nil, errorSecretVerifyFailed
+	}
+
+	// The prefix is a path, it matches the secret or folder which has the same name as the last part.
+	folder, match := "", secret.SecretPath
+	if i := strings.LastIndex(secret.SecretPath, "/"); i >= 0 {
+		folder, match = secret.SecretPath[:i+1], secret.SecretPath[i+1:]
+	}
+
+	after, err := decodePageToken(req.GetPageToken(), folder)
+	if err != nil {
+		return nil, pb.ErrorInputArgError("page token is invalid: %s", err)
+	}
+
+	pageSize := int(req.GetPageSize())
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	page := &SecretPage{}
+	visit := func(secretPath string) bool {
+		if filter != nil && !filter(fmt.Sprintf("%s/data/%s", secret.SecretName, secretPath)) {
+			return true
+		}
+		if len(page.Secrets) == pageSize {
+			page.NextPageToken = encodePageToken(page.Secrets[pageSize-1].SecretPath)
+			return false
+		}
+		page.Secrets = append(page.Secrets, SecretData{
+			SecretName: secret.SecretName,
+			SecretPath: secretPath,
+		})
+		return true
+	}
+
+	if _, err := uc.walkSecret(ctx, secret.SecretName, folder, match, after, visit); err != nil {
+		return nil, pb.ErrorInternalServiceError("list secret %s in %s failed: %s", secret.SecretPath, secret.SecretName, err)
+	}
+	return page, nil
+}
+
+// walkSecret visits the secrets under the folder in order until visit returns false.
+// If match is not empty, only the secret or sub folder named match is walked.
+// The keys in after are the position of the last visited secret, secrets before it and itself are skipped.
+func (uc *VaultUsercase) walkSecret(ctx context.Context, secretName, folder, match string, after []string, visit func(secretPath string) bool) (bool, error) {
+	keys, err := uc.client.ListSecret(ctx, secretName, folder)
+	if err != nil {
+		return false, err
+	}
+
+	for _, key := range keys {
+		isFolder := strings.HasSuffix(key, "/")
+		if match != "" && strings.TrimSuffix(key, "/") != match {
+			continue
+		}
+
+		var subAfter []string
+		if len(after) != 0 {
+			if key < after[0] {
+				continue
+			} else if key == after[0] {
+				if !isFolder || len(after) == 1 {
+					continue
+				}
+				subAfter = after[1:]
+			}
+		}
+
+		if isFolder {
+			goOn, err := uc.walkSecret(ctx, secretName, folder+key, "", subAfter, visit)
+			if err != nil || !goOn {
+				return goOn, err
+			}
+		} else if !visit(folder + key) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func encodePageToken(secretPath string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(secretPath))
+}
+
+// decodePageToken converts the token to the keys of each level in the walk from folder.
+func decodePageToken(token, folder string) ([]string, error) {
+	if token == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	secretPath := string(raw)
+	if !strings.HasPrefix(secretPath, folder) || path.Clean(secretPath) != secretPath {
+		return nil, fmt.Errorf("token is not belong to the prefix")
+	}
+
+	parts := strings.Split(strings.TrimPrefix(secretPath, folder), "/")
+	for i := 0; i < len(parts)-1; i++ {
+		parts[i] += "/"
+	}
+	return parts, nil
+}
@@ -0,0 +1,234 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaultproxy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	pb "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+
+	vault "github.com/hashicorp/vault/api"
+)
+
+// Steps of the policy name migration, they are run in this order.
+const (
+	// Create the policy with the new name of the secret
+	MigrationCreatePolicy = "create_policy"
+	// Replace the legacy policy with the new policy in the token policies of a role
+	MigrationUpdateRole = "update_role"
+	// Delete the legacy policy
+	MigrationDeletePolicy = "delete_policy"
+)
+
+type PolicyMigration struct {
+	Step          string
+	OldPolicyName string
+	NewPolicyName string
+	FullPath      string
+	RolePath      string
+	Done          bool
+}
+
+type PolicyMigrationReport struct {
+	DryRun     bool
+	Migrations []PolicyMigration
+}
+
+// MigratePolicyNames renames the policies created before the policy names have the hash suffix.
+// It creates the policy with the new name, replaces the legacy policy in the roles of all kubernetes auths,
+// and deletes the legacy policy. Nothing is changed if dryRun is true, the report has the steps to run.
+// The migration can be run again if it is interrupted.
+func (uc *VaultUsercase) MigratePolicyNames(ctx context.Context, dryRun bool) (*PolicyMigrationReport, error) {
+	policies, err := uc.listKeys(ctx, "sys/policies/acl")
+	if err != nil {
+		return nil, pb.ErrorInternalServiceError("list policies failed: %s", err)
+	}
+	existPolicies := map[string]bool{}
+	for _, policyName := range policies {
+		existPolicies[policyName] = true
+	}
+
+	// Legacy policy name to the secret it belongs to
+	renames := map[string]*pb.SecretRequest{}
+	for _, secretName := range pb.SecretNames() {
+		var secrets []*pb.SecretRequest
+		_, err := uc.walkSecret(ctx, secretName, "", "", nil, func(secretPath string) bool {
+			secret, err := pb.NewSecretRequestFromPath(secretName, secretPath)
+			if err == nil {
+				secrets = append(secrets, secret)
+			}
+			return true
+		})
+		if err != nil {
+			return nil, pb.ErrorInternalServiceError("list secrets in %s failed: %s", secretName, err)
+		}
+
+		for _, secret := range secrets {
+			legacyName := secret.LegacyPolicyName
+			if legacyName == secret.PolicyName || !existPolicies[legacyName] {
+				continue
+			}
+			// The legacy names of different secrets may collide,
+			// the policy belongs to the secret it grants, which is the one written last.
+			policyData, err := uc.client.GetPolicy(ctx, legacyName)
+			if err != nil {
+				return nil, pb.ErrorInternalServiceError("get policy %s failed: %s", legacyName, err)
+			}
+			match := policySecretPathRegex.FindStringSubmatch(policyData)
+			if match == nil || match[1]+"/data/"+match[2] != secret.FullPath {
+				continue
+			}
+			renames[legacyName] = secret
+		}
+	}
+
+	legacyNames := make([]string, 0, len(renames))
+	for legacyName := range renames {
+		legacyNames = append(legacyNames, legacyName)
+	}
+	sort.Strings(legacyNames)
+
+	report := &PolicyMigrationReport{DryRun: dryRun}
+	run := func(migration PolicyMigration, step func() error) error {
+		if !dryRun {
+			if err := step(); err != nil {
+				report.Migrations = append(report.Migrations, migration)
+				return err
+			}
+			migration.Done = true
+		}
+		report.Migrations = append(report.Migrations, migration)
+		return nil
+	}
+
+	for _, legacyName := range legacyNames {
+		secret := renames[legacyName]
+		if existPolicies[secret.PolicyName] {
+			continue
+		}
+		migration := PolicyMigration{
+			Step:          MigrationCreatePolicy,
+			OldPolicyName: legacyName,
+			NewPolicyName: secret.PolicyName,
+			FullPath:      secret.FullPath,
+		}
+		err := run(migration, func() error {
+			return uc.client.CreatePolicy(ctx, secret.PolicyName, secret.PolicyData)
+		})
+		if err != nil {
+			return report, pb.ErrorInternalServiceError("create policy %s failed: %s", secret.PolicyName, err)
+		}
+	}
+
+	err = uc.walkRoles(ctx, func(rolePath string, roleCFG *vault.Secret) error {
+		_, migrations := renameRolePolicies(rolePath, roleCFG, renames)
+		if len(migrations) == 0 || dryRun {
+			report.Migrations = append(report.Migrations, migrations...)
+			return nil
+		}
+
+		// The role may be changed by a grant or a revoke after it is listed, it is read again right before the write,
+		// so that the policies granted in between are kept
+		roleCFG, err := uc.client.Read(ctx, rolePath)
+		if err != nil {
+			return fmt.Errorf("read role %s failed: %w", rolePath, err)
+		}
+		if roleCFG == nil {
+			return nil
+		}
+		newPolicyList, migrations := renameRolePolicies(rolePath, roleCFG, renames)
+		if len(migrations) == 0 {
+			return nil
+		}
+		roleCFG.Data["token_policies"] = newPolicyList
+		_, err = uc.client.Write(ctx, rolePath, roleCFG.Data)
+		for i := range migrations {
+			migrations[i].Done = err == nil
+		}
+		report.Migrations = append(report.Migrations, migrations...)
+		return err
+	})
+	if err != nil {
+		return report, pb.ErrorInternalServiceError("update roles failed: %s", err)
+	}
+
+	for _, legacyName := range legacyNames {
+		secret := renames[legacyName]
+		migration := PolicyMigration{
+			Step:          MigrationDeletePolicy,
+			OldPolicyName: legacyName,
+			NewPolicyName: secret.PolicyName,
+			FullPath:      secret.FullPath,
+		}
+		err := run(migration, func() error {
+			return uc.client.DeletePolicy(ctx, legacyName)
+		})
+		if err != nil {
+			return report, pb.ErrorInternalServiceError("delete policy %s failed: %s", legacyName, err)
+		}
+	}
+
+	return report, nil
+}
+
+// renameRolePolicies replaces the legacy policies in the token policies of the role with the new ones,
+// it returns the new token policies and the migrations of the role.
+func renameRolePolicies(rolePath string, roleCFG *vault.Secret, renames map[string]*pb.SecretRequest) ([]interface{}, []PolicyMigration) {
+	policyList, _ := roleCFG.Data["token_policies"].([]interface{})
+
+	var migrations []PolicyMigration
+	var newPolicyList []interface{}
+	added := map[string]bool{}
+	for _, policy := range policyList {
+		policyName, _ := policy.(string)
+		if secret, ok := renames[policyName]; ok {
+			migrations = append(migrations, PolicyMigration{
+				Step:          MigrationUpdateRole,
+				OldPolicyName: policyName,
+				NewPolicyName: secret.PolicyName,
+				FullPath:      secret.FullPath,
+				RolePath:      rolePath,
+			})
+			policyName = secret.PolicyName
+		}
+		if !added[policyName] {
+			added[policyName] = true
+			newPolicyList = append(newPolicyList, policyName)
+		}
+	}
+	return newPolicyList, migrations
+}
+
+// policyNamesOf returns the names of the policies which grant the secret. The legacy policy is included if the secret
+// is not migrated yet, so that the secrets written before the hash suffix can still be granted, revoked and deleted.
+func (uc *VaultUsercase) policyNamesOf(ctx context.Context, secret *pb.SecretRequest) ([]string, error) {
+	policyNames := []string{secret.PolicyName}
+	legacyName := secret.LegacyPolicyName
+	if legacyName == "" || legacyName == secret.PolicyName {
+		return policyNames, nil
+	}
+	policyData, err := uc.client.GetPolicy(ctx, legacyName)
+	if err != nil {
+		return nil, fmt.Errorf("get policy %s failed: %w", legacyName, err)
+	}
+	// The legacy names of different secrets may collide, the legacy policy may grant another secret
+	match := policySecretPathRegex.FindStringSubmatch(policyData)
+	if match == nil || match[1]+"/data/"+match[2] != secret.FullPath {
+		return policyNames, nil
+	}
+	return append(policyNames, legacyName), nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaultproxy
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	pb "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+	"github.com/nautes-labs/vault-proxy/internal/biz/auth"
+
+	vault "github.com/hashicorp/vault/api"
+)
+
+// Key of the custom metadata which records the co-owners of the secret, the common names are joined by ",".
+const coOwnersMetadataKey = "co_owners"
+
+// ownerSecretRequest is a request which changes the co-owners of a secret.
+type ownerSecretRequest interface {
+	pb.SecRequest
+	GetCoOwners() []string
+}
+
+// checkOwner returns an error if owner only is enabled and the user in ctx is neither the creator nor a co-owner of the secret.
+// The secret which is not existed can be changed by anyone the resource acl allows,
+// the secret without the creator, such as the one written before owner only is enabled, can only be changed by the admins.
+func (uc *VaultUsercase) checkOwner(ctx context.Context, secret *pb.SecretRequest) error {
+	if !uc.ownerOnly {
+		return nil
+	}
+	metadata, err := uc.client.GetSecretMetadata(ctx, secret.SecretName, secret.SecretPath)
+	if errors.Is(err, vault.ErrSecretNotFound) {
+		return nil
+	} else if err != nil {
+		return pb.ErrorInternalServiceError("get metadata of secret %s in %s failed: %s", secret.SecretPath, secret.SecretName, err)
+	}
+
+	user := auth.FromAuthContext(ctx)
+	provenance := newProvenance(metadata.CustomMetadata)
+	if provenance.CreatedBy == "" {
+		if containsString(uc.ownerAdmins, user) {
+			return nil
+		}
+		return pb.ErrorActionNotAllow("secret %s in %s does not record its creator, only the owner admins can change it", secret.SecretPath, secret.SecretName)
+	}
+	if provenance.CreatedBy == user || containsString(provenance.CoOwners, user) {
+		return nil
+	}
+	return pb.ErrorActionNotAllow("secret %s in %s is owned by %s, %s is not an owner", secret.SecretPath, secret.SecretName, provenance.CreatedBy, user)
+}
+
+// UpdateSecretOwners replaces the co-owners of the secret, only the creator of the secret can change them.
+// The co-owners are removed if the request has none.
+func (uc *VaultUsercase) UpdateSecretOwners(ctx context.Context, req ownerSecretRequest) (*SecretMetadata, error) {
+	secret, err := req.ConvertRequest()
+	if err != nil {
+		return nil, pb.ErrorInputArgError("convert to secret failed: %s", err)
+	}
+	if !verifySecret(secret) {
+		return nil, errorSecretVerifyFailed
+	}
+	secretName := secret.SecretName
+	secretPath := secret.SecretPath
+	// The co-owners are recorded in one custom metadata value
+	if joined := strings.Join(req.GetCoOwners(), ","); len(joined) > maxCustomMetadataValueLength {
+		return nil, pb.ErrorInputArgError("co-owners of secret %s in %s are %d bytes, which is longer than %d bytes",
+			secretPath, secretName, len(joined), maxCustomMetadataValueLength)
+	}
+
+	metadata, err := uc.client.GetSecretMetadata(ctx, secretName, secretPath)
+	if errors.Is(err, vault.ErrSecretNotFound) {
+		return nil, pb.ErrorResourceNotFound("can not find secret %s in %s", secretPath, secretName)
+	} else if err != nil {
+		return nil, pb.ErrorInternalServiceError("get metadata of secret %s in %s failed: %s", secretPath, secretName, err)
+	}
+	user := auth.FromAuthContext(ctx)
+	createdBy := newProvenance(metadata.CustomMetadata).CreatedBy
+	if createdBy != user {
+		return nil, pb.ErrorActionNotAllow("only the creator of secret %s in %s can change the co-owners", secretPath, secretName)
+	}
+
+	var coOwners interface{}
+	if len(req.GetCoOwners()) != 0 {
+		coOwners = strings.Join(req.GetCoOwners(), ",")
+	}
+	err = uc.client.PatchSecretMetadata(ctx, secretName, secretPath, map[string]interface{}{coOwnersMetadataKey: coOwners})
+	if err != nil {
+		return nil, pb.ErrorInternalServiceError("update co-owners of secret %s in %s failed: %s", secretPath, secretName, err)
+	}
+	uc.log.WithContext(ctx).Infof("co-owners of secret %s in %s are changed to %v by %s", secretPath, secretName, req.GetCoOwners(), user)
+
+	return uc.GetSecret(ctx, req)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
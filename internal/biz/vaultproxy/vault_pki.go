@@ -0,0 +1,77 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaultproxy
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+
+	pb "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+)
+
+// CreatePki stores the certificate of a domain in vault after it passes the verification.
+func (uc *VaultUsercase) CreatePki(ctx context.Context, req *pb.PkiRequest) (*SecretData, error) {
+	if err := verifyCertificate(req.Domain, req.Cacert, req.Cert, req.Key); err != nil {
+		return nil, pb.ErrorInputArgError("verify certificate of %s failed: %s", req.Domain, err)
+	}
+	return uc.CreateSecret(ctx, req)
+}
+
+// verifyCertificate has following checks
+//
+// 1. The private key matches the public key in the leaf cert
+// 2. The leaf cert is issued for the domain
+// 3. The cert chain can be verified by the ca cert, certs after the leaf are used as intermediates
+func verifyCertificate(domain, caCert, cert, key string) error {
+	if caCert == "" || cert == "" || key == "" {
+		return fmt.Errorf("cacert, cert and key can not be empty")
+	}
+
+	keyPair, err := tls.X509KeyPair([]byte(cert), []byte(key))
+	if err != nil {
+		return fmt.Errorf("cert and key are not a pair: %w", err)
+	}
+
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM([]byte(caCert)) {
+		return fmt.Errorf("no certificate found in cacert")
+	}
+
+	var chain []*x509.Certificate
+	for _, der := range keyPair.Certificate {
+		c, err := x509.ParseCertificate(der)
+		if err != nil {
+			return fmt.Errorf("parse cert failed: %w", err)
+		}
+		chain = append(chain, c)
+	}
+	intermediates := x509.NewCertPool()
+	for _, c := range chain[1:] {
+		intermediates.AddCert(c)
+	}
+
+	_, err = chain[0].Verify(x509.VerifyOptions{
+		DNSName:       domain,
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return fmt.Errorf("verify cert chain failed: %w", err)
+	}
+	return nil
+}
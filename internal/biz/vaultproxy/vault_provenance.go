@@ -0,0 +1,102 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaultproxy
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	pb "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+	"github.com/nautes-labs/vault-proxy/internal/biz/auth"
+	"github.com/nautes-labs/vault-proxy/internal/pkg/requestid"
+)
+
+// Keys of the custom metadata which record who writes the secret.
+const (
+	createdByMetadataKey  = "created_by"
+	updatedByMetadataKey  = "updated_by"
+	requestIDMetadataKey  = "request_id"
+	tenantMetadataKey     = "tenant"
+	sourceTypeMetadataKey = "source_type"
+)
+
+// Provenance records who writes the secret, it is kept in the custom metadata of the secret.
+type Provenance struct {
+	// Common name of the client cert which creates the secret
+	CreatedBy string
+	// Common name of the client cert which writes the last version
+	UpdatedBy string
+	// Id of the request which writes the last version
+	RequestID string
+	// Nautes tenant which vault proxy serves
+	Tenant string
+	// Secret type of the request which writes the last version
+	SourceType string
+	// Common names which can also update and delete the secret when owner only is enabled
+	CoOwners []string
+}
+
+// recordVersionMetadata writes the provenance and the expire time of the new version to the custom metadata.
+// The creator is only recorded when the first version is written, the expire time is removed if the request does not set it,
+// and the roles revoked by the previous delete are cleared, the new live version is not undeleted to grant them again.
+// The server url and the expiry of a cluster are recorded with them.
+// Other keys in the custom metadata are kept.
+func (uc *VaultUsercase) recordVersionMetadata(ctx context.Context, secret *pb.SecretRequest, version int) error {
+	user := auth.FromAuthContext(ctx)
+	customMetadata := map[string]interface{}{
+		updatedByMetadataKey:  user,
+		requestIDMetadataKey:  requestid.FromContext(ctx),
+		tenantMetadataKey:     uc.tenant,
+		sourceTypeMetadataKey: secret.SecretType,
+		expireTimeMetadataKey: nil,
+	}
+	cleared, _ := revokedRolesMetadata(nil)
+	for key, value := range cleared {
+		customMetadata[key] = value
+	}
+	if version == 1 {
+		customMetadata[createdByMetadataKey] = user
+	}
+	for key, value := range clusterMetadata(secret) {
+		customMetadata[key] = value
+	}
+	if secret.ExpireTime != nil {
+		customMetadata[expireTimeMetadataKey] = secret.ExpireTime.Format(time.RFC3339)
+	}
+
+	if err := uc.client.PatchSecretMetadata(ctx, secret.SecretName, secret.SecretPath, customMetadata); err != nil {
+		return err
+	}
+	return uc.removeRevokedRoles(ctx, secret)
+}
+
+func newProvenance(customMetadata map[string]interface{}) Provenance {
+	get := func(key string) string {
+		value, _ := customMetadata[key].(string)
+		return value
+	}
+	provenance := Provenance{
+		CreatedBy:  get(createdByMetadataKey),
+		UpdatedBy:  get(updatedByMetadataKey),
+		RequestID:  get(requestIDMetadataKey),
+		Tenant:     get(tenantMetadataKey),
+		SourceType: get(sourceTypeMetadataKey),
+	}
+	if coOwners := get(coOwnersMetadataKey); coOwners != "" {
+		provenance.CoOwners = strings.Split(coOwners, ",")
+	}
+	return provenance
+}
@@ -0,0 +1,192 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaultproxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	pb "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+	"github.com/nautes-labs/vault-proxy/internal/conf"
+
+	vault "github.com/hashicorp/vault/api"
+)
+
+// Key of the custom metadata which records the time the secret is purged by the reaper, in RFC3339 format.
+const expireTimeMetadataKey = "expire_time"
+
+type Expiration struct {
+	SecretName string
+	SecretPath string
+	ExpireTime time.Time
+}
+
+// ListExpirations returns the secrets in the managed secret engines which expire before the deadline,
+// they are sorted by the expire time. All the secrets with expire time are returned if the deadline is zero.
+func (uc *VaultUsercase) ListExpirations(ctx context.Context, deadline time.Time) ([]Expiration, error) {
+	var expirations []Expiration
+	for _, secretName := range pb.SecretNames() {
+		var secretPaths []string
+		_, err := uc.walkSecret(ctx, secretName, "", "", nil, func(secretPath string) bool {
+			secretPaths = append(secretPaths, secretPath)
+			return true
+		})
+		if err != nil {
+			return nil, pb.ErrorInternalServiceError("list secrets in %s failed: %s", secretName, err)
+		}
+
+		for _, secretPath := range secretPaths {
+			metadata, err := uc.client.GetSecretMetadata(ctx, secretName, secretPath)
+			if errors.Is(err, vault.ErrSecretNotFound) {
+				continue
+			} else if err != nil {
+				return nil, pb.ErrorInternalServiceError("get metadata of secret %s in %s failed: %s", secretPath, secretName, err)
+			}
+
+			expireTime, err := recordedExpireTime(metadata)
+			if err != nil {
+				uc.log.WithContext(ctx).Warnf("skip secret %s in %s: %s", secretPath, secretName, err)
+				continue
+			}
+			if expireTime.IsZero() || (!deadline.IsZero() && expireTime.After(deadline)) {
+				continue
+			}
+			expirations = append(expirations, Expiration{
+				SecretName: secretName,
+				SecretPath: secretPath,
+				ExpireTime: expireTime,
+			})
+		}
+	}
+
+	sort.SliceStable(expirations, func(i, j int) bool {
+		return expirations[i].ExpireTime.Before(expirations[j].ExpireTime)
+	})
+	return expirations, nil
+}
+
+// ReapExpiredSecrets purges the secrets whose expire time is passed, the policies of them are revoked from all roles and deleted.
+// A secret which can not be purged is logged and left for the next run. It returns the purged secrets.
+func (uc *VaultUsercase) ReapExpiredSecrets(ctx context.Context) ([]Expiration, error) {
+	expirations, err := uc.ListExpirations(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	var purged []Expiration
+	for _, expiration := range expirations {
+		secret, err := pb.NewSecretRequestFromPath(expiration.SecretName, expiration.SecretPath)
+		if err != nil {
+			uc.log.WithContext(ctx).Warnf("skip expired secret %s in %s: %s", expiration.SecretPath, expiration.SecretName, err)
+			continue
+		}
+		// The secret may be written again after it is listed, with a new expire time or without it
+		expired, err := uc.isExpired(ctx, secret, time.Now())
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("check expire time of secret %s in %s failed: %s", expiration.SecretPath, expiration.SecretName, err)
+			continue
+		}
+		if !expired {
+			uc.log.WithContext(ctx).Infof("secret %s in %s is not expired any more, skip purging it", expiration.SecretPath, expiration.SecretName)
+			continue
+		}
+		revokedRoles, err := uc.purgeSecret(ctx, secret)
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("purge expired secret %s in %s failed: %s", expiration.SecretPath, expiration.SecretName, err)
+			continue
+		}
+		uc.log.WithContext(ctx).Infof("secret %s in %s expired at %s is purged, policy %s is deleted and revoked from %v",
+			expiration.SecretPath, expiration.SecretName, expiration.ExpireTime.Format(time.RFC3339), secret.PolicyName, revokedRoles)
+		purged = append(purged, expiration)
+	}
+	return purged, nil
+}
+
+// isExpired reads the expire time of the secret again, it is false if the secret is not found or has no expire time.
+func (uc *VaultUsercase) isExpired(ctx context.Context, secret *pb.SecretRequest, now time.Time) (bool, error) {
+	metadata, err := uc.client.GetSecretMetadata(ctx, secret.SecretName, secret.SecretPath)
+	if errors.Is(err, vault.ErrSecretNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	expireTime, err := recordedExpireTime(metadata)
+	if err != nil {
+		return false, err
+	}
+	return !expireTime.IsZero() && !expireTime.After(now), nil
+}
+
+// recordedExpireTime parses the expire time in the custom metadata, it is zero if the secret has no expire time.
+func recordedExpireTime(metadata *vault.KVMetadata) (time.Time, error) {
+	recorded, _ := metadata.CustomMetadata[expireTimeMetadataKey].(string)
+	if recorded == "" {
+		return time.Time{}, nil
+	}
+	expireTime, err := time.Parse(time.RFC3339, recorded)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("expire time %s is invalid: %w", recorded, err)
+	}
+	return expireTime, nil
+}
+
+// Reaper purges the expired secrets in background.
+type Reaper struct {
+	uc       *VaultUsercase
+	interval time.Duration
+	stop     chan struct{}
+}
+
+func NewReaper(uc *VaultUsercase, cfg *conf.Server) *Reaper {
+	r := &Reaper{
+		uc:   uc,
+		stop: make(chan struct{}),
+	}
+	if cfg.Reaper != nil {
+		r.interval = cfg.Reaper.Interval.AsDuration()
+	}
+	return r
+}
+
+// Start purges the expired secrets every interval until Stop is called.
+func (r *Reaper) Start(ctx context.Context) error {
+	if r.interval <= 0 {
+		<-r.stop
+		return nil
+	}
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		if _, err := r.uc.ReapExpiredSecrets(ctx); err != nil {
+			r.uc.log.WithContext(ctx).Errorf("reap expired secrets failed: %s", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-r.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (r *Reaper) Stop(_ context.Context) error {
+	close(r.stop)
+	return nil
+}
@@ -0,0 +1,380 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaultproxy
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	pb "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+	"github.com/nautes-labs/vault-proxy/internal/conf"
+
+	vault "github.com/hashicorp/vault/api"
+)
+
+// Kinds of drift between secrets, policies and roles.
+const (
+	// A managed policy whose secret is purged, repaired by deleting the policy
+	DriftPolicyWithoutSecret = "policy_without_secret"
+	// A managed secret without policy, repaired by creating the policy
+	DriftSecretWithoutPolicy = "secret_without_policy"
+	// A role has a policy which is not existed or orphaned, repaired by removing it from the role
+	DriftRoleWithMissingPolicy = "role_with_missing_policy"
+)
+
+var (
+	// Policies created by vault proxy always start with the secret path they grant
+	policySecretPathRegex = regexp.MustCompile(`path "([^"/]+)/data/([^"]+)"`)
+	builtinPolicies       = map[string]bool{"root": true, "default": true}
+)
+
+type Drift struct {
+	Kind       string
+	SecretName string
+	SecretPath string
+	PolicyName string
+	RolePath   string
+	Repaired   bool
+}
+
+type DriftReport struct {
+	Drifts      []Drift
+	CheckedTime time.Time
+}
+
+// Count returns the number of drifts of each kind.
+func (r *DriftReport) Count() map[string]int {
+	count := map[string]int{
+		DriftPolicyWithoutSecret:   0,
+		DriftSecretWithoutPolicy:   0,
+		DriftRoleWithMissingPolicy: 0,
+	}
+	for _, drift := range r.Drifts {
+		count[drift.Kind]++
+	}
+	return count
+}
+
+// Reconcile scans the managed secret engines, the acl policies and the roles of all kubernetes auths,
+// it reports the drifts between them and repairs them if repair is true.
+func (uc *VaultUsercase) Reconcile(ctx context.Context, repair bool) (*DriftReport, error) {
+	report := &DriftReport{CheckedTime: time.Now()}
+
+	policies, err := uc.listKeys(ctx, "sys/policies/acl")
+	if err != nil {
+		return nil, pb.ErrorInternalServiceError("list policies failed: %s", err)
+	}
+	existPolicies := map[string]bool{}
+	// Policy name to the full path of the secret it belongs to
+	managedPolicies := map[string]string{}
+	for _, policyName := range policies {
+		existPolicies[policyName] = true
+		if builtinPolicies[policyName] {
+			continue
+		}
+		policyData, err := uc.client.GetPolicy(ctx, policyName)
+		if err != nil {
+			return nil, pb.ErrorInternalServiceError("get policy %s failed: %s", policyName, err)
+		}
+		match := policySecretPathRegex.FindStringSubmatch(policyData)
+		if match == nil {
+			continue
+		}
+		// Policies named before the hash suffix are also managed until they are migrated
+		secret, err := pb.NewSecretRequestFromPath(match[1], match[2])
+		if err != nil || (secret.PolicyName != policyName && secret.LegacyPolicyName != policyName) {
+			continue
+		}
+		managedPolicies[policyName] = secret.FullPath
+	}
+
+	existSecrets := map[string]bool{}
+	for _, secretName := range pb.SecretNames() {
+		var secrets []*pb.SecretRequest
+		_, err := uc.walkSecret(ctx, secretName, "", "", nil, func(secretPath string) bool {
+			secret, err := pb.NewSecretRequestFromPath(secretName, secretPath)
+			if err == nil {
+				secrets = append(secrets, secret)
+			}
+			return true
+		})
+		if err != nil {
+			return nil, pb.ErrorInternalServiceError("list secrets in %s failed: %s", secretName, err)
+		}
+
+		for _, secret := range secrets {
+			existSecrets[secret.FullPath] = true
+			if existPolicies[secret.PolicyName] {
+				continue
+			}
+			// The secret which is not migrated yet is granted by its legacy policy
+			policyNames, err := uc.policyNamesOf(ctx, secret)
+			if err != nil {
+				return nil, pb.ErrorInternalServiceError("get policies of %s in %s failed: %s", secret.SecretPath, secret.SecretName, err)
+			}
+			if len(policyNames) > 1 {
+				continue
+			}
+			drift := Drift{
+				Kind:       DriftSecretWithoutPolicy,
+				SecretName: secret.SecretName,
+				SecretPath: secret.SecretPath,
+				PolicyName: secret.PolicyName,
+			}
+			if repair {
+				drift.Repaired = uc.repairDrift(ctx, drift, func() error {
+					return uc.client.CreatePolicy(ctx, secret.PolicyName, secret.PolicyData)
+				})
+			}
+			report.Drifts = append(report.Drifts, drift)
+		}
+	}
+
+	orphanPolicies := map[string]bool{}
+	// Orphan policies which are deleted by the repair, only they are removed from the roles
+	deletedPolicies := map[string]bool{}
+	for policyName, fullPath := range managedPolicies {
+		if existSecrets[fullPath] {
+			continue
+		}
+		orphanPolicies[policyName] = true
+		drift := Drift{
+			Kind:       DriftPolicyWithoutSecret,
+			PolicyName: policyName,
+		}
+		if repair {
+			drift.Repaired = uc.repairDrift(ctx, drift, func() error {
+				return uc.client.DeletePolicy(ctx, policyName)
+			})
+			deletedPolicies[policyName] = drift.Repaired
+		}
+		report.Drifts = append(report.Drifts, drift)
+	}
+
+	roleDrifts, err := uc.reconcileRoles(ctx, existPolicies, orphanPolicies, deletedPolicies, repair)
+	if err != nil {
+		return nil, err
+	}
+	report.Drifts = append(report.Drifts, roleDrifts...)
+
+	return report, nil
+}
+
+// reconcileRoles reports the roles which have missing or orphan policies.
+// The repair only removes the policies which are missing or deleted by the repair, they are checked again right before the role is written,
+// so that a policy created and granted during the reconcile is kept.
+func (uc *VaultUsercase) reconcileRoles(ctx context.Context, existPolicies, orphanPolicies, deletedPolicies map[string]bool, repair bool) ([]Drift, error) {
+	var drifts []Drift
+	err := uc.walkRoles(ctx, func(rolePath string, roleCFG *vault.Secret) error {
+		policyList, _ := roleCFG.Data["token_policies"].([]interface{})
+
+		var roleDrifts []Drift
+		var removable []string
+		for _, policy := range policyList {
+			policyName, _ := policy.(string)
+			if existPolicies[policyName] && !orphanPolicies[policyName] {
+				continue
+			}
+			roleDrifts = append(roleDrifts, Drift{
+				Kind:       DriftRoleWithMissingPolicy,
+				PolicyName: policyName,
+				RolePath:   rolePath,
+			})
+			if !existPolicies[policyName] || deletedPolicies[policyName] {
+				removable = append(removable, policyName)
+			}
+		}
+
+		if repair && len(removable) != 0 {
+			var removed map[string]bool
+			var recreated map[string]bool
+			repaired := uc.repairDrift(ctx, roleDrifts[0], func() error {
+				var err error
+				removed, recreated, err = uc.removeMissingPolicies(ctx, rolePath, removable)
+				return err
+			})
+			var kept []Drift
+			for _, drift := range roleDrifts {
+				// The policy is created after the scan, it is not a drift any more
+				if recreated[drift.PolicyName] && !orphanPolicies[drift.PolicyName] {
+					continue
+				}
+				drift.Repaired = repaired && removed[drift.PolicyName]
+				kept = append(kept, drift)
+			}
+			roleDrifts = kept
+		}
+		drifts = append(drifts, roleDrifts...)
+		return nil
+	})
+	if err != nil {
+		return nil, pb.ErrorInternalServiceError("list roles failed: %s", err)
+	}
+	return drifts, nil
+}
+
+// removeMissingPolicies removes the policies which still do not exist from the role, it returns the removed policies and the recreated ones.
+// The role is read again before it is written, so that the grants and revokes after the scan are kept.
+func (uc *VaultUsercase) removeMissingPolicies(ctx context.Context, rolePath string, policyNames []string) (removed, recreated map[string]bool, err error) {
+	missing := map[string]bool{}
+	recreated = map[string]bool{}
+	for _, policyName := range policyNames {
+		policyData, err := uc.client.GetPolicy(ctx, policyName)
+		if err != nil {
+			return nil, nil, fmt.Errorf("get policy %s failed: %w", policyName, err)
+		}
+		if policyData == "" {
+			missing[policyName] = true
+		} else {
+			recreated[policyName] = true
+		}
+	}
+	if len(missing) == 0 {
+		return nil, recreated, nil
+	}
+
+	roleCFG, err := uc.client.Read(ctx, rolePath)
+	if err != nil || roleCFG == nil {
+		return nil, recreated, err
+	}
+	policyList, _ := roleCFG.Data["token_policies"].([]interface{})
+	removed = map[string]bool{}
+	var newPolicyList []interface{}
+	for _, policy := range policyList {
+		policyName, _ := policy.(string)
+		if missing[policyName] {
+			removed[policyName] = true
+			continue
+		}
+		newPolicyList = append(newPolicyList, policy)
+	}
+	if len(removed) == 0 {
+		return removed, recreated, nil
+	}
+	roleCFG.Data["token_policies"] = newPolicyList
+	_, err = uc.client.Write(ctx, rolePath, roleCFG.Data)
+	return removed, recreated, err
+}
+
+func (uc *VaultUsercase) repairDrift(ctx context.Context, drift Drift, repair func() error) bool {
+	if err := repair(); err != nil {
+		uc.log.WithContext(ctx).Errorf("repair %s of %+v failed: %s", drift.Kind, drift, err)
+		return false
+	}
+	uc.log.WithContext(ctx).Infof("%s of %+v is repaired", drift.Kind, drift)
+	return true
+}
+
+func (uc *VaultUsercase) listKeys(ctx context.Context, path string) ([]string, error) {
+	sec, err := uc.client.List(ctx, path)
+	if err != nil || sec == nil {
+		return nil, err
+	}
+	rawKeys, _ := sec.Data["keys"].([]interface{})
+	keys := make([]string, 0, len(rawKeys))
+	for _, key := range rawKeys {
+		if k, ok := key.(string); ok {
+			keys = append(keys, k)
+		}
+	}
+	return keys, nil
+}
+
+// Reconciler runs the reconcile in background and keeps the last report.
+type Reconciler struct {
+	uc       *VaultUsercase
+	interval time.Duration
+	repair   bool
+	lock     sync.RWMutex
+	report   *DriftReport
+	stop     chan struct{}
+}
+
+func NewReconciler(uc *VaultUsercase, cfg *conf.Server) *Reconciler {
+	r := &Reconciler{
+		uc:   uc,
+		stop: make(chan struct{}),
+	}
+	if cfg.Reconcile != nil {
+		r.interval = cfg.Reconcile.Interval.AsDuration()
+		r.repair = cfg.Reconcile.Repair
+	}
+	return r
+}
+
+// Start runs the reconcile every interval until Stop is called.
+// The writes left in the journal by the replicas which are gone are recovered every journal lease,
+// whether the reconcile is configured or not, the writes of this replica are recovered when it starts.
+func (r *Reconciler) Start(ctx context.Context) error {
+	var reconcileTick, recoverTick <-chan time.Time
+	if r.uc.journal != nil {
+		ticker := time.NewTicker(r.uc.journal.lease)
+		defer ticker.Stop()
+		recoverTick = ticker.C
+	}
+	if r.interval > 0 {
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		reconcileTick = ticker.C
+		r.reconcile(ctx)
+	}
+
+	for {
+		select {
+		case <-recoverTick:
+			if err := r.uc.RecoverExpiredJournal(ctx); err != nil {
+				r.uc.log.WithContext(ctx).Errorf("recover expired journal failed: %s", err)
+			}
+		case <-reconcileTick:
+			r.reconcile(ctx)
+		case <-r.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (r *Reconciler) reconcile(ctx context.Context) {
+	if _, err := r.Reconcile(ctx, r.repair); err != nil {
+		r.uc.log.WithContext(ctx).Errorf("reconcile failed: %s", err)
+	}
+}
+
+func (r *Reconciler) Stop(_ context.Context) error {
+	close(r.stop)
+	return nil
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, repair bool) (*DriftReport, error) {
+	report, err := r.uc.Reconcile(ctx, repair)
+	if err != nil {
+		return nil, err
+	}
+	r.lock.Lock()
+	r.report = report
+	r.lock.Unlock()
+	return report, nil
+}
+
+// Report returns the last report, it is nil if the reconcile has not run.
+func (r *Reconciler) Report() *DriftReport {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.report
+}
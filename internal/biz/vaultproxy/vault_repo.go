@@ -0,0 +1,159 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaultproxy
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	pb "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+	"github.com/nautes-labs/vault-proxy/internal/conf"
+	"github.com/nautes-labs/vault-proxy/internal/pkg/dryrun"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Character classes of the generated passwords.
+const (
+	CharacterClassLowercase = "lowercase"
+	CharacterClassUppercase = "uppercase"
+	CharacterClassDigits    = "digits"
+	CharacterClassSymbols   = "symbols"
+)
+
+const (
+	defaultPasswordLength  = 32
+	defaultPasswordSymbols = "!#$%&*+-=?@^_"
+)
+
+var passwordCharacterClasses = map[string]string{
+	CharacterClassLowercase: "abcdefghijklmnopqrstuvwxyz",
+	CharacterClassUppercase: "ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+	CharacterClassDigits:    "0123456789",
+}
+
+// repoAccountRequest is a request which stores a repo account, such as the repo and the tenant repo.
+type repoAccountRequest interface {
+	pb.SecRequest
+	proto.Message
+	GetAccount() *pb.RepoAccount
+}
+
+// CreateRepoAccount stores the repo account in vault, if the account asks for a generated password,
+// the password is generated by the password policy and stored as the password of the account.
+// It returns the generated password.
+func (uc *VaultUsercase) CreateRepoAccount(ctx context.Context, req repoAccountRequest) (*SecretData, string, error) {
+	account := req.GetAccount()
+	if !account.GetGeneratePassword() {
+		secretData, err := uc.CreateSecret(ctx, req)
+		return secretData, "", err
+	}
+	if account.GetAuthType() != pb.AuthTypePassword {
+		return nil, "", pb.ErrorInputArgError("password can only be generated when auth type is %s", pb.AuthTypePassword)
+	}
+	if account.GetAccount().GetPassword() != "" {
+		return nil, "", pb.ErrorInputArgError("password can not be set when generate password")
+	}
+
+	password, err := generatePassword(uc.passwordPolicy)
+	if err != nil {
+		return nil, "", pb.ErrorInternalServiceError("generate password failed: %s", err)
+	}
+	req = proto.Clone(req).(repoAccountRequest)
+	account = req.GetAccount()
+	account.Account = &pb.Account{
+		Username: account.GetAccount().GetUsername(),
+		Password: password,
+	}
+
+	secretData, err := uc.CreateSecret(ctx, req)
+	if err != nil {
+		return nil, "", err
+	}
+	// Nothing is stored in dry run, the generated password is never used and is not returned
+	if dryrun.FromContext(ctx) != nil {
+		return secretData, "", nil
+	}
+	return secretData, password, nil
+}
+
+// generatePassword returns a random password which has at least one character of each class in the policy.
+func generatePassword(policy *conf.Server_PasswordPolicy) (string, error) {
+	length := int(policy.GetLength())
+	if length == 0 {
+		length = defaultPasswordLength
+	}
+	classes := policy.GetCharacterClasses()
+	if len(classes) == 0 {
+		classes = []string{CharacterClassLowercase, CharacterClassUppercase, CharacterClassDigits, CharacterClassSymbols}
+	}
+	if length < len(classes) {
+		return "", fmt.Errorf("length %d is less than the number of character classes", length)
+	}
+
+	var charsets [][]rune
+	for _, class := range classes {
+		charset := passwordCharacterClasses[class]
+		if class == CharacterClassSymbols {
+			charset = policy.GetSymbols()
+			if charset == "" {
+				charset = defaultPasswordSymbols
+			}
+		}
+		if charset == "" {
+			return "", fmt.Errorf("character class %s is unknown", class)
+		}
+		charsets = append(charsets, []rune(charset))
+	}
+
+	// The symbols may be not ascii, the password is built by runes
+	var all []rune
+	password := make([]rune, 0, length)
+	for _, charset := range charsets {
+		all = append(all, charset...)
+		c, err := randomChar(charset)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+	for len(password) < length {
+		c, err := randomChar(all)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+
+	// Shuffle the password, otherwise the leading characters are in the order of the classes
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+	return string(password), nil
+}
+
+func randomChar(charset []rune) (rune, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(charset))))
+	if err != nil {
+		return 0, err
+	}
+	return charset[n.Int64()], nil
+}
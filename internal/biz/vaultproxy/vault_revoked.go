@@ -0,0 +1,147 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaultproxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	pb "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+
+	vault "github.com/hashicorp/vault/api"
+)
+
+// Key of the custom metadata which records the roles the policy is revoked from by the soft delete,
+// the paths of the roles are joined by ",". The roles which do not fit in one value are continued in the keys
+// with a numeric suffix, such as "revoked_roles_1".
+const revokedRolesMetadataKey = "revoked_roles"
+
+const (
+	// Vault rejects the custom metadata value longer than 512 bytes
+	maxCustomMetadataValueLength = 512
+	// Vault allows 64 keys in the custom metadata, the others are left for the provenance
+	maxRevokedRolesKeys = 32
+)
+
+func revokedRolesKey(i int) string {
+	if i == 0 {
+		return revokedRolesMetadataKey
+	}
+	return fmt.Sprintf("%s_%d", revokedRolesMetadataKey, i)
+}
+
+// revokedRolesMetadata splits the roles into the custom metadata values of at most 512 bytes,
+// the keys which are not used are removed. Nil roles remove all the keys.
+func revokedRolesMetadata(roles []string) (map[string]interface{}, error) {
+	var values []string
+	var value string
+	for _, role := range roles {
+		if len(role) > maxCustomMetadataValueLength {
+			return nil, fmt.Errorf("role path %s is longer than %d bytes", role, maxCustomMetadataValueLength)
+		}
+		if value != "" && len(value)+1+len(role) > maxCustomMetadataValueLength {
+			values = append(values, value)
+			value = ""
+		}
+		if value != "" {
+			value += ","
+		}
+		value += role
+	}
+	if value != "" {
+		values = append(values, value)
+	}
+	if len(values) > maxRevokedRolesKeys {
+		return nil, fmt.Errorf("%d roles can not be recorded in the custom metadata, configure the journal to record them", len(roles))
+	}
+
+	metadata := map[string]interface{}{}
+	for i := 0; i < maxRevokedRolesKeys; i++ {
+		metadata[revokedRolesKey(i)] = nil
+		if i < len(values) {
+			metadata[revokedRolesKey(i)] = values[i]
+		}
+	}
+	return metadata, nil
+}
+
+// revokedRolesPath is the path of the roles revoked by the soft delete of the secret in the journal.
+func (j *journal) revokedRolesPath(policyName string) string {
+	return j.entryPath(fmt.Sprintf("revoked-roles/%s", policyName))
+}
+
+// loadRevokedRoles returns the roles recorded by the soft deletes of the secret,
+// both the roles in the custom metadata and the roles in the journal are returned.
+func (uc *VaultUsercase) loadRevokedRoles(ctx context.Context, secret *pb.SecretRequest, customMetadata map[string]interface{}) ([]string, error) {
+	var roles []string
+	for i := 0; i < maxRevokedRolesKeys; i++ {
+		value, _ := customMetadata[revokedRolesKey(i)].(string)
+		roles = append(roles, strings.Split(value, ",")...)
+	}
+	if uc.journal != nil {
+		kv, err := uc.client.GetSecret(ctx, uc.journal.secretName, uc.journal.revokedRolesPath(secret.PolicyName))
+		if err != nil && !errors.Is(err, vault.ErrSecretNotFound) {
+			return nil, err
+		}
+		if kv != nil && kv.Data != nil {
+			recorded, _ := kv.Data["roles"].([]interface{})
+			for _, role := range recorded {
+				if rolePath, ok := role.(string); ok {
+					roles = append(roles, rolePath)
+				}
+			}
+		}
+	}
+	return uniqueRoles(roles), nil
+}
+
+// saveRevokedRoles records the roles the policy of the secret is going to be revoked from.
+// They are recorded in the journal if it is enabled, which has no limit of the length, otherwise in the custom metadata.
+func (uc *VaultUsercase) saveRevokedRoles(ctx context.Context, secret *pb.SecretRequest, roles []string) error {
+	if uc.journal != nil {
+		_, err := uc.client.CreateSecret(ctx, uc.journal.secretName, uc.journal.revokedRolesPath(secret.PolicyName), map[string]interface{}{
+			"roles": roles,
+		})
+		return err
+	}
+	metadata, err := revokedRolesMetadata(roles)
+	if err != nil {
+		return err
+	}
+	return uc.client.PatchSecretMetadata(ctx, secret.SecretName, secret.SecretPath, metadata)
+}
+
+// removeRevokedRoles removes the roles of the secret recorded in the journal,
+// the custom metadata is removed with the other metadata by the caller.
+func (uc *VaultUsercase) removeRevokedRoles(ctx context.Context, secret *pb.SecretRequest) error {
+	if uc.journal == nil {
+		return nil
+	}
+	return uc.client.DeleteSecret(ctx, uc.journal.secretName, uc.journal.revokedRolesPath(secret.PolicyName))
+}
+
+func uniqueRoles(roles []string) []string {
+	var unique []string
+	seen := map[string]bool{}
+	for _, role := range roles {
+		if role != "" && !seen[role] {
+			seen[role] = true
+			unique = append(unique, role)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,123 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaultproxy
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	pb "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+
+	vault "github.com/hashicorp/vault/api"
+)
+
+// SecretRotation is the age of the current version of a secret.
+type SecretRotation struct {
+	SecretName  string
+	SecretPath  string
+	SecretType  string
+	Version     int
+	VersionTime time.Time
+	Age         time.Duration
+	CreatedBy   string
+	// Roles which have the policy of the secret
+	Roles []string
+	// Rotation period of the secret type, 0 means the secret is never stale
+	RotationPeriod time.Duration
+	Stale          bool
+}
+
+type RotationReport struct {
+	CheckedTime time.Time
+	Rotations   []SecretRotation
+}
+
+// ReportRotations scans the managed secret engines and returns the age of the current version of each secret,
+// the secrets older than the rotation period of their type are stale. Only the stale secrets are returned if staleOnly is true.
+func (uc *VaultUsercase) ReportRotations(ctx context.Context, staleOnly bool) (*RotationReport, error) {
+	rolesOfPolicies := map[string][]string{}
+	err := uc.walkRoles(ctx, func(rolePath string, roleCFG *vault.Secret) error {
+		policyList, _ := roleCFG.Data["token_policies"].([]interface{})
+		for _, policy := range policyList {
+			policyName, _ := policy.(string)
+			rolesOfPolicies[policyName] = append(rolesOfPolicies[policyName], rolePath)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, pb.ErrorInternalServiceError("list roles failed: %s", err)
+	}
+
+	report := &RotationReport{CheckedTime: time.Now()}
+	for _, secretName := range pb.SecretNames() {
+		var secrets []*pb.SecretRequest
+		_, err := uc.walkSecret(ctx, secretName, "", "", nil, func(secretPath string) bool {
+			secret, err := pb.NewSecretRequestFromPath(secretName, secretPath)
+			if err == nil {
+				secrets = append(secrets, secret)
+			}
+			return true
+		})
+		if err != nil {
+			return nil, pb.ErrorInternalServiceError("list secrets in %s failed: %s", secretName, err)
+		}
+
+		for _, secret := range secrets {
+			metadata, err := uc.client.GetSecretMetadata(ctx, secret.SecretName, secret.SecretPath)
+			if errors.Is(err, vault.ErrSecretNotFound) {
+				continue
+			} else if err != nil {
+				return nil, pb.ErrorInternalServiceError("get metadata of secret %s in %s failed: %s", secret.SecretPath, secret.SecretName, err)
+			}
+			version, ok := metadata.Versions[strconv.Itoa(metadata.CurrentVersion)]
+			if !ok || !version.DeletionTime.IsZero() || version.Destroyed {
+				continue
+			}
+
+			rotation := SecretRotation{
+				SecretName:     secret.SecretName,
+				SecretPath:     secret.SecretPath,
+				SecretType:     secret.SecretType,
+				Version:        metadata.CurrentVersion,
+				VersionTime:    version.CreatedTime,
+				Age:            report.CheckedTime.Sub(version.CreatedTime),
+				CreatedBy:      newProvenance(metadata.CustomMetadata).CreatedBy,
+				Roles:          rolesOfPolicies[secret.PolicyName],
+				RotationPeriod: uc.rotationPeriod(secret.SecretType),
+			}
+			if secret.LegacyPolicyName != secret.PolicyName {
+				rotation.Roles = append(rotation.Roles, rolesOfPolicies[secret.LegacyPolicyName]...)
+			}
+			sort.Strings(rotation.Roles)
+			rotation.Stale = rotation.RotationPeriod > 0 && rotation.Age > rotation.RotationPeriod
+			if staleOnly && !rotation.Stale {
+				continue
+			}
+			report.Rotations = append(report.Rotations, rotation)
+		}
+	}
+	return report, nil
+}
+
+// rotationPeriod returns the rotation period of the secret type, the default period is used if the type is not configured.
+func (uc *VaultUsercase) rotationPeriod(secretType string) time.Duration {
+	if period, ok := uc.rotation.GetPeriods()[secretType]; ok {
+		return period.AsDuration()
+	}
+	return uc.rotation.GetDefaultPeriod().AsDuration()
+}
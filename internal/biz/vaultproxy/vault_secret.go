@@ -16,9 +16,17 @@ package vaultproxy
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	pb "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+	vpData "github.com/nautes-labs/vault-proxy/internal/data"
+	"github.com/nautes-labs/vault-proxy/internal/pkg/dryrun"
+
+	vault "github.com/hashicorp/vault/api"
 )
 
 type SecretData struct {
@@ -27,7 +35,27 @@ type SecretData struct {
 	SecretVersion int
 }
 
+type SecretMetadata struct {
+	SecretData
+	PolicyName  string
+	CreatedTime time.Time
+	UpdatedTime time.Time
+	Provenance  Provenance
+}
+
 func (uc *VaultUsercase) CreateSecret(ctx context.Context, req pb.SecRequest) (*SecretData, error) {
+	secret, err := uc.checkSecretToCreate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if plan := dryrun.FromContext(ctx); plan != nil {
+		return uc.planCreateSecret(ctx, plan, secret)
+	}
+	return uc.createSecret(ctx, secret)
+}
+
+// checkSecretToCreate converts the request and checks the data, the policy and the owner of the secret before it is written.
+func (uc *VaultUsercase) checkSecretToCreate(ctx context.Context, req pb.SecRequest) (*pb.SecretRequest, error) {
 	secret, err := req.ConvertRequest()
 	if err != nil {
 		return nil, pb.ErrorInputArgError("convert to secret failed: %s", err)
@@ -35,26 +63,77 @@ func (uc *VaultUsercase) CreateSecret(ctx context.Context, req pb.SecRequest) (*
 	if !verifySecret(secret) {
 		return nil, errorSecretVerifyFailed
 	}
+	if violations := pb.ValidateSecretData(secret.SecretType, secret.SecretData); len(violations) != 0 {
+		return nil, newSchemaError(secret.SecretType, violations)
+	}
+	if err := verifyClusterSecret(secret); err != nil {
+		return nil, err
+	}
+	// The policy is checked before the secret is written, so that an invalid policy does not need the rollback
+	if err := pb.ValidatePolicy(secret.PolicyData); err != nil {
+		return nil, pb.ErrorInputArgError("policy %s is invalid: %s", secret.PolicyName, err)
+	}
+	if err := uc.checkOwner(ctx, secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+func (uc *VaultUsercase) createSecret(ctx context.Context, secret *pb.SecretRequest) (*SecretData, error) {
 	secretName := secret.SecretName
 	secretPath := secret.SecretPath
 	data := secret.SecretData
 	policyPath := secret.PolicyName
 	policyData := secret.PolicyData
 
-	kv, err := uc.client.CreateSecret(ctx, secretName, secretPath, data)
+	entry, err := uc.beginJournal(ctx, secret)
+	if err != nil {
+		return nil, pb.ErrorInternalServiceError("record journal of secret %s in %s failed: %s", secretPath, secretName, err)
+	}
+
+	var opts []vault.KVOption
+	if secret.CheckAndSet != nil {
+		opts = append(opts, vault.WithCheckAndSet(*secret.CheckAndSet))
+	}
+	kv, err := uc.client.CreateSecret(ctx, secretName, secretPath, data, opts...)
 	if err != nil {
+		uc.finishJournal(ctx, entry)
+		if errors.Is(err, vpData.ErrCheckAndSetMismatch) {
+			return nil, pb.ErrorVersionConflict("secret %s in %s is not at version %d", secretPath, secretName, *secret.CheckAndSet)
+		}
 		return nil, pb.ErrorInputArgError("create secret failed: %s", err)
 	}
 	uc.log.WithContext(ctx).Infof("start to create secret %s at sub path %s", secretName, secretPath)
 
+	if entry != nil {
+		entry.Version = kv.VersionMetadata.Version
+	}
+	uc.stepJournal(ctx, entry, stepWritePolicy)
+
 	err = uc.client.CreatePolicy(ctx, policyPath, policyData)
 	// If create policy failed, try to rollback secret to the last version
 	// If rollback failed, the policy will be removed
+	// If rollback failed, the journal is kept and the secret will be rolled back when vault proxy restarts
 	if err != nil {
+		uc.stepJournal(ctx, entry, stepRollback)
 		rollbackError := uc.revokeToPreVersion(ctx, secretName, secretPath)
+		if rollbackError == nil {
+			uc.finishJournal(ctx, entry)
+		}
 		return nil, pb.ErrorInputArgError("create policy %s failed, try to rollback secret %s at sub path %s: %s",
 			policyPath, secretName, secretPath, fmt.Errorf("%v: %s", err, rollbackError))
 	}
+	uc.finishJournal(ctx, entry)
+	if err := uc.recordVersionMetadata(ctx, secret, kv.VersionMetadata.Version); err != nil {
+		// Without the creator the secret could be changed by others when owner only is enabled, and without the expire time
+		// it is never purged, so the version is rolled back and the caller can retry the whole create
+		rollbackError := uc.revokeToPreVersion(ctx, secretName, secretPath)
+		if rollbackError == nil && kv.VersionMetadata.Version == 1 {
+			rollbackError = uc.client.DeletePolicy(ctx, policyPath)
+		}
+		return nil, pb.ErrorInternalServiceError("record metadata of secret %s in %s failed, try to rollback it: %s",
+			secretPath, secretName, fmt.Errorf("%v: %s", err, rollbackError))
+	}
 
 	return &SecretData{
 		SecretName:    secretName,
@@ -63,6 +142,22 @@ func (uc *VaultUsercase) CreateSecret(ctx context.Context, req pb.SecRequest) (*
 	}, nil
 }
 
+// newSchemaError lists the violations in the message, and sets them in the metadata with the keys as field names.
+func newSchemaError(secretType string, violations []pb.FieldViolation) error {
+	descriptions := make([]string, 0, len(violations))
+	metadata := make(map[string]string, len(violations))
+	for _, v := range violations {
+		descriptions = append(descriptions, v.String())
+		if old, ok := metadata[v.Field]; ok {
+			metadata[v.Field] = old + "; " + v.Description
+		} else {
+			metadata[v.Field] = v.Description
+		}
+	}
+	return pb.ErrorInputArgError("data of %s secret is invalid: %s", secretType, strings.Join(descriptions, "; ")).
+		WithMetadata(metadata)
+}
+
 func (uc *VaultUsercase) revokeToPreVersion(ctx context.Context, secretName, secretPath string) error {
 	versionList, err := uc.client.GetSecretVersionList(ctx, secretName, secretPath)
 	if err != nil {
@@ -70,7 +165,7 @@ func (uc *VaultUsercase) revokeToPreVersion(ctx context.Context, secretName, sec
 	}
 	index := len(versionList)
 	if index > 1 {
-		err := uc.client.RollBackSecret(ctx, secretName, secretPath, versionList[index-2].Version)
+		_, err := uc.client.RollBackSecret(ctx, secretName, secretPath, versionList[index-2].Version)
 		if err != nil {
 			return err
 		}
@@ -83,32 +178,314 @@ func (uc *VaultUsercase) revokeToPreVersion(ctx context.Context, secretName, sec
 	return nil
 }
 
-func (uc *VaultUsercase) DeleteSecret(ctx context.Context, req pb.SecRequest) error {
+// DeleteSecret soft deletes the latest version of the secret and revokes its policy from all roles.
+// The policy itself is kept and the revoked roles are recorded in the secret metadata, so the secret can be undeleted.
+// It returns the paths of the roles the policy is revoked from.
+func (uc *VaultUsercase) DeleteSecret(ctx context.Context, req pb.SecRequest) ([]string, error) {
+	secret, err := req.ConvertRequest()
+	if err != nil {
+		return nil, pb.ErrorInputArgError("convert to secret failed: %s", err)
+	}
+	if !verifySecret(secret) {
+		return nil, errorSecretVerifyFailed
+	}
+	if err := uc.checkOwner(ctx, secret); err != nil {
+		return nil, err
+	}
+	if plan := dryrun.FromContext(ctx); plan != nil {
+		return uc.planDeleteSecret(ctx, plan, secret)
+	}
+	return uc.softDeleteSecret(ctx, secret)
+}
+
+// DeleteSecretRecursive soft deletes the secret and all the secrets under it.
+// It returns the paths of the deleted secrets and the roles the policies are revoked from.
+func (uc *VaultUsercase) DeleteSecretRecursive(ctx context.Context, req pb.SecRequest, deletable func(resource string) bool) (deletedPaths, revokedRoles []string, err error) {
 	secret, err := req.ConvertRequest()
 	if err != nil {
-		return pb.ErrorInputArgError("convert to secret failed: %s", err)
+		return nil, nil, pb.ErrorInputArgError("convert to secret failed: %s", err)
 	}
 	if !verifySecret(secret) {
-		return errorSecretVerifyFailed
+		return nil, nil, errorSecretVerifyFailed
 	}
+	if dryrun.FromContext(ctx) != nil {
+		return nil, nil, pb.ErrorInputArgError("dry run is not supported by recursive delete")
+	}
+
+	var children []*pb.SecretRequest
+	_, err = uc.walkSecret(ctx, secret.SecretName, secret.SecretPath+"/", "", nil, func(secretPath string) bool {
+		child, err := pb.NewSecretRequestFromPath(secret.SecretName, secretPath)
+		if err != nil {
+			uc.log.WithContext(ctx).Warnf("skip %s in %s: %s", secretPath, secret.SecretName, err)
+			return true
+		}
+		children = append(children, child)
+		return true
+	})
+	if err != nil {
+		return nil, nil, pb.ErrorInternalServiceError("list secrets under %s in %s failed: %s", secret.SecretPath, secret.SecretName, err)
+	}
+
+	// All the secrets are checked before any of them is deleted, the parent is authorized by the request
+	secrets := append(children, secret)
+	for _, child := range children {
+		if deletable != nil && !deletable(child.FullPath) {
+			return nil, nil, pb.ErrorActionNotAllow("delete %s is not allowed by current user", child.FullPath)
+		}
+	}
+	for _, sec := range secrets {
+		if err := uc.checkOwner(ctx, sec); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	revoked := map[string]bool{}
+	for _, sec := range secrets {
+		roles, err := uc.softDeleteSecret(ctx, sec)
+		if err != nil {
+			return deletedPaths, revokedRoles, err
+		}
+		deletedPaths = append(deletedPaths, sec.SecretPath)
+		for _, role := range roles {
+			if !revoked[role] {
+				revoked[role] = true
+				revokedRoles = append(revokedRoles, role)
+			}
+		}
+	}
+	return deletedPaths, revokedRoles, nil
+}
+
+// UndeleteSecret restores the latest version of a soft deleted secret, then writes its policy
+// and grants the policy to the roles it was revoked from by the delete, roles which no longer exist are skipped.
+// It returns the paths of the roles the policy is granted to.
+func (uc *VaultUsercase) UndeleteSecret(ctx context.Context, req pb.SecActionRequest) (*SecretData, []string, error) {
+	secret, err := req.ConvertRequest()
+	if err != nil {
+		return nil, nil, pb.ErrorInputArgError("convert to secret failed: %s", err)
+	}
+	if !verifySecret(secret) {
+		return nil, nil, errorSecretVerifyFailed
+	}
+	if err := uc.checkOwner(ctx, secret); err != nil {
+		return nil, nil, err
+	}
+	return uc.undeleteSecret(ctx, secret)
+}
+
+func (uc *VaultUsercase) undeleteSecret(ctx context.Context, secret *pb.SecretRequest) (*SecretData, []string, error) {
 	secretName := secret.SecretName
 	secretPath := secret.SecretPath
 	policyPath := secret.PolicyName
 
-	err = uc.client.DeletePolicy(ctx, policyPath)
+	metadata, err := uc.client.GetSecretMetadata(ctx, secretName, secretPath)
+	if errors.Is(err, vault.ErrSecretNotFound) {
+		return nil, nil, pb.ErrorResourceNotFound("can not find secret %s in %s", secretPath, secretName)
+	} else if err != nil {
+		return nil, nil, pb.ErrorInternalServiceError("get metadata of secret %s in %s failed: %s", secretPath, secretName, err)
+	}
+	version := metadata.CurrentVersion
+	latest := metadata.Versions[strconv.Itoa(version)]
+	if latest.Destroyed {
+		return nil, nil, pb.ErrorInputArgError("version %d of secret %s in %s is destroyed", version, secretPath, secretName)
+	}
+	if latest.DeletionTime.IsZero() {
+		return nil, nil, pb.ErrorInputArgError("secret %s in %s is not deleted", secretPath, secretName)
+	}
+	if err := pb.ValidatePolicy(secret.PolicyData); err != nil {
+		return nil, nil, pb.ErrorInputArgError("policy %s is invalid: %s", policyPath, err)
+	}
+
+	err = uc.client.UndeleteSecret(ctx, secretName, secretPath, []int{version})
+	if err != nil {
+		return nil, nil, pb.ErrorInternalServiceError("undelete secret %s in %s failed: %s", secretPath, secretName, err)
+	}
+	err = uc.client.CreatePolicy(ctx, policyPath, secret.PolicyData)
 	if err != nil {
-		return pb.ErrorInternalServiceError("delete policy of %s in %s failed", secretPath, secretName)
+		return nil, nil, pb.ErrorInternalServiceError("create policy of %s in %s failed: %s", secretPath, secretName, err)
+	}
+
+	recorded, err := uc.loadRevokedRoles(ctx, secret, metadata.CustomMetadata)
+	if err != nil {
+		return nil, nil, pb.ErrorInternalServiceError("get revoked roles of %s in %s failed: %s", secretPath, secretName, err)
+	}
+	var grantedRoles []string
+	for _, rolePath := range recorded {
+		granted, err := uc.grantPolicyToRole(ctx, rolePath, policyPath)
+		if err != nil {
+			return nil, grantedRoles, pb.ErrorInternalServiceError("grant policy of %s in %s to %s failed: %s", secretPath, secretName, rolePath, err)
+		}
+		if !granted {
+			uc.log.WithContext(ctx).Warnf("role %s is not existed, skip granting policy %s", rolePath, policyPath)
+			continue
+		}
+		grantedRoles = append(grantedRoles, rolePath)
+	}
+
+	cleared, _ := revokedRolesMetadata(nil)
+	err = uc.client.PatchSecretMetadata(ctx, secretName, secretPath, cleared)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("remove revoked roles from metadata of secret %s in %s failed: %s", secretPath, secretName, err)
+	}
+	if err := uc.removeRevokedRoles(ctx, secret); err != nil {
+		uc.log.WithContext(ctx).Errorf("remove revoked roles of secret %s in %s from journal failed: %s", secretPath, secretName, err)
+	}
+
+	return &SecretData{
+		SecretName:    secretName,
+		SecretPath:    secretPath,
+		SecretVersion: version,
+	}, grantedRoles, nil
+}
+
+// PurgeSecret revokes the policy of the secret from all roles, then deletes the policy and destroys all the versions of the secret.
+// It returns the paths of the roles the policy is revoked from.
+func (uc *VaultUsercase) PurgeSecret(ctx context.Context, req pb.SecActionRequest) ([]string, error) {
+	secret, err := req.ConvertRequest()
+	if err != nil {
+		return nil, pb.ErrorInputArgError("convert to secret failed: %s", err)
+	}
+	if !verifySecret(secret) {
+		return nil, errorSecretVerifyFailed
+	}
+	if err := uc.checkOwner(ctx, secret); err != nil {
+		return nil, err
+	}
+	return uc.purgeSecret(ctx, secret)
+}
+
+// softDeleteSecret records the roles which have the policies of the secret before any of them is revoked,
+// so that the roles can be granted again by the undelete even if the delete fails after the revoke.
+func (uc *VaultUsercase) softDeleteSecret(ctx context.Context, secret *pb.SecretRequest) ([]string, error) {
+	secretName := secret.SecretName
+	secretPath := secret.SecretPath
+
+	metadata, err := uc.client.GetSecretMetadata(ctx, secretName, secretPath)
+	if errors.Is(err, vault.ErrSecretNotFound) {
+		metadata = nil
+	} else if err != nil {
+		return nil, pb.ErrorInternalServiceError("get metadata of secret %s in %s failed: %s", secretPath, secretName, err)
+	}
+
+	policyNames, err := uc.policyNamesOf(ctx, secret)
+	if err != nil {
+		return nil, pb.ErrorInternalServiceError("get policies of %s in %s failed: %s", secretPath, secretName, err)
+	}
+
+	// Roles recorded by the previous delete are kept, the secret may be deleted again before undeleted
+	var roles []string
+	if metadata != nil {
+		recorded, err := uc.loadRevokedRoles(ctx, secret, metadata.CustomMetadata)
+		if err != nil {
+			return nil, pb.ErrorInternalServiceError("get revoked roles of %s in %s failed: %s", secretPath, secretName, err)
+		}
+		planned, err := uc.rolesWithPolicy(ctx, policyNames...)
+		if err != nil {
+			return nil, pb.ErrorInternalServiceError("get roles of %s in %s failed: %s", secretPath, secretName, err)
+		}
+		roles = uniqueRoles(append(recorded, planned...))
+		if err := uc.saveRevokedRoles(ctx, secret, roles); err != nil {
+			return nil, pb.ErrorInternalServiceError("record revoked roles of %s in %s failed: %s", secretPath, secretName, err)
+		}
+	}
+
+	revokedRoles, err := uc.revokePolicyFromRoles(ctx, policyNames...)
+	if err != nil {
+		return nil, pb.ErrorInternalServiceError("revoke policy of %s in %s from roles failed: %s", secretPath, secretName, err)
+	}
+	// There is nothing to delete, the policy left is cleaned by the reconciler
+	if metadata == nil {
+		return revokedRoles, nil
+	}
+
+	// The policy may be granted to more roles after they are recorded
+	if all := uniqueRoles(append(roles, revokedRoles...)); len(all) != len(roles) {
+		if err := uc.saveRevokedRoles(ctx, secret, all); err != nil {
+			return nil, pb.ErrorInternalServiceError("record revoked roles of %s in %s failed: %s", secretPath, secretName, err)
+		}
+	}
+
+	err = uc.client.SoftDeleteSecret(ctx, secretName, secretPath)
+	if err != nil {
+		return nil, pb.ErrorInternalServiceError("delete secret %s in %s failed: %s", secretPath, secretName, err)
+	}
+
+	return revokedRoles, nil
+}
+
+func (uc *VaultUsercase) purgeSecret(ctx context.Context, secret *pb.SecretRequest) ([]string, error) {
+	secretName := secret.SecretName
+	secretPath := secret.SecretPath
+
+	policyNames, err := uc.policyNamesOf(ctx, secret)
+	if err != nil {
+		return nil, pb.ErrorInternalServiceError("get policies of %s in %s failed: %s", secretPath, secretName, err)
+	}
+	revokedRoles, err := uc.revokePolicyFromRoles(ctx, policyNames...)
+	if err != nil {
+		return nil, pb.ErrorInternalServiceError("revoke policy of %s in %s from roles failed: %s", secretPath, secretName, err)
+	}
+
+	for _, policyName := range policyNames {
+		err = uc.client.DeletePolicy(ctx, policyName)
+		if err != nil {
+			return nil, pb.ErrorInternalServiceError("delete policy %s of %s in %s failed", policyName, secretPath, secretName)
+		}
 	}
 	err = uc.client.DeleteSecret(ctx, secretName, secretPath)
 	if err != nil {
-		return pb.ErrorInternalServiceError("delete secret %s in %s failed", secretPath, secretName)
+		return nil, pb.ErrorInternalServiceError("purge secret %s in %s failed", secretPath, secretName)
+	}
+	if err := uc.removeRevokedRoles(ctx, secret); err != nil {
+		uc.log.WithContext(ctx).Errorf("remove revoked roles of secret %s in %s from journal failed: %s", secretPath, secretName, err)
 	}
 
-	return nil
+	return revokedRoles, nil
 }
 
+// GetSecret returns the metadata and the provenance of the secret, secret values are never read.
+func (uc *VaultUsercase) GetSecret(ctx context.Context, req pb.SecRequest) (*SecretMetadata, error) {
+	secret, err := req.ConvertRequest()
+	if err != nil {
+		return nil, pb.ErrorInputArgError("convert to secret failed: %s", err)
+	}
+	if !verifySecret(secret) {
+		return nil, errorSecretVerifyFailed
+	}
+	secretName := secret.SecretName
+	secretPath := secret.SecretPath
+
+	metadata, err := uc.client.GetSecretMetadata(ctx, secretName, secretPath)
+	if errors.Is(err, vault.ErrSecretNotFound) {
+		return nil, pb.ErrorResourceNotFound("can not find secret %s in %s", secretPath, secretName)
+	} else if err != nil {
+		return nil, pb.ErrorInternalServiceError("get metadata of secret %s in %s failed: %s", secretPath, secretName, err)
+	}
+
+	return &SecretMetadata{
+		SecretData: SecretData{
+			SecretName:    secretName,
+			SecretPath:    secretPath,
+			SecretVersion: metadata.CurrentVersion,
+		},
+		PolicyName:  secret.PolicyName,
+		CreatedTime: metadata.CreatedTime,
+		UpdatedTime: metadata.UpdatedTime,
+		Provenance:  newProvenance(metadata.CustomMetadata),
+	}, nil
+}
+
+// verifySecret checks the full path only contains path safe chars.
+// Dots are only allowed for the domains of pki secrets, but relative segments are not.
 func verifySecret(sec *pb.SecretRequest) bool {
+	allowDot := sec.SecretType == pb.PKI.String()
+	if strings.Contains(sec.FullPath, "..") {
+		return false
+	}
 	for _, char := range sec.FullPath {
+		if char == '.' && allowDot {
+			continue
+		}
 		if (char < 'a' || char > 'z') &&
 			(char < 'A' || char > 'Z') &&
 			(char < '0' || char > '9') &&
@@ -0,0 +1,138 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vaultproxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+
+	vault "github.com/hashicorp/vault/api"
+)
+
+type SecretVersion struct {
+	Version      int
+	CreatedTime  time.Time
+	DeletionTime time.Time
+	Destroyed    bool
+}
+
+// ListSecretVersions returns the versions of the secret in ascending order.
+func (uc *VaultUsercase) ListSecretVersions(ctx context.Context, req pb.SecRequest) ([]SecretVersion, error) {
+	secret, err := req.ConvertRequest()
+	if err != nil {
+		return nil, pb.ErrorInputArgError("convert to secret failed: %s", err)
+	}
+	if !verifySecret(secret) {
+		return nil, errorSecretVerifyFailed
+	}
+
+	versionList, err := uc.getSecretVersionList(ctx, secret.SecretName, secret.SecretPath)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]SecretVersion, 0, len(versionList))
+	for _, version := range versionList {
+		versions = append(versions, SecretVersion{
+			Version:      version.Version,
+			CreatedTime:  version.CreatedTime,
+			DeletionTime: version.DeletionTime,
+			Destroyed:    version.Destroyed,
+		})
+	}
+	return versions, nil
+}
+
+// RollbackSecret writes the data of the version in request as the newest version of the secret.
+// The data of the version is checked as the data of a new version. The policy of the secret is not changed.
+func (uc *VaultUsercase) RollbackSecret(ctx context.Context, req pb.SecRollbackRequest) (*SecretData, error) {
+	secret, err := req.ConvertRequest()
+	if err != nil {
+		return nil, pb.ErrorInputArgError("convert to secret failed: %s", err)
+	}
+	if !verifySecret(secret) {
+		return nil, errorSecretVerifyFailed
+	}
+	if err := uc.checkOwner(ctx, secret); err != nil {
+		return nil, err
+	}
+	secretName := secret.SecretName
+	secretPath := secret.SecretPath
+	toVersion := int(req.GetVersion())
+
+	versionList, err := uc.getSecretVersionList(ctx, secretName, secretPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var target *vault.KVVersionMetadata
+	for i := range versionList {
+		if versionList[i].Version == toVersion {
+			target = &versionList[i]
+			break
+		}
+	}
+	if target == nil {
+		return nil, pb.ErrorResourceNotFound("can not find version %d of secret %s in %s", toVersion, secretPath, secretName)
+	}
+	if target.Destroyed || !target.DeletionTime.IsZero() {
+		return nil, pb.ErrorInputArgError("version %d of secret %s in %s is deleted or destroyed", toVersion, secretPath, secretName)
+	}
+
+	// The target version may be written before the checks of the secret type, such as the schema and the kubeconfig,
+	// it is checked as a new version, and its data is used to record the metadata of the secret
+	targetKV, err := uc.client.GetSecretVersion(ctx, secretName, secretPath, toVersion)
+	if err != nil {
+		return nil, pb.ErrorInternalServiceError("get version %d of secret %s in %s failed: %s", toVersion, secretPath, secretName, err)
+	}
+	secret.SecretData = targetKV.Data
+	if violations := pb.ValidateSecretData(secret.SecretType, secret.SecretData); len(violations) != 0 {
+		return nil, newSchemaError(secret.SecretType, violations)
+	}
+	if err := verifyClusterSecret(secret); err != nil {
+		return nil, err
+	}
+
+	kv, err := uc.client.RollBackSecret(ctx, secretName, secretPath, toVersion)
+	if err != nil {
+		return nil, pb.ErrorInternalServiceError("roll back secret %s in %s to version %d failed: %s", secretPath, secretName, toVersion, err)
+	}
+	uc.log.WithContext(ctx).Infof("secret %s in %s is rolled back to version %d", secretPath, secretName, toVersion)
+	if err := uc.recordVersionMetadata(ctx, secret, kv.VersionMetadata.Version); err != nil {
+		rollbackError := uc.revokeToPreVersion(ctx, secretName, secretPath)
+		return nil, pb.ErrorInternalServiceError("record metadata of secret %s in %s failed, try to revert the rollback to version %d: %s",
+			secretPath, secretName, toVersion, fmt.Errorf("%v: %s", err, rollbackError))
+	}
+
+	return &SecretData{
+		SecretName:    secretName,
+		SecretPath:    secretPath,
+		SecretVersion: kv.VersionMetadata.Version,
+	}, nil
+}
+
+func (uc *VaultUsercase) getSecretVersionList(ctx context.Context, secretName, secretPath string) ([]vault.KVVersionMetadata, error) {
+	versionList, err := uc.client.GetSecretVersionList(ctx, secretName, secretPath)
+	if errors.Is(err, vault.ErrSecretNotFound) {
+		return nil, pb.ErrorResourceNotFound("can not find secret %s in %s", secretPath, secretName)
+	} else if err != nil {
+		return nil, pb.ErrorInternalServiceError("get version list of secret %s in %s failed: %s", secretPath, secretName, err)
+	}
+	return versionList, nil
+}
@@ -16,4 +16,4 @@ package vaultproxy
 
 import "github.com/google/wire"
 
-var ProviderSet = wire.NewSet(NewVaultUsercase)
+var ProviderSet = wire.NewSet(NewVaultUsercase, NewReconciler, NewReaper)
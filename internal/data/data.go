@@ -25,7 +25,7 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewVaultClient)
+var ProviderSet = wire.NewSet(NewData, NewVaultClient, NewIdempotencyStore)
 
 // Data .
 type Data struct {
@@ -40,15 +40,22 @@ func NewData(_ *conf.Data, logger log.Logger) (*Data, func(), error) {
 }
 
 type VaultClientInterface interface {
-	CreateSecret(ctx context.Context, secretName string, path string, data map[string]interface{}) (*vault.KVSecret, error)
+	CreateSecret(ctx context.Context, secretName string, path string, data map[string]interface{}, opts ...vault.KVOption) (*vault.KVSecret, error)
 	GetSecret(ctx context.Context, secretName, path string) (*vault.KVSecret, error)
+	GetSecretVersion(ctx context.Context, secretName, path string, version int) (*vault.KVSecret, error)
 	DeleteSecret(ctx context.Context, secretName string, path string) error
-	RollBackSecret(ctx context.Context, secretName string, path string, toVersion int) error
+	SoftDeleteSecret(ctx context.Context, secretName string, path string) error
+	UndeleteSecret(ctx context.Context, secretName string, path string, versions []int) error
+	RollBackSecret(ctx context.Context, secretName string, path string, toVersion int) (*vault.KVSecret, error)
 	GetSecretVersionList(ctx context.Context, secretName string, path string) ([]vault.KVVersionMetadata, error)
+	GetSecretMetadata(ctx context.Context, secretName string, path string) (*vault.KVMetadata, error)
+	PatchSecretMetadata(ctx context.Context, secretName string, path string, customMetadata map[string]interface{}) error
+	ListSecret(ctx context.Context, secretName string, path string) ([]string, error)
 	CreatePolicy(ctx context.Context, path string, policy string) error
 	GetPolicy(ctx context.Context, path string) (string, error)
 	DeletePolicy(ctx context.Context, path string) error
 	Read(ctx context.Context, path string) (*vault.Secret, error)
+	List(ctx context.Context, path string) (*vault.Secret, error)
 	Write(ctx context.Context, path string, data map[string]interface{}) (*vault.Secret, error)
 	Delete(ctx context.Context, path string) (*vault.Secret, error)
 	EnableAuth(ctx context.Context, path string, authOptions *vault.MountInput) error
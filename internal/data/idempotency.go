@@ -0,0 +1,143 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package data
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nautes-labs/vault-proxy/internal/conf"
+	"github.com/nautes-labs/vault-proxy/internal/pkg/idempotency"
+
+	vault "github.com/hashicorp/vault/api"
+)
+
+// IdempotencyStore stores the records of the idempotency keys in a kv v2 path,
+// each key is stored at the sha256 hash of it, so that any key can be used as a path.
+type IdempotencyStore struct {
+	client     VaultClientInterface
+	secretName string
+	path       string
+}
+
+// NewIdempotencyStore returns nil if the idempotency is not configured, the idempotency keys are ignored then.
+func NewIdempotencyStore(client VaultClientInterface, c *conf.Data) idempotency.Store {
+	if c == nil || c.Idempotency == nil || c.Idempotency.SecretName == "" {
+		return nil
+	}
+	return &IdempotencyStore{
+		client:     client,
+		secretName: c.Idempotency.SecretName,
+		path:       strings.Trim(c.Idempotency.Path, "/"),
+	}
+}
+
+func (s *IdempotencyStore) recordPath(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	if s.path == "" {
+		return hex.EncodeToString(hash[:])
+	}
+	return fmt.Sprintf("%s/%s", s.path, hex.EncodeToString(hash[:]))
+}
+
+func (s *IdempotencyStore) Get(ctx context.Context, key string) (*idempotency.Record, int, error) {
+	kv, err := s.client.GetSecret(ctx, s.secretName, s.recordPath(key))
+	if errors.Is(err, vault.ErrSecretNotFound) {
+		return nil, 0, nil
+	} else if err != nil {
+		return nil, 0, err
+	}
+	version := 0
+	if kv.VersionMetadata != nil {
+		version = kv.VersionMetadata.Version
+	}
+
+	requestHash, _ := kv.Data["request_hash"].(string)
+	createdTime, _ := kv.Data["created_time"].(string)
+	reply, _ := kv.Data["reply"].(string)
+	generated, _ := kv.Data["generated"].(bool)
+	record := &idempotency.Record{
+		RequestHash: requestHash,
+		Reply:       []byte(reply),
+		Generated:   generated,
+	}
+	if record.CreatedTime, err = time.Parse(time.RFC3339Nano, createdTime); err != nil {
+		return nil, version, fmt.Errorf("created time of idempotency record is invalid: %w", err)
+	}
+	return record, version, nil
+}
+
+func (s *IdempotencyStore) Save(ctx context.Context, key string, record *idempotency.Record, version int) error {
+	data := map[string]interface{}{
+		"request_hash": record.RequestHash,
+		"created_time": record.CreatedTime.Format(time.RFC3339Nano),
+		"reply":        string(record.Reply),
+		"generated":    record.Generated,
+	}
+	_, err := s.client.CreateSecret(ctx, s.secretName, s.recordPath(key), data, vault.WithCheckAndSet(version))
+	if errors.Is(err, ErrCheckAndSetMismatch) {
+		return idempotency.ErrConflict
+	}
+	return err
+}
+
+func (s *IdempotencyStore) Delete(ctx context.Context, key string) error {
+	return s.client.DeleteSecret(ctx, s.secretName, s.recordPath(key))
+}
+
+// DeleteExpired lists the records in the path, a record with an invalid created time is deleted as well,
+// the records which can not be read or deleted are left for the next run.
+func (s *IdempotencyStore) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
+	keys, err := s.client.ListSecret(ctx, s.secretName, s.path)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	var errs []error
+	for _, key := range keys {
+		// The records are stored flat, a sub path is not a record
+		if strings.HasSuffix(key, "/") {
+			continue
+		}
+		recordPath := key
+		if s.path != "" {
+			recordPath = fmt.Sprintf("%s/%s", s.path, key)
+		}
+		kv, err := s.client.GetSecret(ctx, s.secretName, recordPath)
+		if errors.Is(err, vault.ErrSecretNotFound) {
+			continue
+		} else if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		createdTime, _ := kv.Data["created_time"].(string)
+		created, err := time.Parse(time.RFC3339Nano, createdTime)
+		if err == nil && !created.Before(before) {
+			continue
+		}
+		if err := s.client.DeleteSecret(ctx, s.secretName, recordPath); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		deleted++
+	}
+	return deleted, errors.Join(errs...)
+}
@@ -0,0 +1,147 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package data_test
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	vault "github.com/hashicorp/vault/api"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/nautes-labs/vault-proxy/internal/conf"
+	vpData "github.com/nautes-labs/vault-proxy/internal/data"
+	"github.com/nautes-labs/vault-proxy/internal/pkg/idempotency"
+)
+
+var _ = Describe("Idempotency Store", func() {
+	var vaultServer *exec.Cmd
+	var vaultRawClient *vault.Client
+	var store idempotency.Store
+
+	BeforeEach(func() {
+		vaultServer = exec.Command("vault", "server", "-dev", "-dev-root-token-id=test")
+		err := vaultServer.Start()
+		Expect(err).Should(BeNil())
+
+		for {
+			vaultServerHealthCheck := exec.Command("vault", "status", "-address=http://127.0.0.1:8200")
+			err := vaultServerHealthCheck.Run()
+			if err == nil {
+				break
+			}
+		}
+
+		cfg := vault.DefaultConfig()
+		cfg.Address = "http://127.0.0.1:8200"
+		vaultRawClient, err = vault.NewClient(cfg)
+		Expect(err).Should(BeNil())
+		vaultRawClient.SetToken("test")
+		err = vaultRawClient.Sys().Mount("git", &vault.MountInput{
+			Type:    "kv",
+			Options: map[string]string{"version": "2"},
+		})
+		Expect(err).Should(BeNil())
+
+		dataCfg := &conf.Data{
+			Vault: &conf.Data_Vault{
+				Addr:  "http://127.0.0.1:8200",
+				Token: "test",
+			},
+			Idempotency: &conf.Data_Idempotency{
+				SecretName: "git",
+				Path:       "vault-proxy/idempotency",
+			},
+		}
+		store = vpData.NewIdempotencyStore(vpData.NewVaultClient(dataCfg, log.DefaultLogger), dataCfg)
+	})
+
+	AfterEach(func() {
+		err := vaultServer.Process.Kill()
+		Expect(err).Should(BeNil())
+	})
+
+	It("return nil if the idempotency is not configured", func() {
+		Expect(vpData.NewIdempotencyStore(nil, &conf.Data{})).Should(BeNil())
+	})
+
+	It("only one request can claim the key", func() {
+		pending := &idempotency.Record{RequestHash: "hash", CreatedTime: time.Now()}
+		err := store.Save(context.Background(), "key", pending, 0)
+		Expect(err).Should(BeNil())
+		err = store.Save(context.Background(), "key", pending, 0)
+		Expect(errors.Is(err, idempotency.ErrConflict)).Should(BeTrue())
+
+		pending.Reply = []byte(`{"secret":{"name":"git"}}`)
+		err = store.Save(context.Background(), "key", pending, 1)
+		Expect(err).Should(BeNil())
+		record, version, err := store.Get(context.Background(), "key")
+		Expect(err).Should(BeNil())
+		Expect(version).Should(Equal(2))
+		Expect(record.RequestHash).Should(Equal("hash"))
+		Expect(record.Reply).Should(Equal(pending.Reply))
+	})
+
+	It("store the flag of the generated reply", func() {
+		err := store.Save(context.Background(), "key", &idempotency.Record{RequestHash: "hash", Generated: true, CreatedTime: time.Now()}, 0)
+		Expect(err).Should(BeNil())
+		record, _, err := store.Get(context.Background(), "key")
+		Expect(err).Should(BeNil())
+		Expect(record.Generated).Should(BeTrue())
+		Expect(record.Reply).Should(BeEmpty())
+	})
+
+	It("the key can be claimed again after it is released", func() {
+		err := store.Save(context.Background(), "key", &idempotency.Record{RequestHash: "hash", CreatedTime: time.Now()}, 0)
+		Expect(err).Should(BeNil())
+		err = store.Delete(context.Background(), "key")
+		Expect(err).Should(BeNil())
+
+		record, version, err := store.Get(context.Background(), "key")
+		Expect(err).Should(BeNil())
+		Expect(record).Should(BeNil())
+		Expect(version).Should(Equal(0))
+		err = store.Save(context.Background(), "key", &idempotency.Record{RequestHash: "other", CreatedTime: time.Now()}, version)
+		Expect(err).Should(BeNil())
+	})
+
+	It("delete the records created before the time", func() {
+		err := store.Save(context.Background(), "old", &idempotency.Record{RequestHash: "hash", CreatedTime: time.Now().Add(-2 * time.Hour)}, 0)
+		Expect(err).Should(BeNil())
+		err = store.Save(context.Background(), "new", &idempotency.Record{RequestHash: "hash", CreatedTime: time.Now()}, 0)
+		Expect(err).Should(BeNil())
+
+		deleted, err := store.DeleteExpired(context.Background(), time.Now().Add(-time.Hour))
+		Expect(err).Should(BeNil())
+		Expect(deleted).Should(Equal(1))
+
+		record, _, err := store.Get(context.Background(), "old")
+		Expect(err).Should(BeNil())
+		Expect(record).Should(BeNil())
+		record, _, err = store.Get(context.Background(), "new")
+		Expect(err).Should(BeNil())
+		Expect(record).ShouldNot(BeNil())
+	})
+
+	It("delete nothing if there is no record", func() {
+		deleted, err := store.DeleteExpired(context.Background(), time.Now())
+		Expect(err).Should(BeNil())
+		Expect(deleted).Should(Equal(0))
+	})
+})
@@ -19,8 +19,11 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
+	"fmt"
 	"net/http"
 	"os"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/nautes-labs/vault-proxy/internal/conf"
@@ -104,20 +107,51 @@ func (vc *VaultClient) GetSecret(ctx context.Context, secretname, path string) (
 	return kv, nil
 }
 
-func (vc *VaultClient) CreateSecret(ctx context.Context, secretName string, path string, data map[string]interface{}) (*vault.KVSecret, error) {
+func (vc *VaultClient) GetSecretVersion(ctx context.Context, secretname, path string, version int) (*vault.KVSecret, error) {
+	kv, err := vc.Client.KVv2(secretname).GetVersion(ctx, path, version)
+	if err != nil {
+		vc.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	vc.log.WithContext(ctx).Infof("get version %d of secret %s successed", version, path)
+	return kv, nil
+}
+
+// ErrCheckAndSetMismatch is returned when the cas option of the write does not match the current version.
+var ErrCheckAndSetMismatch = errors.New("check-and-set parameter did not match the current version")
+
+func isCheckAndSetMismatch(vaultErr *vault.ResponseError) bool {
+	for _, msg := range vaultErr.Errors {
+		if strings.Contains(msg, "check-and-set") {
+			return true
+		}
+	}
+	return false
+}
+
+func (vc *VaultClient) CreateSecret(ctx context.Context, secretName string, path string, data map[string]interface{}, opts ...vault.KVOption) (*vault.KVSecret, error) {
 	var kv *vault.KVSecret
+	var casErr error
 	err := retry.Do(
 		func() error {
 			var err error
-			kv, err = vc.Client.KVv2(secretName).Put(ctx, path, data)
+			kv, err = vc.Client.KVv2(secretName).Put(ctx, path, data, opts...)
 			if err != nil {
 				vc.log.WithContext(ctx).Error(err)
+				vaultErr, ok := errors.Unwrap(err).(*vault.ResponseError)
+				if ok && isCheckAndSetMismatch(vaultErr) {
+					casErr = fmt.Errorf("%w: %s", ErrCheckAndSetMismatch, err)
+					return casErr
+				}
 				return err
 			}
 			vc.log.WithContext(ctx).Infof("create secret %s successed", path)
 			return nil
 		},
 		retry.RetryIf(func(err error) bool {
+			if errors.Is(err, ErrCheckAndSetMismatch) {
+				return false
+			}
 			vaultErrPtr := errors.Unwrap(err)
 			vaultErr, ok := vaultErrPtr.(*vault.ResponseError)
 			if ok && vaultErr.StatusCode == 400 {
@@ -126,6 +160,9 @@ func (vc *VaultClient) CreateSecret(ctx context.Context, secretName string, path
 			}
 			return false
 		}))
+	if casErr != nil {
+		return nil, casErr
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -142,16 +179,37 @@ func (vc *VaultClient) DeleteSecret(ctx context.Context, secretName string, path
 	return nil
 }
 
-func (vc *VaultClient) RollBackSecret(ctx context.Context, secretName string, path string, toVersion int) error {
-	_, err := vc.Client.KVv2(secretName).Rollback(ctx, path, toVersion)
+// SoftDeleteSecret deletes the latest version of the secret, it can be undeleted later.
+func (vc *VaultClient) SoftDeleteSecret(ctx context.Context, secretName string, path string) error {
+	err := vc.Client.KVv2(secretName).Delete(ctx, path)
 	if err != nil {
 		vc.log.WithContext(ctx).Error(err)
 		return err
 	}
-	vc.log.WithContext(ctx).Infof("roll back secret %s successed", path)
+	vc.log.WithContext(ctx).Infof("soft delete secret %s successed", path)
 	return nil
 }
 
+func (vc *VaultClient) UndeleteSecret(ctx context.Context, secretName string, path string, versions []int) error {
+	err := vc.Client.KVv2(secretName).Undelete(ctx, path, versions)
+	if err != nil {
+		vc.log.WithContext(ctx).Error(err)
+		return err
+	}
+	vc.log.WithContext(ctx).Infof("undelete secret %s successed", path)
+	return nil
+}
+
+func (vc *VaultClient) RollBackSecret(ctx context.Context, secretName string, path string, toVersion int) (*vault.KVSecret, error) {
+	kv, err := vc.Client.KVv2(secretName).Rollback(ctx, path, toVersion)
+	if err != nil {
+		vc.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	vc.log.WithContext(ctx).Infof("roll back secret %s successed", path)
+	return kv, nil
+}
+
 func (vc *VaultClient) GetSecretVersionList(ctx context.Context, secretName string, path string) ([]vault.KVVersionMetadata, error) {
 	secretVersionList, err := vc.Client.KVv2(secretName).GetVersionsAsList(ctx, path)
 	if err != nil {
@@ -161,6 +219,50 @@ func (vc *VaultClient) GetSecretVersionList(ctx context.Context, secretName stri
 	return secretVersionList, nil
 }
 
+func (vc *VaultClient) GetSecretMetadata(ctx context.Context, secretName string, path string) (*vault.KVMetadata, error) {
+	metadata, err := vc.Client.KVv2(secretName).GetMetadata(ctx, path)
+	if err != nil {
+		vc.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	vc.log.WithContext(ctx).Infof("get secret metadata %s successed", path)
+	return metadata, nil
+}
+
+// PatchSecretMetadata merges the custom metadata into the secret metadata, a key with nil value is removed.
+func (vc *VaultClient) PatchSecretMetadata(ctx context.Context, secretName string, path string, customMetadata map[string]interface{}) error {
+	err := vc.Client.KVv2(secretName).PatchMetadata(ctx, path, vault.KVMetadataPatchInput{CustomMetadata: customMetadata})
+	if err != nil {
+		vc.log.WithContext(ctx).Error(err)
+		return err
+	}
+	vc.log.WithContext(ctx).Infof("patch secret metadata %s successed", path)
+	return nil
+}
+
+// ListSecret returns the sorted keys under the path, a key ends with "/" is a sub folder.
+func (vc *VaultClient) ListSecret(ctx context.Context, secretName string, path string) ([]string, error) {
+	sec, err := vc.Client.Logical().ListWithContext(ctx, fmt.Sprintf("%s/metadata/%s", secretName, path))
+	if err != nil {
+		vc.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	if sec == nil {
+		return nil, nil
+	}
+
+	rawKeys, _ := sec.Data["keys"].([]interface{})
+	keys := make([]string, 0, len(rawKeys))
+	for _, key := range rawKeys {
+		if k, ok := key.(string); ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	vc.log.WithContext(ctx).Debugf("list secret %s successed", path)
+	return keys, nil
+}
+
 func (vc *VaultClient) GetPolicy(ctx context.Context, path string) (string, error) {
 	policyData, err := vc.Client.Sys().GetPolicy(path)
 	if err != nil {
@@ -201,6 +303,16 @@ func (vc *VaultClient) Read(ctx context.Context, path string) (*vault.Secret, er
 	return sec, nil
 }
 
+func (vc *VaultClient) List(ctx context.Context, path string) (*vault.Secret, error) {
+	sec, err := vc.Client.Logical().ListWithContext(ctx, path)
+	if err != nil {
+		vc.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	vc.log.WithContext(ctx).Debugf("list %s successed", path)
+	return sec, nil
+}
+
 func (vc *VaultClient) Write(ctx context.Context, path string, data map[string]interface{}) (*vault.Secret, error) {
 	sec, err := vc.Client.Logical().Write(path, data)
 	if err != nil {
@@ -0,0 +1,100 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dryrun
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+
+	v1 "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+)
+
+// The request is a dry run if the header or the query parameter is true.
+const (
+	Header   = "X-Dry-Run"
+	QueryKey = "dry_run"
+)
+
+type planKey struct{}
+
+// Server marks the request as a dry run, the plan recorded by the handler is set in the reply.
+// Only the operations matched by supported can be dry run, other requests except GET are rejected,
+// so that a request asking for a dry run never writes vault.
+func Server(supported selector.MatchFunc) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			r, ok := khttp.RequestFromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			dryRun, err := isDryRun(r)
+			if err != nil {
+				return nil, v1.ErrorInputArgError("dry run flag is invalid: %s", err)
+			}
+			if !dryRun {
+				return handler(ctx, req)
+			}
+
+			operation := ""
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				operation = tr.Operation()
+			}
+			if !supported(ctx, operation) {
+				if r.Method == http.MethodGet {
+					return handler(ctx, req)
+				}
+				return nil, v1.ErrorInputArgError("dry run is not supported by %s", operation)
+			}
+
+			plan := &v1.DryRunPlan{}
+			reply, err := handler(NewContext(ctx, plan), req)
+			if err != nil {
+				return nil, err
+			}
+			if dryRunReply, ok := reply.(v1.DryRunReply); ok {
+				dryRunReply.SetDryRun(plan)
+			}
+			return reply, nil
+		}
+	}
+}
+
+func isDryRun(r *http.Request) (bool, error) {
+	value := r.Header.Get(Header)
+	if value == "" {
+		value = r.URL.Query().Get(QueryKey)
+	}
+	if value == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(value)
+}
+
+// NewContext marks the context as a dry run, the changes are recorded in the plan instead of being written.
+func NewContext(ctx context.Context, plan *v1.DryRunPlan) context.Context {
+	return context.WithValue(ctx, planKey{}, plan)
+}
+
+// FromContext returns the plan of the dry run, it is nil if the request is not a dry run.
+func FromContext(ctx context.Context) *v1.DryRunPlan {
+	plan, _ := ctx.Value(planKey{}).(*v1.DryRunPlan)
+	return plan
+}
@@ -0,0 +1,125 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dryrun_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+	"github.com/nautes-labs/vault-proxy/internal/pkg/dryrun"
+	"github.com/nautes-labs/vault-proxy/internal/server"
+)
+
+func matchAPIList(checkList []string) func(ctx context.Context, operation string) bool {
+	return func(ctx context.Context, operation string) bool {
+		for _, v := range checkList {
+			if ok, _ := regexp.MatchString(v, operation); ok {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// serve sends the request to the operation through the dry run middleware,
+// it returns the reply of the middleware and whether the handler runs as a dry run.
+func serve(operation string, r *http.Request) (reply interface{}, dryRun bool, err error) {
+	srv := khttp.NewServer(khttp.Middleware(dryrun.Server(matchAPIList(server.DryRunAPIList))))
+	srv.Route("/").Handle(r.Method, "/test", func(ctx khttp.Context) error {
+		khttp.SetOperation(ctx, operation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			plan := dryrun.FromContext(ctx)
+			dryRun = plan != nil
+			if dryRun {
+				plan.SecretPath = "gitlab/123"
+			}
+			return &v1.CreateGitReply{}, nil
+		})
+		reply, err = h(ctx, &v1.GitRequest{})
+		return nil
+	})
+	srv.ServeHTTP(httptest.NewRecorder(), r)
+	return
+}
+
+var _ = Describe("Dry Run", func() {
+	DescribeTable("read the dry run flag",
+		func(target, header string, expected bool) {
+			r := httptest.NewRequest(http.MethodPost, target, nil)
+			if header != "" {
+				r.Header.Set(dryrun.Header, header)
+			}
+			_, dryRun, err := serve(v1.OperationSecretCreateGit, r)
+			Expect(err).Should(BeNil())
+			Expect(dryRun).Should(Equal(expected))
+		},
+		Entry("no flag", "/test", "", false),
+		Entry("header", "/test", "true", true),
+		Entry("query", "/test?dry_run=true", "", true),
+		Entry("header is read before query", "/test?dry_run=true", "false", false),
+		Entry("query is false", "/test?dry_run=false", "", false),
+	)
+
+	It("reject the invalid flag", func() {
+		r := httptest.NewRequest(http.MethodPost, "/test?dry_run=yes-please", nil)
+		_, _, err := serve(v1.OperationSecretCreateGit, r)
+		Expect(v1.IsInputArgError(err)).Should(BeTrue())
+	})
+
+	It("set the plan on the reply", func() {
+		r := httptest.NewRequest(http.MethodPost, "/test?dry_run=true", nil)
+		reply, _, err := serve(v1.OperationSecretCreateGit, r)
+		Expect(err).Should(BeNil())
+		Expect(reply.(*v1.CreateGitReply).GetDryRun().GetSecretPath()).Should(Equal("gitlab/123"))
+	})
+
+	It("reject the unsupported operations except get", func() {
+		r := httptest.NewRequest(http.MethodPost, "/test?dry_run=true", nil)
+		_, dryRun, err := serve(v1.OperationSecretUndeleteGit, r)
+		Expect(v1.IsInputArgError(err)).Should(BeTrue())
+		Expect(dryRun).Should(BeFalse())
+
+		r = httptest.NewRequest(http.MethodGet, "/test?dry_run=true", nil)
+		_, dryRun, err = serve(v1.OperationSecretGetGit, r)
+		Expect(err).Should(BeNil())
+		Expect(dryRun).Should(BeFalse())
+	})
+
+	DescribeTable("match the operations which support dry run",
+		func(operation string, expected bool) {
+			Expect(matchAPIList(server.DryRunAPIList)(context.Background(), operation)).Should(Equal(expected))
+		},
+		Entry("create git", v1.OperationSecretCreateGit, true),
+		Entry("create tenant git", v1.OperationSecretCreteTenantGit, true),
+		Entry("create generic secret", v1.OperationSecretCreateSecret, true),
+		Entry("delete repo", v1.OperationSecretDeleteRepoAccountProduct, true),
+		Entry("create auth", v1.OperationAuthCreateAuth, true),
+		Entry("create auth role", v1.OperationAuthCreateAuthrole, true),
+		Entry("grant policy", v1.OperationAuthGrantGrantAuthroleGitPolicy, true),
+		Entry("revoke policy", v1.OperationAuthGrantRevokeAuthroleGitPolicy, true),
+		Entry("undelete git", v1.OperationSecretUndeleteGit, false),
+		Entry("purge git", v1.OperationSecretPurgeGit, false),
+		Entry("rollback git", v1.OperationSecretRollbackGit, false),
+		Entry("batch create", v1.OperationSecretBatchCreateSecrets, false),
+		Entry("delete auth", v1.OperationAuthDeleteAuth, false),
+	)
+})
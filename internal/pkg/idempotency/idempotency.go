@@ -0,0 +1,292 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	v1 "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+	"github.com/nautes-labs/vault-proxy/internal/pkg/dryrun"
+)
+
+const (
+	// Header carries the idempotency key in the request.
+	Header = "Idempotency-Key"
+	// ReplayedHeader is set to true in the reply if it is replayed from the stored reply.
+	ReplayedHeader = "Idempotency-Replayed"
+	// DefaultWindow is how long the reply of a key is replayed if the window is not set.
+	DefaultWindow = 24 * time.Hour
+	// DefaultCleanupInterval is how often the expired records are deleted if the interval is not set.
+	DefaultCleanupInterval = time.Hour
+	// A request in progress longer than this is considered abandoned, its key can be used again.
+	pendingTimeout = time.Minute
+	maxKeyLength   = 255
+)
+
+// ErrConflict is returned by the store when the record is changed by another request.
+var ErrConflict = errors.New("idempotency record is changed by another request")
+
+// Record is the stored request of an idempotency key, the reply is empty while the request is in progress.
+type Record struct {
+	// Hash of the operation and the request body
+	RequestHash string
+	// Reply of the request in protojson format
+	Reply []byte
+	// The reply has the generated secret material, it is not stored and the retries are rejected
+	Generated   bool
+	CreatedTime time.Time
+}
+
+// Store persists the records of the idempotency keys.
+type Store interface {
+	// Get returns the record of the key and its version, the record is nil and the version is 0 if the key is not stored.
+	Get(ctx context.Context, key string) (*Record, int, error)
+	// Save writes the record if the key is still at the version, otherwise it returns ErrConflict.
+	Save(ctx context.Context, key string, record *Record, version int) error
+	Delete(ctx context.Context, key string) error
+	// DeleteExpired deletes the records created before the time, it returns the number of the deleted records.
+	DeleteExpired(ctx context.Context, before time.Time) (int, error)
+}
+
+// Server replays the stored reply of a request retried with the same idempotency key in the window.
+// The keys are scoped by the caller, the same key sent by different callers never shares the reply.
+// The key is claimed before the request runs, a duplicate request arrived while it is in progress is rejected,
+// and so is a reused key with a different operation or body. Only the successful replies are stored,
+// the key of a failed request can be retried. The reply with the generated secret material is never stored,
+// a retry of it is rejected as a conflict, since a replayed reply without the material looks like nothing is generated.
+// Requests of the operations not matched by supported, the dry run requests and the requests without the key
+// are not changed. Nothing is done if the store is nil.
+func Server(store Store, window time.Duration, supported selector.MatchFunc, caller func(ctx context.Context) string, logger log.Logger) middleware.Middleware {
+	if window <= 0 {
+		window = DefaultWindow
+	}
+	helper := log.NewHelper(logger)
+	return func(handler middleware.Handler) middleware.Handler {
+		if store == nil {
+			return handler
+		}
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			key := tr.RequestHeader().Get(Header)
+			if key == "" || !supported(ctx, tr.Operation()) || dryrun.FromContext(ctx) != nil {
+				return handler(ctx, req)
+			}
+			if len(key) > maxKeyLength {
+				return nil, v1.ErrorInputArgError("idempotency key is longer than %d", maxKeyLength)
+			}
+
+			requestHash, err := hashRequest(tr.Operation(), caller(ctx), req)
+			if err != nil {
+				return nil, v1.ErrorInputArgError("hash request failed: %s", err)
+			}
+			storeKey := scopeKey(caller(ctx), key)
+			record, version, err := store.Get(ctx, storeKey)
+			if err != nil {
+				return nil, v1.ErrorInternalServiceError("get idempotency key failed: %s", err)
+			}
+			if record != nil && time.Since(record.CreatedTime) < window {
+				if record.RequestHash != requestHash {
+					return nil, v1.ErrorInputArgError("idempotency key %s is used by another request", key)
+				}
+				if record.Generated {
+					return nil, v1.ErrorIdempotencyConflict("reply of idempotency key %s has a generated value which is not replayable", key)
+				}
+				if len(record.Reply) != 0 {
+					reply, err := decodeReply(tr.Operation(), record.Reply)
+					if err != nil {
+						return nil, v1.ErrorInternalServiceError("replay reply of idempotency key %s failed: %s", key, err)
+					}
+					tr.ReplyHeader().Set(ReplayedHeader, "true")
+					return reply, nil
+				}
+				if time.Since(record.CreatedTime) < pendingTimeout {
+					return nil, v1.ErrorIdempotencyConflict("request with idempotency key %s is in progress", key)
+				}
+			}
+
+			pending := &Record{
+				RequestHash: requestHash,
+				CreatedTime: time.Now(),
+			}
+			if err := store.Save(ctx, storeKey, pending, version); errors.Is(err, ErrConflict) {
+				return nil, v1.ErrorIdempotencyConflict("request with idempotency key %s is in progress", key)
+			} else if err != nil {
+				return nil, v1.ErrorInternalServiceError("claim idempotency key failed: %s", err)
+			}
+
+			reply, err := handler(ctx, req)
+			if err != nil {
+				// The request context may be canceled by the timeout, the key is released in a new context
+				if err := store.Delete(context.Background(), storeKey); err != nil {
+					helper.WithContext(ctx).Errorf("release idempotency key %s failed: %s", key, err)
+				}
+				return nil, err
+			}
+			if err := saveReply(store, storeKey, pending, version+1, reply); err != nil {
+				// The retries are rejected as in progress until the pending record times out
+				helper.WithContext(ctx).Errorf("save reply of idempotency key %s failed: %s", key, err)
+			}
+			return reply, nil
+		}
+	}
+}
+
+// scopeKey returns the key in the store of the idempotency key sent by the caller.
+func scopeKey(caller, key string) string {
+	return caller + "/" + key
+}
+
+func saveReply(store Store, key string, record *Record, version int, reply interface{}) error {
+	message, ok := reply.(proto.Message)
+	if !ok {
+		return fmt.Errorf("reply is not a proto message")
+	}
+	if generated, ok := message.(v1.GeneratedReply); ok && generated.HasGenerated() {
+		record.Generated = true
+		return store.Save(context.Background(), key, record, version)
+	}
+	raw, err := protojson.Marshal(message)
+	if err != nil {
+		return err
+	}
+	record.Reply = raw
+	return store.Save(context.Background(), key, record, version)
+}
+
+// hashRequest hashes the operation, the caller and the request body, the fields are marshaled in a deterministic order.
+func hashRequest(operation, caller string, req interface{}) (string, error) {
+	message, ok := req.(proto.Message)
+	if !ok {
+		return "", fmt.Errorf("request is not a proto message")
+	}
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.New()
+	hash.Write([]byte(operation))
+	hash.Write([]byte{0})
+	hash.Write([]byte(caller))
+	hash.Write([]byte{0})
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// decodeReply decodes the stored reply as the output type of the operation, such as /api.vaultproxy.v1.Secret/CreateGit.
+func decodeReply(operation string, raw []byte) (proto.Message, error) {
+	index := strings.LastIndex(operation, "/")
+	if index == -1 {
+		return nil, fmt.Errorf("operation %s is invalid", operation)
+	}
+	serviceName := protoreflect.FullName(strings.TrimPrefix(operation[:index], "/"))
+	descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(serviceName)
+	if err != nil {
+		return nil, err
+	}
+	service, ok := descriptor.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a service", serviceName)
+	}
+	method := service.Methods().ByName(protoreflect.Name(operation[index+1:]))
+	if method == nil {
+		return nil, fmt.Errorf("method of operation %s is not found", operation)
+	}
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(method.Output().FullName())
+	if err != nil {
+		return nil, err
+	}
+
+	reply := messageType.New().Interface()
+	if err := protojson.Unmarshal(raw, reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// Cleaner deletes the records older than the window in background, so that the store does not grow unbounded.
+type Cleaner struct {
+	store    Store
+	window   time.Duration
+	interval time.Duration
+	stop     chan struct{}
+	log      *log.Helper
+}
+
+// NewCleaner returns a cleaner of the store, nothing is deleted if the store is nil.
+func NewCleaner(store Store, window, interval time.Duration, logger log.Logger) *Cleaner {
+	if window <= 0 {
+		window = DefaultWindow
+	}
+	if interval <= 0 {
+		interval = DefaultCleanupInterval
+	}
+	return &Cleaner{
+		store:    store,
+		window:   window,
+		interval: interval,
+		stop:     make(chan struct{}),
+		log:      log.NewHelper(logger),
+	}
+}
+
+// Start deletes the expired records every interval until Stop is called.
+func (c *Cleaner) Start(ctx context.Context) error {
+	if c.store == nil {
+		<-c.stop
+		return nil
+	}
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		deleted, err := c.store.DeleteExpired(ctx, time.Now().Add(-c.window))
+		if err != nil {
+			c.log.WithContext(ctx).Errorf("delete expired idempotency records failed: %s", err)
+		}
+		if deleted != 0 {
+			c.log.WithContext(ctx).Infof("%d expired idempotency records are deleted", deleted)
+		}
+		select {
+		case <-ticker.C:
+		case <-c.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (c *Cleaner) Stop(_ context.Context) error {
+	close(c.stop)
+	return nil
+}
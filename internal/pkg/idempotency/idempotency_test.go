@@ -0,0 +1,290 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package idempotency_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+	"github.com/nautes-labs/vault-proxy/internal/pkg/idempotency"
+	"github.com/nautes-labs/vault-proxy/internal/server"
+)
+
+type mockStore struct {
+	lock     sync.Mutex
+	records  map[string]*idempotency.Record
+	versions map[string]int
+}
+
+func newMockStore() *mockStore {
+	return &mockStore{
+		records:  map[string]*idempotency.Record{},
+		versions: map[string]int{},
+	}
+}
+
+func (s *mockStore) Get(_ context.Context, key string) (*idempotency.Record, int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	record, ok := s.records[key]
+	if !ok {
+		return nil, s.versions[key], nil
+	}
+	copied := *record
+	return &copied, s.versions[key], nil
+}
+
+func (s *mockStore) Save(_ context.Context, key string, record *idempotency.Record, version int) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.versions[key] != version {
+		return idempotency.ErrConflict
+	}
+	copied := *record
+	s.records[key] = &copied
+	s.versions[key] = version + 1
+	return nil
+}
+
+func (s *mockStore) Delete(_ context.Context, key string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.records, key)
+	delete(s.versions, key)
+	return nil
+}
+
+func (s *mockStore) DeleteExpired(_ context.Context, before time.Time) (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	deleted := 0
+	for key, record := range s.records {
+		if record.CreatedTime.Before(before) {
+			delete(s.records, key)
+			delete(s.versions, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func matchAPIList(checkList []string) func(ctx context.Context, operation string) bool {
+	return func(ctx context.Context, operation string) bool {
+		for _, v := range checkList {
+			if ok, _ := regexp.MatchString(v, operation); ok {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func caller(_ context.Context) string {
+	return "runtime"
+}
+
+// serve sends the git request with the idempotency key through the middleware,
+// it returns the reply, whether the reply is replayed and how many times the handler runs.
+func serve(store idempotency.Store, operation, key string, req *v1.GitRequest) (reply interface{}, replayed bool, called int, err error) {
+	return serveReply(store, operation, key, req, &v1.CreateGitReply{
+		Secret:    &v1.SecretInfo{Name: "git", Path: "gitlab/123", Version: 1},
+		PublicKey: "ssh-rsa AAAA",
+	})
+}
+
+// serveReply is the same as serve, but the handler returns the reply in the arguments.
+func serveReply(store idempotency.Store, operation, key string, req interface{}, handlerReply interface{}) (reply interface{}, replayed bool, called int, err error) {
+	srv := khttp.NewServer(khttp.Middleware(idempotency.Server(store, time.Hour, matchAPIList(server.IdempotentAPIList), caller, log.DefaultLogger)))
+	srv.Route("/").Handle(http.MethodPost, "/test", func(ctx khttp.Context) error {
+		khttp.SetOperation(ctx, operation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			called++
+			return handlerReply, nil
+		})
+		reply, err = h(ctx, req)
+		return nil
+	})
+	r := httptest.NewRequest(http.MethodPost, "/test", nil)
+	if key != "" {
+		r.Header.Set(idempotency.Header, key)
+	}
+	w := httptest.NewRecorder()
+	srv.ServeHTTP(w, r)
+	replayed = w.Header().Get(idempotency.ReplayedHeader) == "true"
+	return
+}
+
+var _ = Describe("Idempotency", func() {
+	var store *mockStore
+	var req *v1.GitRequest
+
+	BeforeEach(func() {
+		store = newMockStore()
+		req = &v1.GitRequest{
+			Meta: &v1.GitMeta{ProviderType: "gitlab", Id: "123", Username: "user", Permission: "readonly"},
+		}
+	})
+
+	It("replay the reply of the same request with the public key", func() {
+		_, replayed, called, err := serve(store, v1.OperationSecretCreateGit, "key", req)
+		Expect(err).Should(BeNil())
+		Expect(replayed).Should(BeFalse())
+		Expect(called).Should(Equal(1))
+
+		reply, replayed, called, err := serve(store, v1.OperationSecretCreateGit, "key", req)
+		Expect(err).Should(BeNil())
+		Expect(replayed).Should(BeTrue())
+		Expect(called).Should(Equal(0))
+		Expect(reply.(*v1.CreateGitReply).GetSecret().GetPath()).Should(Equal("gitlab/123"))
+		Expect(reply.(*v1.CreateGitReply).GetPublicKey()).Should(Equal("ssh-rsa AAAA"))
+	})
+
+	It("reject the retry of the request which generates a password", func() {
+		repoReq := &v1.RepoRequest{
+			Meta: &v1.RepoMeta{ProviderId: "nexus", Product: "demo"},
+		}
+		generated := &v1.CreateRepoReply{Secret: &v1.SecretInfo{Name: "repo", Path: "nexus/demo", Version: 1}, Password: "generated"}
+		reply, _, called, err := serveReply(store, v1.OperationSecretCreateRepoAccount, "key", repoReq, generated)
+		Expect(err).Should(BeNil())
+		Expect(called).Should(Equal(1))
+		Expect(reply.(*v1.CreateRepoReply).GetPassword()).Should(Equal("generated"))
+		record, _, _ := store.Get(context.Background(), "runtime/key")
+		Expect(record.Generated).Should(BeTrue())
+		Expect(record.Reply).Should(BeEmpty())
+
+		_, replayed, called, err := serveReply(store, v1.OperationSecretCreateRepoAccount, "key", repoReq, generated)
+		Expect(v1.IsIdempotencyConflict(err)).Should(BeTrue())
+		Expect(replayed).Should(BeFalse())
+		Expect(called).Should(Equal(0))
+	})
+
+	It("replay the reply of the request which does not generate a password", func() {
+		repoReq := &v1.RepoRequest{
+			Meta: &v1.RepoMeta{ProviderId: "nexus", Product: "demo"},
+		}
+		plain := &v1.CreateRepoReply{Secret: &v1.SecretInfo{Name: "repo", Path: "nexus/demo", Version: 1}}
+		_, _, _, err := serveReply(store, v1.OperationSecretCreateRepoAccount, "key", repoReq, plain)
+		Expect(err).Should(BeNil())
+
+		_, replayed, called, err := serveReply(store, v1.OperationSecretCreateRepoAccount, "key", repoReq, plain)
+		Expect(err).Should(BeNil())
+		Expect(replayed).Should(BeTrue())
+		Expect(called).Should(Equal(0))
+	})
+
+	DescribeTable("reject the key used by another request",
+		func(operation string, change func(req *v1.GitRequest)) {
+			_, _, _, err := serve(store, v1.OperationSecretCreateGit, "key", req)
+			Expect(err).Should(BeNil())
+
+			change(req)
+			_, _, called, err := serve(store, operation, "key", req)
+			Expect(v1.IsInputArgError(err)).Should(BeTrue())
+			Expect(called).Should(Equal(0))
+		},
+		Entry("different body", v1.OperationSecretCreateGit, func(req *v1.GitRequest) { req.Meta.Id = "456" }),
+		Entry("different operation", v1.OperationSecretCreteTenantGit, func(req *v1.GitRequest) {}),
+	)
+
+	DescribeTable("handle the pending request of the key",
+		func(createdTime time.Time, inProgress bool) {
+			record := &idempotency.Record{CreatedTime: createdTime}
+			// Claim the key with the hash of the request, so that only the pending state decides the result
+			_, _, _, err := serve(store, v1.OperationSecretCreateGit, "key", req)
+			Expect(err).Should(BeNil())
+			stored, version, _ := store.Get(context.Background(), "runtime/key")
+			record.RequestHash = stored.RequestHash
+			Expect(store.Save(context.Background(), "runtime/key", record, version)).Should(BeNil())
+
+			_, _, called, err := serve(store, v1.OperationSecretCreateGit, "key", req)
+			if inProgress {
+				Expect(v1.IsIdempotencyConflict(err)).Should(BeTrue())
+				Expect(called).Should(Equal(0))
+			} else {
+				Expect(err).Should(BeNil())
+				Expect(called).Should(Equal(1))
+			}
+		},
+		Entry("in progress", time.Now(), true),
+		Entry("timed out", time.Now().Add(-2*time.Minute), false),
+		Entry("out of the window", time.Now().Add(-2*time.Hour), false),
+	)
+
+	DescribeTable("pass the request through",
+		func(store idempotency.Store, operation, key string) {
+			for i := 0; i < 2; i++ {
+				_, replayed, called, err := serve(store, operation, key, req)
+				Expect(err).Should(BeNil())
+				Expect(replayed).Should(BeFalse())
+				Expect(called).Should(Equal(1))
+			}
+		},
+		Entry("no key", newMockStore(), v1.OperationSecretCreateGit, ""),
+		Entry("unsupported operation", newMockStore(), v1.OperationSecretDeleteGit, "key"),
+		Entry("no store", nil, v1.OperationSecretCreateGit, "key"),
+	)
+
+	It("scope the key by the caller", func() {
+		_, _, _, err := serve(store, v1.OperationSecretCreateGit, "key", req)
+		Expect(err).Should(BeNil())
+		record, _, _ := store.Get(context.Background(), "runtime/key")
+		Expect(record).ShouldNot(BeNil())
+		record, _, _ = store.Get(context.Background(), "key")
+		Expect(record).Should(BeNil())
+	})
+
+	It("delete the records older than the window", func() {
+		Expect(store.Save(context.Background(), "old", &idempotency.Record{CreatedTime: time.Now().Add(-2 * time.Hour)}, 0)).Should(BeNil())
+		Expect(store.Save(context.Background(), "new", &idempotency.Record{CreatedTime: time.Now()}, 0)).Should(BeNil())
+
+		cleaner := idempotency.NewCleaner(store, time.Hour, time.Hour, log.DefaultLogger)
+		done := make(chan error)
+		go func() { done <- cleaner.Start(context.Background()) }()
+		Eventually(func() int {
+			store.lock.Lock()
+			defer store.lock.Unlock()
+			return len(store.records)
+		}).Should(Equal(1))
+		Expect(cleaner.Stop(context.Background())).Should(BeNil())
+		Expect(<-done).Should(BeNil())
+		record, _, _ := store.Get(context.Background(), "new")
+		Expect(record).ShouldNot(BeNil())
+	})
+
+	DescribeTable("match the operations which support idempotency keys",
+		func(operation string, expected bool) {
+			Expect(matchAPIList(server.IdempotentAPIList)(context.Background(), operation)).Should(Equal(expected))
+		},
+		Entry("create git", v1.OperationSecretCreateGit, true),
+		Entry("create tenant git", v1.OperationSecretCreteTenantGit, true),
+		Entry("create generic secret", v1.OperationSecretCreateSecret, true),
+		Entry("batch create", v1.OperationSecretBatchCreateSecrets, true),
+		Entry("grant policy", v1.OperationAuthGrantGrantAuthroleGitPolicy, true),
+		Entry("revoke policy", v1.OperationAuthGrantRevokeAuthroleGitPolicy, false),
+		Entry("delete git", v1.OperationSecretDeleteGit, false),
+		Entry("get git", v1.OperationSecretGetGit, false),
+		Entry("create auth", v1.OperationAuthCreateAuth, false),
+	)
+})
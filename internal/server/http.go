@@ -20,10 +20,14 @@ import (
 	"crypto/x509"
 	"os"
 	"regexp"
+	"time"
 
 	v1 "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
 	"github.com/nautes-labs/vault-proxy/internal/biz/auth"
 	"github.com/nautes-labs/vault-proxy/internal/conf"
+	"github.com/nautes-labs/vault-proxy/internal/pkg/dryrun"
+	"github.com/nautes-labs/vault-proxy/internal/pkg/idempotency"
+	"github.com/nautes-labs/vault-proxy/internal/pkg/requestid"
 	"github.com/nautes-labs/vault-proxy/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -38,10 +42,24 @@ var (
 	BasicAPIList = []string{
 		`\/api\.vaultproxy\.v1\.Secret\/.*`,
 		`\/api\.vaultproxy\.v1\.Auth\/.*`,
+		`\/api\.vaultproxy\.v1\.Reconcile\/.*`,
+		`\/api\.vaultproxy\.v1\.Expiration\/.*`,
+		`\/api\.vaultproxy\.v1\.Rotation\/.*`,
 	}
 	GrantAPIList = []string{
 		`\/api\.vaultproxy\.v1\.AuthGrant\/.*`,
 	}
+	// Apis which replay the reply of the requests retried with the same idempotency key
+	IdempotentAPIList = []string{
+		`\/api\.vaultproxy\.v1\.Secret\/(Create|Crete|BatchCreate)[A-Za-z]*$`,
+		`\/api\.vaultproxy\.v1\.AuthGrant\/Grant[A-Za-z]*$`,
+	}
+	// Mutating apis which can be dry run, other mutating apis reject the dry run requests
+	DryRunAPIList = []string{
+		`\/api\.vaultproxy\.v1\.Secret\/(Create|Crete|Delete)[A-Za-z]*$`,
+		`\/api\.vaultproxy\.v1\.Auth\/(CreateAuth|CreateAuthrole)$`,
+		`\/api\.vaultproxy\.v1\.AuthGrant\/.*`,
+	}
 )
 
 func getCheckList(checkType int) selector.MatchFunc {
@@ -52,6 +70,10 @@ func getCheckList(checkType int) selector.MatchFunc {
 	case auth.GRANT:
 		checkList = GrantAPIList
 	}
+	return matchAPIList(checkList)
+}
+
+func matchAPIList(checkList []string) selector.MatchFunc {
 	return func(ctx context.Context, operation string) bool {
 		for _, v := range checkList {
 			ok, err := regexp.MatchString(v, operation)
@@ -77,9 +99,21 @@ func NewHTTPServer(c *conf.Server,
 	authService *service.AuthService,
 	authGrantService *service.AuthGrantService,
 	healthService *service.HealthService,
-	_ log.Logger) *http.Server {
+	reconcileService *service.ReconcileService,
+	expirationService *service.ExpirationService,
+	rotationService *service.RotationService,
+	d *conf.Data,
+	idempotencyStore idempotency.Store,
+	logger log.Logger) *http.Server {
+	var idempotencyWindow time.Duration
+	if d.Idempotency != nil {
+		idempotencyWindow = d.Idempotency.Window.AsDuration()
+	}
+
 	var opts = []http.ServerOption{
 		http.Middleware(
+			requestid.Server(),
+			dryrun.Server(matchAPIList(DryRunAPIList)),
 			validate.Validator(),
 			recovery.Recovery(),
 			selector.Server(
@@ -90,6 +124,7 @@ func NewHTTPServer(c *conf.Server,
 				auth.Authenticate(),
 				auth.Authorize(c, auth.GRANT),
 			).Match(getCheckList(auth.GRANT)).Build(),
+			idempotency.Server(idempotencyStore, idempotencyWindow, matchAPIList(IdempotentAPIList), auth.FromAuthContext, logger),
 		),
 	}
 
@@ -128,5 +163,10 @@ func NewHTTPServer(c *conf.Server,
 	v1.RegisterAuthHTTPServer(srv, authService)
 	v1.RegisterAuthGrantHTTPServer(srv, authGrantService)
 	v1.RegisterHealthHTTPServer(srv, healthService)
+	v1.RegisterReconcileHTTPServer(srv, reconcileService)
+	v1.RegisterExpirationHTTPServer(srv, expirationService)
+	v1.RegisterRotationHTTPServer(srv, rotationService)
+	srv.Route("/").GET("/v1/rotations/export", rotationService.ExportRotations)
+	srv.Route("/").GET("/metrics", reconcileService.Metrics)
 	return srv
 }
@@ -0,0 +1,27 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/nautes-labs/vault-proxy/internal/conf"
+	"github.com/nautes-labs/vault-proxy/internal/pkg/idempotency"
+)
+
+// NewIdempotencyCleaner deletes the idempotency records older than the window in background.
+func NewIdempotencyCleaner(d *conf.Data, store idempotency.Store, logger log.Logger) *idempotency.Cleaner {
+	return idempotency.NewCleaner(store, d.GetIdempotency().GetWindow().AsDuration(), d.GetIdempotency().GetCleanupInterval().AsDuration(), logger)
+}
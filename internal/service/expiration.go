@@ -0,0 +1,56 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+	vproxy "github.com/nautes-labs/vault-proxy/internal/biz/vaultproxy"
+)
+
+type ExpirationService struct {
+	uc *vproxy.VaultUsercase
+}
+
+func NewExpirationService(uc *vproxy.VaultUsercase) *ExpirationService {
+	return &ExpirationService{uc: uc}
+}
+
+func (s *ExpirationService) ListExpirations(ctx context.Context, req *pb.ListExpirationsRequest) (*pb.ListExpirationsReply, error) {
+	var deadline time.Time
+	if req.Within != "" {
+		within, err := time.ParseDuration(req.Within)
+		if err != nil {
+			return nil, pb.ErrorInputArgError("within %s is invalid: %s", req.Within, err)
+		}
+		deadline = time.Now().Add(within)
+	}
+
+	expirations, err := s.uc.ListExpirations(ctx, deadline)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListExpirationsReply{}
+	for _, expiration := range expirations {
+		reply.Expirations = append(reply.Expirations, &pb.SecretExpiration{
+			SecretName: expiration.SecretName,
+			SecretPath: expiration.SecretPath,
+			ExpireTime: expiration.ExpireTime.Format(time.RFC3339),
+		})
+	}
+	return reply, nil
+}
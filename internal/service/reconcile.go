@@ -0,0 +1,102 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+
+	pb "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+	vproxy "github.com/nautes-labs/vault-proxy/internal/biz/vaultproxy"
+)
+
+type ReconcileService struct {
+	reconciler *vproxy.Reconciler
+}
+
+func NewReconcileService(reconciler *vproxy.Reconciler) *ReconcileService {
+	return &ReconcileService{reconciler: reconciler}
+}
+
+func (s *ReconcileService) GetDrift(_ context.Context, _ *pb.GetDriftRequest) (*pb.DriftReply, error) {
+	return newDriftReply(s.reconciler.Report()), nil
+}
+func (s *ReconcileService) Reconcile(ctx context.Context, req *pb.ReconcileRequest) (*pb.DriftReply, error) {
+	report, err := s.reconciler.Reconcile(ctx, req.Repair)
+	if err != nil {
+		return nil, err
+	}
+	return newDriftReply(report), nil
+}
+
+// OperationReconcileMetrics is the operation of GET /metrics, it is authorized as the drift report it is computed from.
+const OperationReconcileMetrics = "/api.vaultproxy.v1.Reconcile/Metrics"
+
+// Metrics writes the number of drifts found by the last reconcile in prometheus text format.
+func (s *ReconcileService) Metrics(ctx http.Context) error {
+	http.SetOperation(ctx, OperationReconcileMetrics)
+	h := ctx.Middleware(func(_ context.Context, _ interface{}) (interface{}, error) {
+		return s.reconciler.Report(), nil
+	})
+	out, err := h(ctx, &pb.GetDriftRequest{})
+	if err != nil {
+		return err
+	}
+	report := out.(*vproxy.DriftReport)
+
+	var buf bytes.Buffer
+	if report != nil {
+		count := report.Count()
+		kinds := make([]string, 0, len(count))
+		for kind := range count {
+			kinds = append(kinds, kind)
+		}
+		sort.Strings(kinds)
+
+		fmt.Fprintln(&buf, "# HELP vault_proxy_drifts Number of drifts found by the last reconcile.")
+		fmt.Fprintln(&buf, "# TYPE vault_proxy_drifts gauge")
+		for _, kind := range kinds {
+			fmt.Fprintf(&buf, "vault_proxy_drifts{kind=%q} %d\n", kind, count[kind])
+		}
+		fmt.Fprintln(&buf, "# HELP vault_proxy_reconcile_timestamp_seconds Time of the last reconcile.")
+		fmt.Fprintln(&buf, "# TYPE vault_proxy_reconcile_timestamp_seconds gauge")
+		fmt.Fprintf(&buf, "vault_proxy_reconcile_timestamp_seconds %d\n", report.CheckedTime.Unix())
+	}
+	return ctx.Blob(200, "text/plain; version=0.0.4", buf.Bytes())
+}
+
+func newDriftReply(report *vproxy.DriftReport) *pb.DriftReply {
+	reply := &pb.DriftReply{}
+	if report == nil {
+		return reply
+	}
+	reply.CheckedTime = report.CheckedTime.Format(time.RFC3339)
+	for _, drift := range report.Drifts {
+		reply.Drifts = append(reply.Drifts, &pb.Drift{
+			Kind:       drift.Kind,
+			SecretName: drift.SecretName,
+			SecretPath: drift.SecretPath,
+			PolicyName: drift.PolicyName,
+			RolePath:   drift.RolePath,
+			Repaired:   drift.Repaired,
+		})
+	}
+	return reply
+}
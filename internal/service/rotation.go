@@ -0,0 +1,142 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/encoding"
+	"github.com/go-kratos/kratos/v2/encoding/json"
+	"github.com/go-kratos/kratos/v2/transport/http"
+
+	pb "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+	vproxy "github.com/nautes-labs/vault-proxy/internal/biz/vaultproxy"
+)
+
+// OperationRotationExportRotations is the operation of GET /v1/rotations/export, it is matched by the middlewares as the generated apis.
+const OperationRotationExportRotations = "/api.vaultproxy.v1.Rotation/ExportRotations"
+
+// Columns of the exported csv file
+var rotationCSVHeader = []string{
+	"secret_name", "secret_path", "secret_type", "version", "version_time", "age_seconds",
+	"created_by", "roles", "rotation_period_seconds", "stale",
+}
+
+type RotationService struct {
+	uc *vproxy.VaultUsercase
+}
+
+func NewRotationService(uc *vproxy.VaultUsercase) *RotationService {
+	return &RotationService{uc: uc}
+}
+
+func (s *RotationService) ListRotations(ctx context.Context, req *pb.ListRotationsRequest) (*pb.ListRotationsReply, error) {
+	report, err := s.uc.ReportRotations(ctx, req.StaleOnly)
+	if err != nil {
+		return nil, err
+	}
+	return newRotationsReply(report), nil
+}
+
+// ExportRotations writes the rotation report as a csv or json file, it is registered on GET /v1/rotations/export.
+func (s *RotationService) ExportRotations(ctx http.Context) error {
+	var in pb.ExportRotationsRequest
+	if err := ctx.BindQuery(&in); err != nil {
+		return err
+	}
+	http.SetOperation(ctx, OperationRotationExportRotations)
+	h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.ListRotations(ctx, &pb.ListRotationsRequest{StaleOnly: req.(*pb.ExportRotationsRequest).StaleOnly})
+	})
+	out, err := h(ctx, &in)
+	if err != nil {
+		return err
+	}
+	reply := out.(*pb.ListRotationsReply)
+
+	format := in.Format
+	if format == "" {
+		format = "csv"
+	}
+	var data []byte
+	var contentType string
+	switch format {
+	case "json":
+		contentType = "application/json"
+		data, err = encoding.GetCodec(json.Name).Marshal(reply)
+	default:
+		contentType = "text/csv"
+		data, err = encodeRotationsCSV(reply)
+	}
+	if err != nil {
+		return pb.ErrorInternalServiceError("export rotations failed: %s", err)
+	}
+
+	filename := fmt.Sprintf("rotations-%s.%s", time.Now().UTC().Format("20060102"), format)
+	ctx.Response().Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	return ctx.Blob(200, contentType, data)
+}
+
+func encodeRotationsCSV(reply *pb.ListRotationsReply) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(rotationCSVHeader); err != nil {
+		return nil, err
+	}
+	for _, rotation := range reply.Rotations {
+		err := w.Write([]string{
+			rotation.SecretName,
+			rotation.SecretPath,
+			rotation.SecretType,
+			strconv.Itoa(int(rotation.Version)),
+			rotation.VersionTime,
+			strconv.FormatInt(rotation.AgeSeconds, 10),
+			rotation.CreatedBy,
+			strings.Join(rotation.Roles, ";"),
+			strconv.FormatInt(rotation.RotationPeriodSeconds, 10),
+			strconv.FormatBool(rotation.Stale),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func newRotationsReply(report *vproxy.RotationReport) *pb.ListRotationsReply {
+	reply := &pb.ListRotationsReply{CheckedTime: report.CheckedTime.Format(time.RFC3339)}
+	for _, rotation := range report.Rotations {
+		reply.Rotations = append(reply.Rotations, &pb.SecretRotation{
+			SecretName:            rotation.SecretName,
+			SecretPath:            rotation.SecretPath,
+			SecretType:            rotation.SecretType,
+			Version:               int32(rotation.Version),
+			VersionTime:           rotation.VersionTime.Format(time.RFC3339),
+			AgeSeconds:            int64(rotation.Age.Seconds()),
+			CreatedBy:             rotation.CreatedBy,
+			Roles:                 rotation.Roles,
+			RotationPeriodSeconds: int64(rotation.RotationPeriod.Seconds()),
+			Stale:                 rotation.Stale,
+		})
+	}
+	return reply
+}
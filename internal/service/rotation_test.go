@@ -0,0 +1,159 @@
+// Copyright 2023 Nautes Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"os/exec"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/validate"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	vault "github.com/hashicorp/vault/api"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	vpApi "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+	"github.com/nautes-labs/vault-proxy/internal/biz/auth"
+	"github.com/nautes-labs/vault-proxy/internal/biz/vaultproxy"
+	"github.com/nautes-labs/vault-proxy/internal/conf"
+	vpData "github.com/nautes-labs/vault-proxy/internal/data"
+	"github.com/nautes-labs/vault-proxy/internal/service"
+)
+
+var _ = Describe("Rotation Export", func() {
+	var vaultServer *exec.Cmd
+	var server *httptest.Server
+
+	BeforeEach(func() {
+		vaultServer = exec.Command("vault", "server", "-dev", "-dev-root-token-id=test")
+		err := vaultServer.Start()
+		Expect(err).Should(BeNil())
+
+		for {
+			vaultServerHealthCheck := exec.Command("vault", "status", "-address=http://127.0.0.1:8200")
+			err := vaultServerHealthCheck.Run()
+			if err == nil {
+				break
+			}
+		}
+
+		cfg := vault.DefaultConfig()
+		cfg.Address = "http://127.0.0.1:8200"
+		vaultRawClient, err := vault.NewClient(cfg)
+		Expect(err).Should(BeNil())
+		vaultRawClient.SetToken("test")
+		err = vaultRawClient.Sys().Mount(vpApi.GitSecretName, &vault.MountInput{
+			Type:    "kv",
+			Options: map[string]string{"version": "2"},
+		})
+		Expect(err).Should(BeNil())
+
+		dataCfg := &conf.Data{
+			Vault: &conf.Data_Vault{
+				Addr:  "http://127.0.0.1:8200",
+				Token: "test",
+			},
+		}
+		uc := vaultproxy.NewVaultUsercase(vpData.NewVaultClient(dataCfg, log.DefaultLogger), &conf.Server{
+			Authorization: &conf.Server_Authorization{
+				Resource: &conf.Server_Authorization_Casbin{Acl: "/tmp/permission.acl"},
+			},
+			Rotation: &conf.Server_Rotation{
+				Periods: map[string]*durationpb.Duration{"git": durationpb.New(time.Nanosecond)},
+			},
+		}, dataCfg, log.DefaultLogger)
+
+		// The creator has the chars to be escaped in csv
+		ctx := auth.NewAuthContext(context.Background(), `ops, "team"`)
+		_, err = uc.CreateSecret(ctx, &vpApi.CreateSecretRequest{
+			Type: "git",
+			Meta: map[string]string{"provider_type": "gitlab", "id": "123", "username": "default", "permission": "readonly"},
+			Data: map[string]string{"deploykey": "key"},
+		})
+		Expect(err).Should(BeNil())
+
+		srv := http.NewServer(http.Middleware(validate.Validator()))
+		srv.Route("/").GET("/v1/rotations/export", service.NewRotationService(uc).ExportRotations)
+		server = httptest.NewServer(srv)
+	})
+
+	AfterEach(func() {
+		server.Close()
+		err := vaultServer.Process.Kill()
+		Expect(err).Should(BeNil())
+	})
+
+	get := func(query string) (*nethttp.Response, []byte) {
+		resp, err := nethttp.Get(server.URL + "/v1/rotations/export" + query)
+		Expect(err).Should(BeNil())
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).Should(BeNil())
+		return resp, body
+	}
+
+	It("export the rotations as csv by default", func() {
+		resp, body := get("")
+		Expect(resp.StatusCode).Should(Equal(nethttp.StatusOK))
+		Expect(resp.Header.Get("Content-Type")).Should(Equal("text/csv"))
+		Expect(resp.Header.Get("Content-Disposition")).Should(MatchRegexp(`^attachment; filename="rotations-\d{8}\.csv"$`))
+		Expect(string(body)).Should(ContainSubstring(`,"ops, ""team""",`))
+
+		records, err := csv.NewReader(bytes.NewReader(body)).ReadAll()
+		Expect(err).Should(BeNil())
+		Expect(records).Should(HaveLen(2))
+		Expect(records[0]).Should(Equal([]string{
+			"secret_name", "secret_path", "secret_type", "version", "version_time", "age_seconds",
+			"created_by", "roles", "rotation_period_seconds", "stale",
+		}))
+		Expect(records[1][0]).Should(Equal("git"))
+		Expect(records[1][1]).Should(Equal("gitlab/123/default/readonly"))
+		Expect(records[1][2]).Should(Equal("git"))
+		Expect(records[1][3]).Should(Equal("1"))
+		Expect(records[1][6]).Should(Equal(`ops, "team"`))
+		Expect(records[1][8]).Should(Equal("0"))
+		Expect(records[1][9]).Should(Equal("true"))
+	})
+
+	It("export the rotations as json", func() {
+		resp, body := get("?format=json&stale_only=true")
+		Expect(resp.StatusCode).Should(Equal(nethttp.StatusOK))
+		Expect(resp.Header.Get("Content-Type")).Should(Equal("application/json"))
+		Expect(resp.Header.Get("Content-Disposition")).Should(MatchRegexp(`^attachment; filename="rotations-\d{8}\.json"$`))
+
+		reply := struct {
+			Rotations []map[string]interface{} `json:"rotations"`
+		}{}
+		Expect(json.Unmarshal(body, &reply)).Should(Succeed())
+		Expect(reply.Rotations).Should(HaveLen(1))
+		Expect(reply.Rotations[0]["secret_path"]).Should(Equal("gitlab/123/default/readonly"))
+		Expect(reply.Rotations[0]["created_by"]).Should(Equal(`ops, "team"`))
+		Expect(reply.Rotations[0]["stale"]).Should(BeTrue())
+	})
+
+	It("reject the unknown format", func() {
+		resp, _ := get("?format=xml")
+		Expect(resp.StatusCode).Should(Equal(nethttp.StatusBadRequest))
+	})
+})
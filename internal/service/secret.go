@@ -16,9 +16,12 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
 
 	pb "github.com/nautes-labs/vault-proxy/api/vaultproxy/v1"
+	"github.com/nautes-labs/vault-proxy/internal/biz/auth"
 	vproxy "github.com/nautes-labs/vault-proxy/internal/biz/vaultproxy"
 )
 
@@ -31,7 +34,7 @@ func NewSecretService(uc *vproxy.VaultUsercase) *SecretService {
 }
 
 func (s *SecretService) CreateGit(ctx context.Context, req *pb.GitRequest) (*pb.CreateGitReply, error) {
-	sec, err := s.uc.CreateSecret(ctx, req)
+	sec, publicKey, err := s.uc.CreateGit(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -41,41 +44,141 @@ func (s *SecretService) CreateGit(ctx context.Context, req *pb.GitRequest) (*pb.
 			Path:    sec.SecretPath,
 			Version: int32(sec.SecretVersion),
 		},
+		PublicKey: publicKey,
 	}, nil
 }
 func (s *SecretService) DeleteGit(ctx context.Context, req *pb.GitRequest) (*pb.DeleteGitReply, error) {
-	err := s.uc.DeleteSecret(ctx, req)
+	revokedRoles, err := s.uc.DeleteSecret(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	return &pb.DeleteGitReply{}, nil
+	return &pb.DeleteGitReply{
+		RevokedRoles: revokedRoles,
+	}, nil
 }
-func (s *SecretService) CreatePki(_ context.Context, _ *pb.PkiRequest) (*pb.CreatePkiReply, error) {
-	return &pb.CreatePkiReply{}, nil
+func (s *SecretService) GetGit(ctx context.Context, req *pb.GetGitRequest) (*pb.GetGitReply, error) {
+	sec, err := s.uc.GetSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetGitReply{
+		Secret:   newSecretInfo(sec),
+		Metadata: newSecretMetadata(sec),
+	}, nil
 }
-func (s *SecretService) DeletePki(_ context.Context, _ *pb.PkiRequest) (*pb.DeletePkiReply, error) {
-	return &pb.DeletePkiReply{}, nil
+func (s *SecretService) ListGit(ctx context.Context, req *pb.ListGitRequest) (*pb.ListGitReply, error) {
+	page, err := s.uc.ListSecret(ctx, req, auth.ReadableFilter(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListGitReply{
+		Secrets:       newSecretInfoList(page.Secrets),
+		NextPageToken: page.NextPageToken,
+	}, nil
 }
-
-func (s *SecretService) CreateRepoAccount(ctx context.Context, req *pb.RepoRequest) (*pb.CreateRepoReply, error) {
-	sec, err := req.ConvertRequest()
+func (s *SecretService) ListGitVersions(ctx context.Context, req *pb.GetGitRequest) (*pb.ListGitVersionsReply, error) {
+	versions, err := s.uc.ListSecretVersions(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	switch sec.SecretData[pb.AuthTypeKey] {
-	case pb.AuthTypeToken:
-		if sec.SecretData["token"] == "" {
-			return nil, fmt.Errorf("token is empty")
-		}
-	case pb.AuthTypePassword:
-		if sec.SecretData["username"] == "" {
-			return nil, fmt.Errorf("account is empty")
-		}
-	default:
-		return nil, fmt.Errorf("auth type must in [ token, password ]")
+	return &pb.ListGitVersionsReply{
+		Versions: newSecretVersionList(versions),
+	}, nil
+}
+func (s *SecretService) RollbackGit(ctx context.Context, req *pb.RollbackGitRequest) (*pb.RollbackGitReply, error) {
+	sec, err := s.uc.RollbackSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RollbackGitReply{
+		Secret: &pb.SecretInfo{
+			Name:    sec.SecretName,
+			Path:    sec.SecretPath,
+			Version: int32(sec.SecretVersion),
+		},
+	}, nil
+}
+func (s *SecretService) UndeleteGit(ctx context.Context, req *pb.UndeleteGitRequest) (*pb.UndeleteGitReply, error) {
+	sec, grantedRoles, err := s.uc.UndeleteSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UndeleteGitReply{
+		Secret: &pb.SecretInfo{
+			Name:    sec.SecretName,
+			Path:    sec.SecretPath,
+			Version: int32(sec.SecretVersion),
+		},
+		GrantedRoles: grantedRoles,
+	}, nil
+}
+func (s *SecretService) PurgeGit(ctx context.Context, req *pb.PurgeGitRequest) (*pb.DeleteGitReply, error) {
+	revokedRoles, err := s.uc.PurgeSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteGitReply{
+		RevokedRoles: revokedRoles,
+	}, nil
+}
+func (s *SecretService) CreatePki(ctx context.Context, req *pb.PkiRequest) (*pb.CreatePkiReply, error) {
+	sec, err := s.uc.CreatePki(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreatePkiReply{
+		Secret: &pb.SecretInfo{
+			Name:    sec.SecretName,
+			Path:    sec.SecretPath,
+			Version: int32(sec.SecretVersion),
+		},
+	}, nil
+}
+func (s *SecretService) DeletePki(ctx context.Context, req *pb.PkiRequest) (*pb.DeletePkiReply, error) {
+	revokedRoles, err := s.uc.DeleteSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeletePkiReply{
+		RevokedRoles: revokedRoles,
+	}, nil
+}
+func (s *SecretService) GetPki(ctx context.Context, req *pb.PkiRequest) (*pb.GetPkiReply, error) {
+	sec, err := s.uc.GetSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetPkiReply{
+		Secret:   newSecretInfo(sec),
+		Metadata: newSecretMetadata(sec),
+	}, nil
+}
+func (s *SecretService) UndeletePki(ctx context.Context, req *pb.UndeletePkiRequest) (*pb.UndeletePkiReply, error) {
+	sec, grantedRoles, err := s.uc.UndeleteSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UndeletePkiReply{
+		Secret: &pb.SecretInfo{
+			Name:    sec.SecretName,
+			Path:    sec.SecretPath,
+			Version: int32(sec.SecretVersion),
+		},
+		GrantedRoles: grantedRoles,
+	}, nil
+}
+func (s *SecretService) PurgePki(ctx context.Context, req *pb.PurgePkiRequest) (*pb.DeletePkiReply, error) {
+	revokedRoles, err := s.uc.PurgeSecret(ctx, req)
+	if err != nil {
+		return nil, err
 	}
+	return &pb.DeletePkiReply{
+		RevokedRoles: revokedRoles,
+	}, nil
+}
 
-	secData, err := s.uc.CreateSecret(ctx, req)
+func (s *SecretService) CreateRepoAccount(ctx context.Context, req *pb.RepoRequest) (*pb.CreateRepoReply, error) {
+	sec, password, err := s.uc.CreateRepoAccount(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -83,23 +186,165 @@ func (s *SecretService) CreateRepoAccount(ctx context.Context, req *pb.RepoReque
 		Secret: &pb.SecretInfo{
 			Name:    sec.SecretName,
 			Path:    sec.SecretPath,
-			Version: int32(secData.SecretVersion),
+			Version: int32(sec.SecretVersion),
 		},
+		Password: password,
 	}, nil
 }
 func (s *SecretService) DeleteRepoAccountProduct(ctx context.Context, req *pb.RepoRequest) (*pb.DeleteRepoReply, error) {
-	err := s.uc.DeleteSecret(ctx, req)
+	if !req.Recursive {
+		revokedRoles, err := s.uc.DeleteSecret(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.DeleteRepoReply{
+			RevokedRoles: revokedRoles,
+		}, nil
+	}
+
+	deletedPaths, revokedRoles, err := s.uc.DeleteSecretRecursive(ctx, req, auth.DeletableFilter(ctx))
 	if err != nil {
 		return nil, err
 	}
-	return &pb.DeleteRepoReply{}, nil
+	return &pb.DeleteRepoReply{
+		RevokedRoles: revokedRoles,
+		DeletedPaths: deletedPaths,
+	}, nil
 }
 func (s *SecretService) DeleteRepoAccountProject(ctx context.Context, req *pb.RepoRequest) (*pb.DeleteRepoReply, error) {
-	err := s.uc.DeleteSecret(ctx, req)
+	revokedRoles, err := s.uc.DeleteSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteRepoReply{
+		RevokedRoles: revokedRoles,
+	}, nil
+}
+func (s *SecretService) GetRepoAccountProduct(ctx context.Context, req *pb.GetRepoRequest) (*pb.GetRepoReply, error) {
+	sec, err := s.uc.GetSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetRepoReply{
+		Secret:   newSecretInfo(sec),
+		Metadata: newSecretMetadata(sec),
+	}, nil
+}
+func (s *SecretService) GetRepoAccountProject(ctx context.Context, req *pb.GetRepoRequest) (*pb.GetRepoReply, error) {
+	sec, err := s.uc.GetSecret(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	return &pb.DeleteRepoReply{}, nil
+	return &pb.GetRepoReply{
+		Secret:   newSecretInfo(sec),
+		Metadata: newSecretMetadata(sec),
+	}, nil
+}
+func (s *SecretService) ListRepoAccount(ctx context.Context, req *pb.ListRepoRequest) (*pb.ListRepoReply, error) {
+	page, err := s.uc.ListSecret(ctx, req, auth.ReadableFilter(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListRepoReply{
+		Secrets:       newSecretInfoList(page.Secrets),
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+func (s *SecretService) ListRepoAccountProductVersions(ctx context.Context, req *pb.GetRepoRequest) (*pb.ListRepoVersionsReply, error) {
+	versions, err := s.uc.ListSecretVersions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListRepoVersionsReply{
+		Versions: newSecretVersionList(versions),
+	}, nil
+}
+func (s *SecretService) RollbackRepoAccountProduct(ctx context.Context, req *pb.RollbackRepoRequest) (*pb.RollbackRepoReply, error) {
+	sec, err := s.uc.RollbackSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RollbackRepoReply{
+		Secret: &pb.SecretInfo{
+			Name:    sec.SecretName,
+			Path:    sec.SecretPath,
+			Version: int32(sec.SecretVersion),
+		},
+	}, nil
+}
+func (s *SecretService) UndeleteRepoAccountProduct(ctx context.Context, req *pb.UndeleteRepoRequest) (*pb.UndeleteRepoReply, error) {
+	sec, grantedRoles, err := s.uc.UndeleteSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UndeleteRepoReply{
+		Secret: &pb.SecretInfo{
+			Name:    sec.SecretName,
+			Path:    sec.SecretPath,
+			Version: int32(sec.SecretVersion),
+		},
+		GrantedRoles: grantedRoles,
+	}, nil
+}
+func (s *SecretService) PurgeRepoAccountProduct(ctx context.Context, req *pb.PurgeRepoRequest) (*pb.DeleteRepoReply, error) {
+	revokedRoles, err := s.uc.PurgeSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteRepoReply{
+		RevokedRoles: revokedRoles,
+	}, nil
+}
+func (s *SecretService) ListRepoAccountProjectVersions(ctx context.Context, req *pb.GetRepoRequest) (*pb.ListRepoVersionsReply, error) {
+	versions, err := s.uc.ListSecretVersions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListRepoVersionsReply{
+		Versions: newSecretVersionList(versions),
+	}, nil
+}
+func (s *SecretService) RollbackRepoAccountProject(ctx context.Context, req *pb.RollbackRepoRequest) (*pb.RollbackRepoReply, error) {
+	sec, err := s.uc.RollbackSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RollbackRepoReply{
+		Secret: &pb.SecretInfo{
+			Name:    sec.SecretName,
+			Path:    sec.SecretPath,
+			Version: int32(sec.SecretVersion),
+		},
+	}, nil
+}
+func (s *SecretService) UndeleteRepoAccountProject(ctx context.Context, req *pb.UndeleteRepoRequest) (*pb.UndeleteRepoReply, error) {
+	sec, grantedRoles, err := s.uc.UndeleteSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UndeleteRepoReply{
+		Secret: &pb.SecretInfo{
+			Name:    sec.SecretName,
+			Path:    sec.SecretPath,
+			Version: int32(sec.SecretVersion),
+		},
+		GrantedRoles: grantedRoles,
+	}, nil
+}
+func (s *SecretService) PurgeRepoAccountProject(ctx context.Context, req *pb.PurgeRepoRequest) (*pb.DeleteRepoReply, error) {
+	revokedRoles, err := s.uc.PurgeSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteRepoReply{
+		RevokedRoles: revokedRoles,
+	}, nil
+}
+func (s *SecretService) RenderRepoAccountProductDockerConfig(ctx context.Context, req *pb.RenderRepoDockerConfigRequest) (*pb.DockerConfigReply, error) {
+	return s.renderDockerConfig(ctx, req)
+}
+func (s *SecretService) RenderRepoAccountProjectDockerConfig(ctx context.Context, req *pb.RenderRepoDockerConfigRequest) (*pb.DockerConfigReply, error) {
+	return s.renderDockerConfig(ctx, req)
 }
 func (s *SecretService) CreteTenantGit(ctx context.Context, req *pb.TenantGitRequest) (*pb.CreateTenantGitReply, error) {
 	sec, err := s.uc.CreateSecret(ctx, req)
@@ -115,14 +360,81 @@ func (s *SecretService) CreteTenantGit(ctx context.Context, req *pb.TenantGitReq
 	}, nil
 }
 func (s *SecretService) DeleteTenantGit(ctx context.Context, req *pb.TenantGitRequest) (*pb.DeleteTenantGitReply, error) {
-	err := s.uc.DeleteSecret(ctx, req)
+	revokedRoles, err := s.uc.DeleteSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteTenantGitReply{
+		RevokedRoles: revokedRoles,
+	}, nil
+}
+func (s *SecretService) GetTenantGit(ctx context.Context, req *pb.GetTenantGitRequest) (*pb.GetTenantGitReply, error) {
+	sec, err := s.uc.GetSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetTenantGitReply{
+		Secret:   newSecretInfo(sec),
+		Metadata: newSecretMetadata(sec),
+	}, nil
+}
+func (s *SecretService) ListTenantGit(ctx context.Context, req *pb.ListTenantGitRequest) (*pb.ListTenantGitReply, error) {
+	page, err := s.uc.ListSecret(ctx, req, auth.ReadableFilter(ctx))
 	if err != nil {
 		return nil, err
 	}
-	return &pb.DeleteTenantGitReply{}, nil
+	return &pb.ListTenantGitReply{
+		Secrets:       newSecretInfoList(page.Secrets),
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+func (s *SecretService) ListTenantGitVersions(ctx context.Context, req *pb.GetTenantGitRequest) (*pb.ListTenantGitVersionsReply, error) {
+	versions, err := s.uc.ListSecretVersions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListTenantGitVersionsReply{
+		Versions: newSecretVersionList(versions),
+	}, nil
+}
+func (s *SecretService) RollbackTenantGit(ctx context.Context, req *pb.RollbackTenantGitRequest) (*pb.RollbackTenantGitReply, error) {
+	sec, err := s.uc.RollbackSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RollbackTenantGitReply{
+		Secret: &pb.SecretInfo{
+			Name:    sec.SecretName,
+			Path:    sec.SecretPath,
+			Version: int32(sec.SecretVersion),
+		},
+	}, nil
+}
+func (s *SecretService) UndeleteTenantGit(ctx context.Context, req *pb.UndeleteTenantGitRequest) (*pb.UndeleteTenantGitReply, error) {
+	sec, grantedRoles, err := s.uc.UndeleteSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UndeleteTenantGitReply{
+		Secret: &pb.SecretInfo{
+			Name:    sec.SecretName,
+			Path:    sec.SecretPath,
+			Version: int32(sec.SecretVersion),
+		},
+		GrantedRoles: grantedRoles,
+	}, nil
+}
+func (s *SecretService) PurgeTenantGit(ctx context.Context, req *pb.PurgeTenantGitRequest) (*pb.DeleteTenantGitReply, error) {
+	revokedRoles, err := s.uc.PurgeSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteTenantGitReply{
+		RevokedRoles: revokedRoles,
+	}, nil
 }
 func (s *SecretService) CreateTenantRepo(ctx context.Context, req *pb.TenantRepoRequest) (*pb.CreateTenantRepoReply, error) {
-	sec, err := s.uc.CreateSecret(ctx, req)
+	sec, password, err := s.uc.CreateRepoAccount(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -132,17 +444,88 @@ func (s *SecretService) CreateTenantRepo(ctx context.Context, req *pb.TenantRepo
 			Path:    sec.SecretPath,
 			Version: int32(sec.SecretVersion),
 		},
+		Password: password,
 	}, nil
 }
 func (s *SecretService) DeleteTenantRepo(ctx context.Context, req *pb.TenantRepoRequest) (*pb.DeleteTenantRepoReply, error) {
-	err := s.uc.DeleteSecret(ctx, req)
+	revokedRoles, err := s.uc.DeleteSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteTenantRepoReply{
+		RevokedRoles: revokedRoles,
+	}, nil
+}
+func (s *SecretService) GetTenantRepo(ctx context.Context, req *pb.GetTenantRepoRequest) (*pb.GetTenantRepoReply, error) {
+	sec, err := s.uc.GetSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetTenantRepoReply{
+		Secret:   newSecretInfo(sec),
+		Metadata: newSecretMetadata(sec),
+	}, nil
+}
+func (s *SecretService) ListTenantRepo(ctx context.Context, req *pb.ListTenantRepoRequest) (*pb.ListTenantRepoReply, error) {
+	page, err := s.uc.ListSecret(ctx, req, auth.ReadableFilter(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListTenantRepoReply{
+		Secrets:       newSecretInfoList(page.Secrets),
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+func (s *SecretService) ListTenantRepoVersions(ctx context.Context, req *pb.GetTenantRepoRequest) (*pb.ListTenantRepoVersionsReply, error) {
+	versions, err := s.uc.ListSecretVersions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListTenantRepoVersionsReply{
+		Versions: newSecretVersionList(versions),
+	}, nil
+}
+func (s *SecretService) RollbackTenantRepo(ctx context.Context, req *pb.RollbackTenantRepoRequest) (*pb.RollbackTenantRepoReply, error) {
+	sec, err := s.uc.RollbackSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RollbackTenantRepoReply{
+		Secret: &pb.SecretInfo{
+			Name:    sec.SecretName,
+			Path:    sec.SecretPath,
+			Version: int32(sec.SecretVersion),
+		},
+	}, nil
+}
+func (s *SecretService) UndeleteTenantRepo(ctx context.Context, req *pb.UndeleteTenantRepoRequest) (*pb.UndeleteTenantRepoReply, error) {
+	sec, grantedRoles, err := s.uc.UndeleteSecret(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	return &pb.DeleteTenantRepoReply{}, nil
+	return &pb.UndeleteTenantRepoReply{
+		Secret: &pb.SecretInfo{
+			Name:    sec.SecretName,
+			Path:    sec.SecretPath,
+			Version: int32(sec.SecretVersion),
+		},
+		GrantedRoles: grantedRoles,
+	}, nil
+}
+func (s *SecretService) PurgeTenantRepo(ctx context.Context, req *pb.PurgeTenantRepoRequest) (*pb.DeleteTenantRepoReply, error) {
+	revokedRoles, err := s.uc.PurgeSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteTenantRepoReply{
+		RevokedRoles: revokedRoles,
+	}, nil
+}
+func (s *SecretService) RenderTenantRepoDockerConfig(ctx context.Context, req *pb.RenderTenantRepoDockerConfigRequest) (*pb.DockerConfigReply, error) {
+	return s.renderDockerConfig(ctx, req)
 }
 func (s *SecretService) CreateCluster(ctx context.Context, req *pb.ClusterRequest) (*pb.CreateClusterReply, error) {
-	sec, err := s.uc.CreateSecret(ctx, req)
+	sec, err := s.uc.CreateCluster(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -155,9 +538,244 @@ func (s *SecretService) CreateCluster(ctx context.Context, req *pb.ClusterReques
 	}, nil
 }
 func (s *SecretService) DeleteCluster(ctx context.Context, req *pb.ClusterRequest) (*pb.DeleteClusterReply, error) {
-	err := s.uc.DeleteSecret(ctx, req)
+	revokedRoles, err := s.uc.DeleteSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteClusterReply{
+		RevokedRoles: revokedRoles,
+	}, nil
+}
+func (s *SecretService) GetCluster(ctx context.Context, req *pb.GetClusterRequest) (*pb.GetClusterReply, error) {
+	sec, err := s.uc.GetSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetClusterReply{
+		Secret:   newSecretInfo(sec),
+		Metadata: newSecretMetadata(sec),
+	}, nil
+}
+func (s *SecretService) ListCluster(ctx context.Context, req *pb.ListClusterRequest) (*pb.ListClusterReply, error) {
+	page, err := s.uc.ListSecret(ctx, req, auth.ReadableFilter(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListClusterReply{
+		Secrets:       newSecretInfoList(page.Secrets),
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+func (s *SecretService) ListClusterVersions(ctx context.Context, req *pb.GetClusterRequest) (*pb.ListClusterVersionsReply, error) {
+	versions, err := s.uc.ListSecretVersions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListClusterVersionsReply{
+		Versions: newSecretVersionList(versions),
+	}, nil
+}
+func (s *SecretService) RollbackCluster(ctx context.Context, req *pb.RollbackClusterRequest) (*pb.RollbackClusterReply, error) {
+	sec, err := s.uc.RollbackSecret(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	return &pb.DeleteClusterReply{}, nil
+	return &pb.RollbackClusterReply{
+		Secret: &pb.SecretInfo{
+			Name:    sec.SecretName,
+			Path:    sec.SecretPath,
+			Version: int32(sec.SecretVersion),
+		},
+	}, nil
+}
+func (s *SecretService) UndeleteCluster(ctx context.Context, req *pb.UndeleteClusterRequest) (*pb.UndeleteClusterReply, error) {
+	sec, grantedRoles, err := s.uc.UndeleteSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UndeleteClusterReply{
+		Secret: &pb.SecretInfo{
+			Name:    sec.SecretName,
+			Path:    sec.SecretPath,
+			Version: int32(sec.SecretVersion),
+		},
+		GrantedRoles: grantedRoles,
+	}, nil
+}
+func (s *SecretService) PurgeCluster(ctx context.Context, req *pb.PurgeClusterRequest) (*pb.DeleteClusterReply, error) {
+	revokedRoles, err := s.uc.PurgeSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteClusterReply{
+		RevokedRoles: revokedRoles,
+	}, nil
+}
+
+func (s *SecretService) CreateSecret(ctx context.Context, req *pb.CreateSecretRequest) (*pb.CreateSecretReply, error) {
+	sec, err := s.uc.CreateSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateSecretReply{
+		Secret: &pb.SecretInfo{
+			Name:    sec.SecretName,
+			Path:    sec.SecretPath,
+			Version: int32(sec.SecretVersion),
+		},
+	}, nil
+}
+func (s *SecretService) GetSecret(ctx context.Context, req *pb.GetSecretRequest) (*pb.GetSecretReply, error) {
+	sec, err := s.uc.GetSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetSecretReply{
+		Secret:   newSecretInfo(sec),
+		Metadata: newSecretMetadata(sec),
+	}, nil
+}
+func (s *SecretService) DeleteSecret(ctx context.Context, req *pb.DeleteSecretRequest) (*pb.DeleteSecretReply, error) {
+	revokedRoles, err := s.uc.DeleteSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteSecretReply{
+		RevokedRoles: revokedRoles,
+	}, nil
+}
+
+func (s *SecretService) UndeleteSecret(ctx context.Context, req *pb.UndeleteSecretRequest) (*pb.UndeleteSecretReply, error) {
+	sec, grantedRoles, err := s.uc.UndeleteSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UndeleteSecretReply{
+		Secret: &pb.SecretInfo{
+			Name:    sec.SecretName,
+			Path:    sec.SecretPath,
+			Version: int32(sec.SecretVersion),
+		},
+		GrantedRoles: grantedRoles,
+	}, nil
+}
+
+func (s *SecretService) PurgeSecret(ctx context.Context, req *pb.PurgeSecretRequest) (*pb.DeleteSecretReply, error) {
+	revokedRoles, err := s.uc.PurgeSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteSecretReply{
+		RevokedRoles: revokedRoles,
+	}, nil
+}
+
+func (s *SecretService) UpdateSecretOwners(ctx context.Context, req *pb.UpdateSecretOwnersRequest) (*pb.UpdateSecretOwnersReply, error) {
+	sec, err := s.uc.UpdateSecretOwners(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateSecretOwnersReply{
+		Secret:   newSecretInfo(sec),
+		Metadata: newSecretMetadata(sec),
+	}, nil
+}
+
+func (s *SecretService) BatchCreateSecrets(ctx context.Context, req *pb.BatchCreateSecretsRequest) (*pb.BatchSecretsReply, error) {
+	results, err := s.uc.BatchCreateSecrets(ctx, req.SecRequests(), req.Atomic)
+	if err != nil {
+		return nil, err
+	}
+	return newBatchSecretsReply(results), nil
+}
+
+func (s *SecretService) BatchDeleteSecrets(ctx context.Context, req *pb.BatchDeleteSecretsRequest) (*pb.BatchSecretsReply, error) {
+	results, err := s.uc.BatchDeleteSecrets(ctx, req.SecRequests(), req.Atomic)
+	if err != nil {
+		return nil, err
+	}
+	return newBatchSecretsReply(results), nil
+}
+
+func (s *SecretService) renderDockerConfig(ctx context.Context, req pb.DockerConfigRequest) (*pb.DockerConfigReply, error) {
+	manifest, err := s.uc.RenderDockerConfig(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DockerConfigReply{
+		Manifest: manifest,
+	}, nil
+}
+
+func newSecretInfo(sec *vproxy.SecretMetadata) *pb.SecretInfo {
+	return &pb.SecretInfo{
+		Name:    sec.SecretName,
+		Path:    sec.SecretPath,
+		Version: int32(sec.SecretVersion),
+	}
+}
+
+func newSecretMetadata(sec *vproxy.SecretMetadata) *pb.SecretMetadata {
+	return &pb.SecretMetadata{
+		PolicyName:  sec.PolicyName,
+		CreatedTime: sec.CreatedTime.Format(time.RFC3339),
+		UpdatedTime: sec.UpdatedTime.Format(time.RFC3339),
+		CreatedBy:   sec.Provenance.CreatedBy,
+		UpdatedBy:   sec.Provenance.UpdatedBy,
+		RequestId:   sec.Provenance.RequestID,
+		Tenant:      sec.Provenance.Tenant,
+		SourceType:  sec.Provenance.SourceType,
+		CoOwners:    sec.Provenance.CoOwners,
+	}
+}
+
+func newBatchSecretsReply(results []vproxy.BatchResult) *pb.BatchSecretsReply {
+	reply := &pb.BatchSecretsReply{}
+	for _, result := range results {
+		item := &pb.BatchSecretResult{
+			RevokedRoles: result.RevokedRoles,
+			RolledBack:   result.RolledBack,
+		}
+		if result.Secret != nil {
+			item.Secret = &pb.SecretInfo{
+				Name:    result.Secret.SecretName,
+				Path:    result.Secret.SecretPath,
+				Version: int32(result.Secret.SecretVersion),
+			}
+		}
+		if result.Err != nil {
+			e := errors.FromError(result.Err)
+			item.Reason = e.Reason
+			item.Message = e.Message
+		}
+		reply.Results = append(reply.Results, item)
+	}
+	return reply
+}
+
+func newSecretInfoList(secrets []vproxy.SecretData) []*pb.SecretInfo {
+	infos := make([]*pb.SecretInfo, 0, len(secrets))
+	for _, sec := range secrets {
+		infos = append(infos, &pb.SecretInfo{
+			Name: sec.SecretName,
+			Path: sec.SecretPath,
+		})
+	}
+	return infos
+}
+
+func newSecretVersionList(versions []vproxy.SecretVersion) []*pb.SecretVersion {
+	list := make([]*pb.SecretVersion, 0, len(versions))
+	for _, version := range versions {
+		secretVersion := &pb.SecretVersion{
+			Version:     int32(version.Version),
+			CreatedTime: version.CreatedTime.Format(time.RFC3339),
+			Destroyed:   version.Destroyed,
+		}
+		if !version.DeletionTime.IsZero() {
+			secretVersion.DeletionTime = version.DeletionTime.Format(time.RFC3339)
+		}
+		list = append(list, secretVersion)
+	}
+	return list
 }
@@ -17,4 +17,4 @@ package service
 import "github.com/google/wire"
 
 // ProviderSet is service providers.
-var ProviderSet = wire.NewSet(NewSecretService, NewAuthService, NewAuthGrantService, NewHealthService)
+var ProviderSet = wire.NewSet(NewSecretService, NewAuthService, NewAuthGrantService, NewHealthService, NewReconcileService, NewExpirationService, NewRotationService)